@@ -1,31 +1,85 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
 	"log"
 	"main/internal/composites"
+	"main/internal/constants"
+	"main/internal/microservices/profile"
+	"main/internal/microservices/profile/repository"
+	"main/internal/microservices/profile/utils/netguard"
+	"main/internal/microservices/profile/utils/notify"
+	"main/internal/microservices/profile/utils/preferences"
+	"main/internal/microservices/profile/utils/schedule"
+	"main/internal/microservices/profile/utils/stock"
+	"main/internal/microservices/profile/utils/telegram"
+	"main/internal/microservices/profile/utils/webpush"
 	"main/internal/models"
-	"net/smtp"
+	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"sync"
 	"syscall"
 	"time"
 
 	cron "github.com/robfig/cron/v3"
 )
 
+// Вебхуки пользователей и Bot API могут отвечать медленно или с ошибками, поэтому рассылки
+// только ставят сообщения в очередь notification_outbox, а SendOutbox отправляет их отдельно:
+// каждый запрос ограничен по времени, одновременно идёт не больше outboxWorkers запросов,
+// а временные ошибки повторяются в следующих запусках с удваивающейся паузой
+const (
+	sendTimeout   = 10 * time.Second
+	outboxWorkers = 8
+	outboxBatch   = 100
+	outboxLease   = 5 * time.Minute
+)
+
+var retry = notify.Retry{
+	Attempts: 5,
+	Backoff:  time.Minute,
+}
+
 func main() {
 	postgresDBC, err := composites.NewPostgresDBComposite()
 	if err != nil {
 		log.Fatal("postgres db composite failed")
 	}
 
+	bot := telegram.NewClient(os.Getenv("TELEGRAM_API_URL"), os.Getenv("TELEGRAM_TOKEN"), &http.Client{Timeout: sendTimeout})
+	notifiers := map[string]notify.Notifier{
+		preferences.ChannelEmail:    notify.NewSMTP("myaidkit@gmail.com", os.Getenv("EMAILPASSWORD"), "smtp.gmail.com", "587"),
+		preferences.ChannelWebhook:  notify.NewWebhook(netguard.NewClient(sendTimeout)),
+		preferences.ChannelTelegram: notify.NewTelegram(bot),
+	}
+
+	push, err := webpush.NewClient(netguard.NewClient(sendTimeout), os.Getenv("VAPID_SUBJECT"),
+		os.Getenv("VAPID_PUBLIC_KEY"), os.Getenv("VAPID_PRIVATE_KEY"))
+	if err != nil {
+		log.Println("web push is disabled:", err)
+	} else {
+		notifiers[preferences.ChannelPush] = notify.NewPush(push)
+	}
+
+	// расписание и средние дозы для прогноза остатка читаются теми же запросами, что и в сервисе профиля
+	storage := repository.NewStorage(postgresDBC.DB, nil, nil)
+
 	loc := time.UTC
 	scheduler := cron.New(cron.WithLocation(loc))
 
 	defer scheduler.Stop()
 
 	scheduler.AddFunc("30 2 * * *", func() { DeleteNotifications(postgresDBC) })
-	scheduler.AddFunc("* * * * *", func() { SendNotifications(postgresDBC) })
+	scheduler.AddFunc("* * * * *", func() { SendNotifications(postgresDBC, notifiers) })
+	scheduler.AddFunc("* * * * *", func() { SendDigests(postgresDBC, notifiers) })
+	scheduler.AddFunc("0 7 * * *", func() { CheckStock(postgresDBC, storage, notifiers) })
+	scheduler.AddFunc("0 3 * * *", func() { UpdateShoppingLists(postgresDBC) })
+	scheduler.AddFunc("0 * * * *", func() { DeleteExpiredPushSubscriptions(postgresDBC) })
+	scheduler.AddJob("@every 10s", cron.NewChain(cron.SkipIfStillRunning(cron.DefaultLogger)).
+		Then(cron.FuncJob(func() { SendOutbox(postgresDBC, notifiers) })))
 
 	go scheduler.Start()
 
@@ -34,8 +88,12 @@ func main() {
 	<-sig
 }
 
+// DeleteNotifications удаляет прошедшие напоминания, сохраняя в dose_log, был ли приём отмечен
 func DeleteNotifications(postgresDBC *composites.PostgresDBComposite) {
-	sqlScript := "DELETE FROM notification_user WHERE time::timestamptz < now()::timestamptz"
+	sqlScript := "WITH deleted AS (DELETE FROM notification_user WHERE time < now() " +
+		"RETURNING to_is_user, id_to_user, id_medicine, name_medicine, time, is_accepted, count) " +
+		"INSERT INTO dose_log(to_is_user, id_person, id_medicine, name_medicine, time, is_taken, count) " +
+		"SELECT COALESCE(to_is_user, true), COALESCE(id_to_user, 0), id_medicine, name_medicine, time, COALESCE(is_accepted, false), count FROM deleted"
 	_, err := postgresDBC.DB.Exec(sqlScript)
 	if err != nil {
 		log.Fatal(err)
@@ -45,17 +103,12 @@ func DeleteNotifications(postgresDBC *composites.PostgresDBComposite) {
 	log.Println(time.Now().In(loc).Format("2006-01-02 15:04:05") + " DeleteNotifications\n")
 }
 
-func SendNotifications(postgresDBC *composites.PostgresDBComposite) {
-	loc := time.UTC
-	currentTime := time.Now().In(loc).Format("2006-01-02 15:04")
-
-	from := "myaidkit@gmail.com"
-	password := os.Getenv("EMAILPASSWORD")
+// SendNotifications рассылает наступившие напоминания: получателю приёма и копии взрослым членам семьи.
+// Уведомления, наступившие в тихие часы получателя, приходят после их окончания; тем, кто отключил копии, копии не отправляются
+func SendNotifications(postgresDBC *composites.PostgresDBComposite, notifiers map[string]notify.Notifier) {
+	currentTime := time.Now().Truncate(time.Minute)
 
-	host := "smtp.gmail.com"
-	port := "587"
-
-	sqlScript := "select id_from, to_is_user, id_to_user, name_to, name_medicine, email, id_family from users join notification_user on id_from = users.id where time::timestamptz = to_timestamp($1, 'YYYY-MM-DD HH24:MI')::timestamptz;"
+	sqlScript := "select notification_user.id, id_from, to_is_user, id_to_user, name_to, name_medicine, email, COALESCE(id_family, 0) from users join notification_user on id_from = users.id where time >= $1::timestamptz and time < $1::timestamptz + interval '1 minute' and id_medicine not in (select id from medicine where disposed is not null);"
 	rows, err := postgresDBC.DB.Query(sqlScript, currentTime)
 	if err != nil {
 		log.Fatal(err)
@@ -64,6 +117,7 @@ func SendNotifications(postgresDBC *composites.PostgresDBComposite) {
 
 	for rows.Next() {
 		notificationFrom := models.NotificationsFrom{
+			ID:           0,
 			IDFrom:       0,
 			ToIsUser:     false,
 			IDTo:         0,
@@ -73,100 +127,494 @@ func SendNotifications(postgresDBC *composites.PostgresDBComposite) {
 			IDFamily:     0,
 		}
 
-		if err = rows.Scan(&notificationFrom.IDFrom, &notificationFrom.ToIsUser, &notificationFrom.IDTo,
+		if err = rows.Scan(&notificationFrom.ID, &notificationFrom.IDFrom, &notificationFrom.ToIsUser, &notificationFrom.IDTo,
 			&notificationFrom.NameTo, &notificationFrom.NameMedicine, &notificationFrom.Email, &notificationFrom.IDFamily); err != nil {
 			log.Fatal(err)
 		}
 
-		if notificationFrom.IDFrom == notificationFrom.IDTo && notificationFrom.ToIsUser {
-			toList := []string{notificationFrom.Email}
-			msg := "Вам неообходимо принять " + notificationFrom.NameMedicine + "\r\n" +
-				"Зайдите в приложение и отметьте количество выпитых таблеток или просто нажмите \"Принять\", если выпитое лекарство не является таблеткой.\r\n" +
+		var idUser int64
+		if notificationFrom.ToIsUser {
+			idUser = notificationFrom.IDTo
+		}
+		ownOnly := notificationFrom.IDFrom == notificationFrom.IDTo && notificationFrom.ToIsUser
+
+		recipients, err := familyRecipients(postgresDBC, notificationFrom.IDFamily, idUser)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for _, recipient := range recipients {
+			message := notify.Message{
+				Event:          notify.EventDose,
+				IDNotification: notificationFrom.ID,
+				Person:         notificationFrom.NameTo,
+				Medicine:       notificationFrom.NameMedicine,
+				Time:           currentTime,
+			}
+
+			switch {
+			case recipient.ID == idUser:
+				message.Text = "Вам неообходимо принять " + notificationFrom.NameMedicine + "\r\n"
+			case ownOnly || recipient.Dependents != preferences.DependentsCopies:
+				continue
+			default:
+				message.Event = notify.EventDoseCopy
+				message.Text = "Члену семьи " + notificationFrom.NameTo + " необходимо принять " + notificationFrom.NameMedicine + "\r\n"
+			}
+			message.Text += "Зайдите в приложение и отметьте количество выпитых таблеток или просто нажмите \"Принять\", если выпитое лекарство не является таблеткой.\r\n" +
 				"https://myaidkit.ru"
 
-			body := []byte(msg)
+			deliver(postgresDBC, notifiers, recipient, message, currentTime)
+		}
+	}
+
+	log.Println(time.Now().In(time.UTC).Format("2006-01-02 15:04:05") + " SendNotifications\n")
+}
+
+// SendDigests присылает взрослым, выбравшим сводку вместо копий, список приёмов лекарств
+// членами семьи за прошедшие сутки; сводка приходит один раз в день в местное время Digest
+func SendDigests(postgresDBC *composites.PostgresDBComposite, notifiers map[string]notify.Notifier) {
+	currentTime := time.Now().Truncate(time.Minute)
+
+	sqlScript := "SELECT users.id, users.email, users.time_zone, users.id_family, notification_settings.channels, " +
+		"COALESCE(notification_settings.quiet_from, ''), COALESCE(notification_settings.quiet_to, ''), notification_settings.dependents " +
+		"FROM users JOIN notification_settings ON notification_settings.id_user = users.id " +
+		"WHERE notification_settings.dependents = $1 AND users.is_adult = true AND users.id_family IS NOT NULL;"
+	rows, err := postgresDBC.DB.Query(sqlScript, preferences.DependentsDigest)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	recipients := make([]models.Recipient, 0)
+	func() {
+		defer rows.Close()
+
+		for rows.Next() {
+			var recipient models.Recipient
+			if err = rows.Scan(&recipient.ID, &recipient.Email, &recipient.TimeZone, &recipient.IDFamily,
+				&recipient.Channels, &recipient.QuietFrom, &recipient.QuietTo, &recipient.Dependents); err != nil {
+				log.Fatal(err)
+			}
+			recipients = append(recipients, recipient)
+		}
+	}()
+
+	for _, recipient := range recipients {
+		local := schedule.In(currentTime, recipient.TimeZone)
+		if local.Format("15:04") != recipientPreferences(recipient).Digest() {
+			continue
+		}
+
+		doses, err := familyDoses(postgresDBC, recipient.IDFamily, recipient.ID, currentTime)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(doses) == 0 {
+			continue
+		}
+
+		msg := "Приёмы лекарств членами семьи за прошедшие сутки:\r\n"
+		for _, dose := range doses {
+			result := "пропущено"
+			if dose.Taken {
+				result = "принято"
+			}
+			msg += schedule.In(dose.Time, recipient.TimeZone).Format("02.01 15:04") + " " + dose.NamePerson + ", " +
+				dose.NameMedicine + ": " + result + "\r\n"
+		}
+		msg += "https://myaidkit.ru"
+
+		deliver(postgresDBC, notifiers, recipient, notify.Message{
+			Event: notify.EventDigest,
+			Text:  msg,
+			Time:  currentTime,
+		}, currentTime)
+	}
+
+	log.Println(time.Now().In(time.UTC).Format("2006-01-02 15:04:05") + " SendDigests\n")
+}
+
+// familyDoses возвращает приёмы лекарств за сутки до now подопечными: членами семьи без аккаунта
+// и пользователями семьи, которые не отмечены взрослыми
+func familyDoses(postgresDBC *composites.PostgresDBComposite, idFamily, idUser int64, now time.Time) ([]models.DigestDose, error) {
+	sqlScript := "SELECT COALESCE(users.name, members.name, ''), doses.name, doses.time, doses.is_taken FROM (" +
+		"SELECT dose_log.to_is_user AS is_user, dose_log.id_person, COALESCE(medicine.name, dose_log.name_medicine, '') AS name, dose_log.time, dose_log.is_taken " +
+		"FROM dose_log LEFT JOIN medicine ON medicine.id = dose_log.id_medicine " +
+		"UNION ALL " +
+		"SELECT notification_user.to_is_user, notification_user.id_to_user, medicine.name, notification_user.time, COALESCE(notification_user.is_accepted, false) " +
+		"FROM notification_user JOIN medicine ON medicine.id = notification_user.id_medicine" +
+		") doses " +
+		"LEFT JOIN users ON doses.is_user AND users.id = doses.id_person " +
+		"LEFT JOIN members ON NOT doses.is_user AND members.id = doses.id_person " +
+		"WHERE doses.time >= $3::timestamptz - interval '1 day' AND doses.time < $3::timestamptz " +
+		"AND $1 <> 0 AND ((users.id_family = $1 AND users.id <> $2 AND NOT COALESCE(users.is_adult, false)) OR members.id_family = $1) " +
+		"ORDER BY doses.time, doses.name;"
+	rows, err := postgresDBC.DB.Query(sqlScript, idFamily, idUser, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	doses := make([]models.DigestDose, 0)
+	for rows.Next() {
+		var dose models.DigestDose
+		if err = rows.Scan(&dose.NamePerson, &dose.NameMedicine, &dose.Time, &dose.Taken); err != nil {
+			return nil, err
+		}
+		doses = append(doses, dose)
+	}
+
+	return doses, nil
+}
+
+// CheckStock предупреждает владельца лекарства или взрослых членов его семьи,
+// если остаток опустился до минимума или по расписанию закончится в ближайшие дни
+func CheckStock(postgresDBC *composites.PostgresDBComposite, storage profile.Storage, notifiers map[string]notify.Notifier) {
+	loc := time.UTC
+	now := time.Now().In(loc)
+
+	days := int64(constants.LowStockDays)
+	if value, err := strconv.ParseInt(os.Getenv("LOW_STOCK_DAYS"), 10, 64); err == nil {
+		days = value
+	}
+
+	sqlScript := "SELECT medicine.id, medicine.name, medicine.count, COALESCE(medicine.min_count, 0), COALESCE(medicine.unit, ''), users.id, COALESCE(users.id_family, 0) " +
+		"FROM medicine JOIN users ON users.id = medicine.id_user " +
+		"WHERE COALESCE(medicine.stock_alerted, false) = false AND medicine.disposed IS NULL AND (medicine.is_tablets = true OR COALESCE(medicine.unit, '') <> '');"
+	rows, err := postgresDBC.DB.Query(sqlScript)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	medicines := make([]models.LowStock, 0)
+	func() {
+		defer rows.Close()
 
-			authSMTP := smtp.PlainAuth("", from, password, host)
-			err = smtp.SendMail(host+":"+port, authSMTP, from, toList, body)
-			if err != nil {
+		for rows.Next() {
+			var medicine models.LowStock
+			if err = rows.Scan(&medicine.IDMedicine, &medicine.NameMedicine, &medicine.Count, &medicine.MinCount,
+				&medicine.Unit, &medicine.IDUser, &medicine.IDFamily); err != nil {
 				log.Fatal(err)
 			}
-		} else {
-			if !notificationFrom.ToIsUser {
-				sqlScript = "select email from users where id_family = $1 and users.is_adult = true;"
-				userRows, err := postgresDBC.DB.Query(sqlScript, notificationFrom.IDFamily)
-				if err != nil {
-					log.Fatal(err)
-				}
-				func() {
-					defer userRows.Close()
-
-					for userRows.Next() {
-						var email string
-						if err = userRows.Scan(&email); err != nil {
-							log.Fatal(err)
-						}
-
-						toList := []string{email}
-						msg := "Члену семьи " + notificationFrom.NameTo + " необходимо принять " + notificationFrom.NameMedicine + "\r\n" +
-							"Зайдите в приложение и отметьте количество выпитых таблеток или просто нажмите \"Принять\", если выпитое лекарство не является таблеткой.\r\n" +
-							"https://myaidkit.ru"
-						body := []byte(msg)
-
-						authSMTP := smtp.PlainAuth("", from, password, host)
-						err = smtp.SendMail(host+":"+port, authSMTP, from, toList, body)
-						if err != nil {
-							log.Fatal(err)
-						}
-					}
-				}()
-			} else {
-				sqlScript = "select id, email from users where id_family = $1 and (users.is_adult = true or id = $2);"
-				userRows, err := postgresDBC.DB.Query(sqlScript, notificationFrom.IDFamily, notificationFrom.IDTo)
-				if err != nil {
-					log.Fatal(err)
-				}
-				func() {
-					defer userRows.Close()
-
-					for userRows.Next() {
-						var email string
-						var id int64
-						if err = userRows.Scan(&id, &email); err != nil {
-							log.Fatal(err)
-						}
-
-						if id == notificationFrom.IDTo {
-							toList := []string{email}
-							msg := "Вам неообходимо принять " + notificationFrom.NameMedicine + "\r\n" +
-								"Зайдите в приложение и отметьте количество выпитых таблеток или просто нажмите \"Принять\", если выпитое лекарство не является таблеткой.\r\n" +
-								"https://myaidkit.ru"
-
-							body := []byte(msg)
-
-							authSMTP := smtp.PlainAuth("", from, password, host)
-							err = smtp.SendMail(host+":"+port, authSMTP, from, toList, body)
-							if err != nil {
-								log.Fatal(err)
-							}
-
-							continue
-						}
-
-						toList := []string{email}
-						msg := "Члену семьи " + notificationFrom.NameTo + " необходимо принять " + notificationFrom.NameMedicine
-						body := []byte(msg)
-
-						authSMTP := smtp.PlainAuth("", from, password, host)
-						err = smtp.SendMail(host+":"+port, authSMTP, from, toList, body)
-						if err != nil {
-							log.Fatal(err)
-						}
-					}
-				}()
+			medicines = append(medicines, medicine)
+		}
+	}()
+
+	medicineIDs := make([]int64, 0, len(medicines))
+	for _, medicine := range medicines {
+		medicineIDs = append(medicineIDs, medicine.IDMedicine)
+	}
+
+	schedules, err := storage.GetSchedules(medicineIDs)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	doses, err := storage.GetAverageDoses(medicineIDs)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, medicine := range medicines {
+		runOut, ok := stock.Forecast(medicine.Count, doses[medicine.IDMedicine], schedules[medicine.IDMedicine])
+		supply := stock.DaysOfSupply(runOut, ok, now)
+
+		var msg string
+		switch {
+		case ok && supply <= days:
+			msg = "Лекарство " + medicine.NameMedicine + " закончится " + runOut.In(loc).Format("02.01.2006") +
+				", осталось " + strconv.FormatInt(medicine.Count, 10) + " " + medicine.Unit + "\r\n"
+		case medicine.MinCount > 0 && medicine.Count <= medicine.MinCount:
+			msg = "Лекарство " + medicine.NameMedicine + " заканчивается, осталось " + strconv.FormatInt(medicine.Count, 10) + " " + medicine.Unit + "\r\n"
+		default:
+			continue
+		}
+		msg += "Пополните аптечку или добавьте лекарство в список покупок.\r\n" +
+			"https://myaidkit.ru"
+
+		// в семье предупреждение получают взрослые, иначе владелец лекарства
+		var idUser int64
+		if medicine.IDFamily == 0 {
+			idUser = medicine.IDUser
+		}
+		recipients, err := familyRecipients(postgresDBC, medicine.IDFamily, idUser)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		message := notify.Message{
+			Event:    notify.EventLowStock,
+			Text:     msg,
+			Medicine: medicine.NameMedicine,
+			Time:     now,
+		}
+
+		delivered := false
+		for _, recipient := range recipients {
+			if deliver(postgresDBC, notifiers, recipient, message, now) {
+				delivered = true
 			}
 		}
+		if !delivered {
+			continue
+		}
+
+		sqlScript = "UPDATE medicine SET stock_alerted = true WHERE id = $1;"
+		_, err = postgresDBC.DB.Exec(sqlScript, medicine.IDMedicine)
+		if err != nil {
+			log.Fatal(err)
+		}
 	}
 
-	log.Println(time.Now().In(loc).Format("2006-01-02 15:04:05") + " SendNotifications\n")
+	log.Println(time.Now().In(loc).Format("2006-01-02 15:04:05") + " CheckStock\n")
+}
+
+// familyRecipients возвращает взрослых членов семьи и пользователя idUser вместе с их настройками уведомлений.
+// У пользователей без семьи id_family = 0, поэтому при idFamily = 0 возвращается только сам idUser
+func familyRecipients(postgresDBC *composites.PostgresDBComposite, idFamily, idUser int64) ([]models.Recipient, error) {
+	defaults := preferences.Default()
+
+	sqlScript := "SELECT users.id, users.email, users.time_zone, COALESCE(users.id_family, 0), COALESCE(notification_settings.channels, $3), " +
+		"COALESCE(notification_settings.quiet_from, ''), COALESCE(notification_settings.quiet_to, ''), COALESCE(notification_settings.dependents, $4) " +
+		"FROM users LEFT JOIN notification_settings ON notification_settings.id_user = users.id " +
+		"WHERE ($1 <> 0 AND users.id_family = $1 AND users.is_adult = true) OR users.id = $2;"
+	rows, err := postgresDBC.DB.Query(sqlScript, idFamily, idUser, preferences.JoinChannels(defaults.Channels), defaults.Dependents)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	recipients := make([]models.Recipient, 0)
+	for rows.Next() {
+		var recipient models.Recipient
+		if err = rows.Scan(&recipient.ID, &recipient.Email, &recipient.TimeZone, &recipient.IDFamily,
+			&recipient.Channels, &recipient.QuietFrom, &recipient.QuietTo, &recipient.Dependents); err != nil {
+			return nil, err
+		}
+		recipients = append(recipients, recipient)
+	}
+
+	return recipients, nil
+}
+
+func recipientPreferences(recipient models.Recipient) preferences.Preferences {
+	return preferences.Preferences{
+		Channels:   preferences.SplitChannels(recipient.Channels),
+		QuietFrom:  recipient.QuietFrom,
+		QuietTo:    recipient.QuietTo,
+		Dependents: recipient.Dependents,
+	}
+}
+
+// deliver ставит сообщение в очередь для получателя по всем включённым у него каналам и сообщает,
+// поставлено ли оно хотя бы для одного адреса. В тихие часы отправка откладывается до их конца
+func deliver(postgresDBC *composites.PostgresDBComposite, notifiers map[string]notify.Notifier,
+	recipient models.Recipient, message notify.Message, now time.Time) bool {
+	prefs := recipientPreferences(recipient)
+	at := prefs.QuietEnd(schedule.In(now, recipient.TimeZone))
+
+	body, err := json.Marshal(message)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	queued := false
+	for _, channel := range prefs.Channels {
+		if _, ok := notifiers[channel]; !ok {
+			continue
+		}
+
+		count, err := enqueue(postgresDBC, channel, recipient.ID, body, at)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if count != 0 {
+			queued = true
+		}
+	}
+
+	return queued
+}
+
+// enqueue ставит сообщение в очередь по одному разу на каждый адрес пользователя в канале:
+// почту, привязанный чат Telegram, вебхуки или подписки браузеров
+func enqueue(postgresDBC *composites.PostgresDBComposite, channel string, idUser int64, body []byte, at time.Time) (int64, error) {
+	var sqlScript string
+	switch channel {
+	case preferences.ChannelWebhook:
+		sqlScript = "INSERT INTO notification_outbox(id_user, channel, id_webhook, message, next_attempt) " +
+			"SELECT $1, $2, id, $3, $4 FROM webhooks WHERE id_user = $1;"
+	case preferences.ChannelPush:
+		sqlScript = "INSERT INTO notification_outbox(id_user, channel, id_subscription, message, next_attempt) " +
+			"SELECT $1, $2, id, $3, $4 FROM push_subscriptions WHERE id_user = $1 AND expires > now();"
+	case preferences.ChannelTelegram:
+		sqlScript = "INSERT INTO notification_outbox(id_user, channel, message, next_attempt) " +
+			"SELECT $1, $2, $3, $4 FROM telegram_chats WHERE id_user = $1;"
+	default:
+		sqlScript = "INSERT INTO notification_outbox(id_user, channel, message, next_attempt) VALUES($1, $2, $3, $4);"
+	}
+
+	result, err := postgresDBC.DB.Exec(sqlScript, idUser, channel, body, at)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// SendOutbox отправляет наступившие сообщения из очереди, каждое одной попыткой. Сообщения
+// забираются с арендой outboxLease, так что сообщение, отправка которого оборвалась, будет отправлено повторно
+func SendOutbox(postgresDBC *composites.PostgresDBComposite, notifiers map[string]notify.Notifier) {
+	items, err := claimOutbox(postgresDBC, outboxBatch)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(items) == 0 {
+		return
+	}
+
+	queue := make(chan models.OutboxItem)
+	var wg sync.WaitGroup
+	for i := 0; i < outboxWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for item := range queue {
+				sendItem(postgresDBC, notifiers, item)
+			}
+		}()
+	}
+
+	for _, item := range items {
+		queue <- item
+	}
+	close(queue)
+	wg.Wait()
+
+	log.Println(time.Now().In(time.UTC).Format("2006-01-02 15:04:05") + " SendOutbox " + strconv.Itoa(len(items)) + "\n")
+}
+
+// claimOutbox забирает до limit наступивших сообщений вместе с адресами получателей
+func claimOutbox(postgresDBC *composites.PostgresDBComposite, limit int) ([]models.OutboxItem, error) {
+	sqlScript := "WITH claimed AS (UPDATE notification_outbox SET next_attempt = now() + $2 * interval '1 second' WHERE id IN " +
+		"(SELECT id FROM notification_outbox WHERE next_attempt <= now() ORDER BY next_attempt, id LIMIT $1 FOR UPDATE SKIP LOCKED) " +
+		"RETURNING id, id_user, channel, id_webhook, id_subscription, message, attempts) " +
+		"SELECT claimed.id, claimed.channel, claimed.message, claimed.attempts, " +
+		"COALESCE(webhooks.url, push_subscriptions.endpoint, telegram_chats.chat_id::text, users.email, ''), " +
+		"COALESCE(webhooks.secret, push_subscriptions.auth, ''), COALESCE(push_subscriptions.p256dh, '') " +
+		"FROM claimed LEFT JOIN webhooks ON webhooks.id = claimed.id_webhook " +
+		"LEFT JOIN push_subscriptions ON push_subscriptions.id = claimed.id_subscription " +
+		"LEFT JOIN telegram_chats ON claimed.channel = $3 AND telegram_chats.id_user = claimed.id_user " +
+		"LEFT JOIN users ON claimed.channel = $4 AND users.id = claimed.id_user;"
+	rows, err := postgresDBC.DB.Query(sqlScript, limit, int64(outboxLease/time.Second), preferences.ChannelTelegram, preferences.ChannelEmail)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := make([]models.OutboxItem, 0)
+	for rows.Next() {
+		var item models.OutboxItem
+		if err = rows.Scan(&item.ID, &item.Channel, &item.Message, &item.Attempts,
+			&item.Address, &item.Secret, &item.Key); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// sendItem отправляет сообщение и убирает его из очереди или, при временной ошибке, откладывает
+// следующую попытку. Ошибки доставки только записываются в лог: недоступный вебхук не должен
+// останавливать рассылку остальным
+func sendItem(postgresDBC *composites.PostgresDBComposite, notifiers map[string]notify.Notifier, item models.OutboxItem) {
+	notifier, ok := notifiers[item.Channel]
+
+	var message notify.Message
+	err := json.Unmarshal(item.Message, &message)
+	if err != nil || !ok || item.Address == "" {
+		deleteOutboxItem(postgresDBC, item.ID)
+		return
+	}
+
+	now := time.Now()
+	err = notifier.Send(notify.Target{Address: item.Address, Secret: item.Secret, Key: item.Key}, message)
+	switch {
+	case err == nil:
+		deleteOutboxItem(postgresDBC, item.ID)
+	case errors.Is(err, webpush.ErrGone):
+		deletePushSubscription(postgresDBC, item.Address)
+	default:
+		next, again := retry.Next(err, item.Attempts+1, now)
+		if !again {
+			log.Println("giving up delivery:", err)
+			deleteOutboxItem(postgresDBC, item.ID)
+			return
+		}
+
+		log.Println(err)
+		sqlScript := "UPDATE notification_outbox SET attempts = attempts + 1, next_attempt = $2 WHERE id = $1;"
+		if _, err = postgresDBC.DB.Exec(sqlScript, item.ID, next); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+func deleteOutboxItem(postgresDBC *composites.PostgresDBComposite, id int64) {
+	sqlScript := "DELETE FROM notification_outbox WHERE id = $1;"
+	_, err := postgresDBC.DB.Exec(sqlScript, id)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// deletePushSubscription удаляет подписку, от которой браузер отказался, вместе с сообщениями для неё
+func deletePushSubscription(postgresDBC *composites.PostgresDBComposite, endpoint string) {
+	sqlScript := "DELETE FROM push_subscriptions WHERE endpoint = $1;"
+	_, err := postgresDBC.DB.Exec(sqlScript, endpoint)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// DeleteExpiredPushSubscriptions удаляет подписки браузеров, сессии которых истекли
+func DeleteExpiredPushSubscriptions(postgresDBC *composites.PostgresDBComposite) {
+	sqlScript := "DELETE FROM push_subscriptions WHERE expires < now();"
+	_, err := postgresDBC.DB.Exec(sqlScript)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Println(time.Now().In(time.UTC).Format("2006-01-02 15:04:05") + " DeleteExpiredPushSubscriptions\n")
+}
+
+// UpdateShoppingLists добавляет в списки покупок лекарства с истёкшим сроком годности
+// и удаляет позиции, купленные больше месяца назад
+func UpdateShoppingLists(postgresDBC *composites.PostgresDBComposite) {
+	// после исправления срока годности о лекарстве можно напомнить снова
+	sqlScript := "UPDATE medicine SET expiry_listed = false WHERE expiry_listed AND (expires IS NULL OR expires >= now()::date);"
+	_, err := postgresDBC.DB.Exec(sqlScript)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// лекарство попадает в список один раз: отметка остаётся и после того, как купленная позиция удалена
+	sqlScript = "WITH listed AS (UPDATE medicine SET expiry_listed = true " +
+		"WHERE expires < now()::date AND disposed IS NULL AND NOT expiry_listed RETURNING id_user, id, name, count, unit) " +
+		"INSERT INTO shopping_list(id_user, id_medicine, name, count, unit, reason) " +
+		"SELECT id_user, id, name, GREATEST(count, 1), COALESCE(unit, ''), $1 FROM listed WHERE NOT EXISTS " +
+		"(SELECT 1 FROM shopping_list WHERE shopping_list.id_medicine = listed.id AND shopping_list.reason = $1 AND shopping_list.is_bought = false);"
+	_, err = postgresDBC.DB.Exec(sqlScript, constants.ShoppingExpired)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	sqlScript = "DELETE FROM shopping_list WHERE is_bought = true AND created < now() - interval '30 days';"
+	_, err = postgresDBC.DB.Exec(sqlScript)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	loc := time.UTC
+	log.Println(time.Now().In(loc).Format("2006-01-02 15:04:05") + " UpdateShoppingLists\n")
 }
@@ -10,6 +10,7 @@ require (
 	github.com/mailru/easyjson v0.7.7
 	go.uber.org/zap v1.24.0
 	golang.org/x/crypto v0.6.0
+	golang.org/x/image v0.6.0
 	google.golang.org/grpc v1.53.0
 	google.golang.org/protobuf v1.29.0
 	gopkg.in/validator.v2 v2.0.1
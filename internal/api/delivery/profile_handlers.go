@@ -1,7 +1,10 @@
 package delivery
 
 import (
+	"bytes"
 	"context"
+	"crypto/subtle"
+	"encoding/json"
 	"image"
 	_ "image/jpeg"
 	_ "image/png"
@@ -9,11 +12,22 @@ import (
 	"main/internal/constants"
 	"main/internal/csrf"
 	profile "main/internal/microservices/profile/proto"
+	"main/internal/microservices/profile/utils/calendar"
+	"main/internal/microservices/profile/utils/fhir"
+	"main/internal/microservices/profile/utils/inventory"
+	"main/internal/microservices/profile/utils/pagination"
+	"main/internal/microservices/profile/utils/report"
+	"main/internal/microservices/profile/utils/schedule"
+	"main/internal/microservices/profile/utils/stock"
+	"main/internal/microservices/profile/utils/telegram"
+	"main/internal/microservices/profile/utils/templates"
 	"main/internal/models"
 	"mime/multipart"
 	"net/http"
 	"net/smtp"
 	"os"
+	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -29,6 +43,9 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// telegramHTTPClient — общий клиент для ответов боту из вебхука Telegram
+var telegramHTTPClient = &http.Client{Timeout: telegram.Timeout}
+
 type profileHandler struct {
 	logger *zap.SugaredLogger
 
@@ -49,19 +66,68 @@ func (p *profileHandler) Register(router *echo.Echo) {
 	router.POST(constants.CreateFamilyURL, p.CreateFamily())
 	router.DELETE(constants.DeleteFamilyURL, p.DeleteFamily())
 	router.DELETE(constants.RemoveUserUrl, p.RemoveUser())
+	router.POST(constants.LeaveFamilyURL, p.LeaveFamily())
 	router.DELETE(constants.RemoveMemberUrl, p.RemoveMember())
+	router.POST(constants.PromoteMemberURL, p.PromoteMember())
 	router.POST(constants.AddMembersToFamilyURL, p.AddMember())
 	router.GET(constants.GetFamilyURL, p.GetFamily())
+	router.GET(constants.HealthURL, p.GetHealth())
+	router.PUT(constants.EditHealthURL, p.EditHealth())
 	router.DELETE(constants.DeleteMedicine, p.DeleteMedicine())
 	router.POST(constants.AddMedicineURL, p.AddMedicine())
 	router.GET(constants.GetMedicineURL, p.GetMedicine())
 	router.PUT(constants.EditMedicineURL, p.EditMedicine())
+	router.GET(constants.SearchMedicineURL, p.SearchMedicine())
+	router.GET(constants.ExportMedicineURL, p.ExportMedicine())
+	router.POST(constants.ImportMedicineURL, p.ImportMedicine())
+	router.PUT(constants.DisposeMedicineURL, p.DisposeMedicine())
+	router.GET(constants.TrashURL, p.GetTrash())
+	router.PUT(constants.RestoreMedicineURL, p.RestoreMedicine())
+	router.POST(constants.ChangeStockURL, p.ChangeStock())
+	router.GET(constants.StockHistoryURL, p.GetStockHistory())
+	router.PUT(constants.MinCountURL, p.SetMinCount())
+	router.GET(constants.ShoppingListURL, p.GetShoppingList())
+	router.POST(constants.AddShoppingItemURL, p.AddShoppingItem())
+	router.PUT(constants.CheckShoppingItemURL, p.CheckShoppingItem())
+	router.DELETE(constants.DeleteShoppingItemURL, p.DeleteShoppingItem())
+	router.GET(constants.KitsURL, p.GetKits())
+	router.POST(constants.AddKitURL, p.AddKit())
+	router.PUT(constants.EditKitURL, p.EditKit())
+	router.DELETE(constants.DeleteKitURL, p.DeleteKit())
+	router.PUT(constants.MoveMedicineURL, p.MoveMedicine())
+	router.GET(constants.TemplatesURL, p.GetTemplates())
+	router.POST(constants.AddTemplateURL, p.AddTemplate())
+	router.POST(constants.ImportTemplateURL, p.ImportTemplate())
+	router.DELETE(constants.DeleteTemplateURL, p.DeleteTemplate())
+	router.POST(constants.CheckKitURL, p.CheckKit())
 	router.POST(constants.BarcodeURL, p.Barcode())
 	router.GET(constants.SearchURL, p.Search())
 	router.DELETE(constants.DeleteNotificationURL, p.DeleteNotification())
 	router.POST(constants.AddNotificationURL, p.AddNotification())
 	router.GET(constants.GetNotificationURL, p.GetNotification())
 	router.PUT(constants.AcceptMedicineURL, p.AcceptMedicine())
+	router.GET(constants.InteractionsURL, p.AuditRegimen())
+	router.POST(constants.AddCalendarURL, p.AddCalendar())
+	router.DELETE(constants.DeleteCalendarURL, p.DeleteCalendar())
+	router.GET(constants.CalendarURL+"/:token", p.GetCalendar())
+	router.GET(constants.ReportURL, p.GetReport())
+	router.GET(constants.ExportFHIRURL, p.ExportFHIR())
+	router.GET(constants.SettingsURL, p.GetNotificationSettings())
+	router.PUT(constants.EditSettingsURL, p.EditNotificationSettings())
+	router.GET(constants.WebhooksURL, p.GetWebhooks())
+	router.POST(constants.AddWebhookURL, p.AddWebhook())
+	router.DELETE(constants.DeleteWebhookURL, p.DeleteWebhook())
+	router.POST(constants.AddTelegramURL, p.AddTelegram())
+	router.DELETE(constants.DeleteTelegramURL, p.DeleteTelegram())
+	router.POST(constants.TelegramUpdateURL, p.TelegramUpdate())
+	router.GET(constants.PushKeyURL, p.GetPushKey())
+	router.POST(constants.AddPushURL, p.AddPushSubscription())
+	router.DELETE(constants.DeletePushURL, p.DeletePushSubscription())
+	router.PUT(constants.RescheduleURL, p.RescheduleNotification())
+	router.GET(constants.PRNRulesURL, p.GetPRNRules())
+	router.PUT(constants.EditPRNURL, p.EditPRNRule())
+	router.DELETE(constants.DeletePRNURL, p.DeletePRNRule())
+	router.POST(constants.AddPRNIntakeURL, p.AddPRNIntake())
 }
 
 func (p *profileHandler) ParseError(ctx echo.Context, requestID string, err error) error {
@@ -138,6 +204,20 @@ func (p *profileHandler) ParseError(ctx echo.Context, requestID string, err erro
 				return ctx.NoContent(http.StatusInternalServerError)
 			}
 			return ctx.JSONBlob(http.StatusBadRequest, resp)
+		case codes.NotFound:
+			p.logger.Info(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusNotFound),
+			)
+			resp, err := easyjson.Marshal(&models.Response{
+				Status:  http.StatusNotFound,
+				Message: getErr.Message(),
+			})
+			if err != nil {
+				return ctx.NoContent(http.StatusInternalServerError)
+			}
+			return ctx.JSONBlob(http.StatusNotFound, resp)
 		}
 	}
 	return nil
@@ -162,14 +242,15 @@ func (p *profileHandler) GetUserProfile() echo.HandlerFunc {
 		)
 
 		profileData := models.ProfileUserDTO{
-			ID:      userID,
-			Name:    userData.Name,
-			Surname: userData.Surname,
-			Email:   userData.Email,
-			Avatar:  userData.Avatar,
-			Date:    userData.Date,
-			Main:    userData.Main,
-			Adult:   userData.Adult,
+			ID:       userID,
+			Name:     userData.Name,
+			Surname:  userData.Surname,
+			Email:    userData.Email,
+			Avatar:   userData.Avatar,
+			Date:     userData.Date,
+			Main:     userData.Main,
+			Adult:    userData.Adult,
+			TimeZone: userData.TimeZone,
 		}
 
 		sanitizer := bluemonday.UGCPolicy()
@@ -292,6 +373,7 @@ func (p *profileHandler) EditProfile() echo.HandlerFunc {
 			Surname:  userData.Surname,
 			Password: userData.Password,
 			Date:     userData.Date,
+			TimeZone: userData.TimeZone,
 		}
 
 		_, err = p.profileMicroservice.EditProfile(context.Background(), data)
@@ -440,6 +522,74 @@ func (p *profileHandler) RemoveUser() echo.HandlerFunc {
 	}
 }
 
+func (p *profileHandler) LeaveFamily() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		data := &profile.UserID{ID: userID}
+		hasFamilyResp, err := p.profileMicroservice.HasFamily(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		profileData, err := p.profileMicroservice.GetUserProfile(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		_, err = p.profileMicroservice.LeaveFamily(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		dataMainUser := &profile.UserID{ID: hasFamilyResp.IDMainUser}
+		mainUserData, err := p.profileMicroservice.GetUserProfile(context.Background(), dataMainUser)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		from := "myaidkit@gmail.com"
+		password := os.Getenv("EMAILPASSWORD")
+
+		toList := []string{mainUserData.Email}
+
+		host := "smtp.gmail.com"
+		port := "587"
+
+		msg := "Пользователь " + profileData.Name + " " + profileData.Surname + " (" + profileData.Email + ") покинул вашу семью\r\n" +
+			"Его лекарства больше не входят в аптечку семьи, а напоминания по ним отменены.\r\n" +
+			"https://myaidkit.ru"
+
+		body := []byte(msg)
+
+		authSMTP := smtp.PlainAuth("", from, password, host)
+		err = smtp.SendMail(host+":"+port, authSMTP, from, toList, body)
+		if err != nil {
+			p.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+			)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.FamilyIsLeft,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
 func (p *profileHandler) RemoveMember() echo.HandlerFunc {
 	return func(ctx echo.Context) error {
 		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
@@ -476,6 +626,55 @@ func (p *profileHandler) RemoveMember() echo.HandlerFunc {
 	}
 }
 
+func (p *profileHandler) PromoteMember() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		promoteData := models.PromoteMemberDTO{}
+
+		if err = ctx.Bind(&promoteData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.UserID{ID: userID}
+		hasFamilyResp, err := p.profileMicroservice.HasFamily(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		if hasFamilyResp.IDMainUser != userID {
+			return constants.RespError(ctx, p.logger, requestID, constants.NotMainUser, http.StatusForbidden)
+		}
+
+		dataPromote := &profile.PromoteMemberData{
+			IDFamily: hasFamilyResp.IDFamily,
+			IDMember: promoteData.ID,
+			Email:    promoteData.Email,
+		}
+		_, err = p.profileMicroservice.PromoteMember(context.Background(), dataPromote)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.MemberIsPromoted,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
 func (p *profileHandler) AddMember() echo.HandlerFunc {
 	return func(ctx echo.Context) error {
 		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
@@ -584,8 +783,22 @@ func (p *profileHandler) GetFamily() echo.HandlerFunc {
 		if err != nil {
 			return p.ParseError(ctx, requestID, err)
 		}
-		data := &profile.UserID{
-			ID: userID,
+		page, err := pageFromQuery(ctx)
+		if err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.FamilyFilter{
+			UserID: userID,
+			Kind:   ctx.QueryParam("kind"),
+			Page:   page,
+		}
+
+		if ctx.QueryParam("adult") != "" {
+			data.OnlyAdults, err = strconv.ParseBool(ctx.QueryParam("adult"))
+			if err != nil {
+				return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+			}
 		}
 
 		family, err := p.profileMicroservice.GetFamily(context.Background(), data)
@@ -605,8 +818,157 @@ func (p *profileHandler) GetFamily() echo.HandlerFunc {
 		}
 
 		resp, err := easyjson.Marshal(&models.ResponseMembers{
-			Status:  200,
-			Members: membersResult,
+			Status:     200,
+			Members:    membersResult,
+			NextCursor: family.NextCursor,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+// personFromQuery читает из параметров запроса человека, к данным которого обращаются;
+// без параметров это сам пользователь
+func personFromQuery(ctx echo.Context, userID int64) (*profile.Person, error) {
+	person := &profile.Person{
+		UserID:   userID,
+		IsUser:   true,
+		IDPerson: userID,
+	}
+
+	if ctx.QueryParam("id") == "" {
+		return person, nil
+	}
+
+	var err error
+	person.IDPerson, err = strconv.ParseInt(ctx.QueryParam("id"), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	person.IsUser, err = strconv.ParseBool(ctx.QueryParam("is_user"))
+	if err != nil {
+		return nil, err
+	}
+
+	return person, nil
+}
+
+// pageFromQuery читает из параметров запроса ключ и направление сортировки, курсор и размер страницы;
+// без limit список возвращается целиком
+func pageFromQuery(ctx echo.Context) (*profile.Page, error) {
+	page := &profile.Page{
+		Sort:   ctx.QueryParam("sort"),
+		Desc:   ctx.QueryParam("order") == pagination.SortDesc,
+		Cursor: ctx.QueryParam("cursor"),
+	}
+
+	if ctx.QueryParam("order") != "" && ctx.QueryParam("order") != pagination.SortAsc && !page.Desc {
+		return nil, constants.ErrWrongSort
+	}
+
+	if ctx.QueryParam("limit") != "" {
+		var err error
+		page.Limit, err = strconv.ParseInt(ctx.QueryParam("limit"), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
+}
+
+func (p *profileHandler) GetHealth() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		data, err := personFromQuery(ctx, userID)
+		if err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		health, err := p.profileMicroservice.GetHealth(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		sanitizer := bluemonday.UGCPolicy()
+		healthResult := models.Health{
+			IsUser:            health.IsUser,
+			ID:                health.IDPerson,
+			BirthDate:         health.BirthDate,
+			Weight:            health.Weight,
+			Allergies:         make([]string, 0),
+			ChronicConditions: make([]string, 0),
+			Notes:             sanitizer.Sanitize(health.Notes),
+		}
+		for _, allergy := range health.Allergies {
+			healthResult.Allergies = append(healthResult.Allergies, sanitizer.Sanitize(allergy))
+		}
+		for _, condition := range health.ChronicConditions {
+			healthResult.ChronicConditions = append(healthResult.ChronicConditions, sanitizer.Sanitize(condition))
+		}
+
+		resp, err := easyjson.Marshal(&models.ResponseHealth{
+			Status: http.StatusOK,
+			Health: &healthResult,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) EditHealth() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		healthData := models.Health{}
+
+		if err = ctx.Bind(&healthData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		if healthData.ID == 0 {
+			healthData.ID = userID
+			healthData.IsUser = true
+		}
+
+		data := &profile.EditHealthData{
+			UserID: userID,
+			HealthData: &profile.HealthData{
+				IsUser:            healthData.IsUser,
+				IDPerson:          healthData.ID,
+				BirthDate:         healthData.BirthDate,
+				Weight:            healthData.Weight,
+				Allergies:         healthData.Allergies,
+				ChronicConditions: healthData.ChronicConditions,
+				Notes:             healthData.Notes,
+			},
+		}
+
+		_, err = p.profileMicroservice.EditHealth(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.HealthIsEdited,
 		})
 		if err != nil {
 			return ctx.NoContent(http.StatusInternalServerError)
@@ -692,6 +1054,21 @@ func (p *profileHandler) Invite() echo.HandlerFunc {
 			"/accept?family=" + strconv.Itoa(int(hasFamilyResp.IDFamily)) +
 			"&email=" + userData.Email + "&adult=" + strconv.FormatBool(userData.Adult)
 
+		// член семьи передаётся одноразовым токеном, который проверяется на сервере при принятии
+		if userData.Member != 0 {
+			inviteData := &profile.MemberInvite{
+				UserID:   userID,
+				IDMember: userData.Member,
+				Email:    userData.Email,
+				IsAdult:  userData.Adult,
+			}
+			invite, err := p.profileMicroservice.CreateMemberInvite(context.Background(), inviteData)
+			if err != nil {
+				return p.ParseError(ctx, requestID, err)
+			}
+			msg += "&member=" + invite.Token
+		}
+
 		body := []byte(msg)
 
 		authSMTP := smtp.PlainAuth("", from, password, host)
@@ -728,7 +1105,7 @@ func (p *profileHandler) AcceptInvitation() echo.HandlerFunc {
 			return ctx.JSONBlob(http.StatusInternalServerError, resp)
 		}
 
-		tmp := strings.Fields(strings.ReplaceAll(strings.ReplaceAll(strings.ReplaceAll(ctx.Request().Header.Get("Req"), "/accept?family=", ""), "&email=", " "), "&adult=", " "))
+		tmp := strings.Fields(strings.ReplaceAll(strings.ReplaceAll(strings.ReplaceAll(strings.ReplaceAll(ctx.Request().Header.Get("Req"), "/accept?family=", ""), "&email=", " "), "&adult=", " "), "&member=", " "))
 
 		family := tmp[0]
 		email := tmp[1]
@@ -744,14 +1121,27 @@ func (p *profileHandler) AcceptInvitation() echo.HandlerFunc {
 			return ctx.NoContent(http.StatusInternalServerError)
 		}
 
-		data := &profile.AddToFamily{
-			ID:      int64(familyID),
-			Email:   email,
-			IsAdult: isAdult,
-		}
-		_, err = p.profileMicroservice.AcceptInvitationToFamily(context.Background(), data)
-		if err != nil {
-			return ctx.NoContent(http.StatusInternalServerError)
+		if len(tmp) > 3 {
+			// приглашение на место члена семьи без аккаунта: семья, член семьи и возраст берутся
+			// из токена, а не из ссылки, и история переносится только по действующему токену
+			inviteData := &profile.AcceptMemberInviteData{
+				Token: tmp[3],
+				Email: email,
+			}
+			_, err = p.profileMicroservice.AcceptMemberInvite(context.Background(), inviteData)
+			if err != nil {
+				return p.ParseError(ctx, requestID, err)
+			}
+		} else {
+			data := &profile.AddToFamily{
+				ID:      int64(familyID),
+				Email:   email,
+				IsAdult: isAdult,
+			}
+			_, err = p.profileMicroservice.AcceptInvitationToFamily(context.Background(), data)
+			if err != nil {
+				return ctx.NoContent(http.StatusInternalServerError)
+			}
 		}
 
 		p.logger.Info(
@@ -846,10 +1236,18 @@ func (p *profileHandler) AddMedicine() echo.HandlerFunc {
 		data := &profile.AddMed{
 			UserID: userID,
 			Medicine: &profile.Medicine{
-				Image:     fileName,
-				Name:      medicineData.Name,
-				IsTablets: medicineData.IsTablets,
-				Count:     medicineData.Count,
+				Image:       fileName,
+				Name:        medicineData.Name,
+				Lot:         medicineData.Lot,
+				IsTablets:   medicineData.IsTablets,
+				Count:       medicineData.Count,
+				Form:        medicineData.Form,
+				Strength:    medicineData.Strength,
+				Unit:        medicineData.Unit,
+				Ingredients: medicineData.Ingredients,
+				MinCount:    medicineData.MinCount,
+				ExpiryDate:  medicineData.ExpiryDate,
+				IDKit:       medicineData.IDKit,
 			},
 		}
 
@@ -916,9 +1314,31 @@ func (p *profileHandler) GetMedicine() echo.HandlerFunc {
 			return p.ParseError(ctx, requestID, err)
 		}
 
-		data := &profile.UserID{
-			ID: userID,
+		page, err := pageFromQuery(ctx)
+		if err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.MedicineFilter{
+			UserID:        userID,
+			ExpiresBefore: ctx.QueryParam("expires_before"),
+			Page:          page,
+		}
+
+		if ctx.QueryParam("kit") != "" {
+			data.IDKit, err = strconv.ParseInt(ctx.QueryParam("kit"), 10, 64)
+			if err != nil {
+				return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+			}
+		}
+
+		if ctx.QueryParam("owner") != "" {
+			data.IDOwner, err = strconv.ParseInt(ctx.QueryParam("owner"), 10, 64)
+			if err != nil {
+				return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+			}
 		}
+
 		medicines, err := p.profileMicroservice.GetMedicine(context.Background(), data)
 		if err != nil {
 			return p.ParseError(ctx, requestID, err)
@@ -927,17 +1347,30 @@ func (p *profileHandler) GetMedicine() echo.HandlerFunc {
 		medicineResult := make([]models.Medicine, 0)
 		for _, medicine := range medicines.MedicineArr {
 			medicineResult = append(medicineResult, models.Medicine{
-				ID:        medicine.ID,
-				Name:      medicine.Medicine.Name,
-				Image:     medicine.Medicine.Image,
-				IsTablets: medicine.Medicine.IsTablets,
-				Count:     medicine.Medicine.Count,
+				ID:           medicine.ID,
+				Name:         medicine.Medicine.Name,
+				Image:        medicine.Medicine.Image,
+				IsTablets:    medicine.Medicine.IsTablets,
+				Count:        medicine.Medicine.Count,
+				Form:         medicine.Medicine.Form,
+				Strength:     medicine.Medicine.Strength,
+				Unit:         medicine.Medicine.Unit,
+				Ingredients:  medicine.Medicine.Ingredients,
+				MinCount:     medicine.Medicine.MinCount,
+				DaysOfSupply: medicine.Medicine.DaysOfSupply,
+				RunOutDate:   medicine.Medicine.RunOutDate,
+				ExpiryDate:   medicine.Medicine.ExpiryDate,
+				IDKit:        medicine.Medicine.IDKit,
+				KitName:      medicine.Medicine.KitName,
+				Lot:          medicine.Medicine.Lot,
+				KitLocation:  medicine.Medicine.KitLocation,
 			})
 		}
 
 		resp, err := easyjson.Marshal(&models.ResponseMedicine{
-			Status:   200,
-			Medicine: medicineResult,
+			Status:     200,
+			Medicine:   medicineResult,
+			NextCursor: medicines.NextCursor,
 		})
 		if err != nil {
 			return ctx.NoContent(http.StatusInternalServerError)
@@ -1030,8 +1463,17 @@ func (p *profileHandler) EditMedicine() echo.HandlerFunc {
 		}
 
 		data.ID = medicineData.ID
+		data.UserID = userID
 		data.Medicine.Name = medicineData.Name
 		data.Medicine.Count = medicineData.Count
+		data.Medicine.Form = medicineData.Form
+		data.Medicine.Strength = medicineData.Strength
+		data.Medicine.Unit = medicineData.Unit
+		data.Medicine.Ingredients = medicineData.Ingredients
+		// пустой список очищает состав, отсутствующий оставляет его без изменений
+		data.Medicine.IngredientsSet = medicineData.Ingredients != nil
+		data.Medicine.ExpiryDate = medicineData.ExpiryDate
+		data.Medicine.Lot = medicineData.Lot
 		_, err = p.profileMicroservice.EditMedicine(context.Background(), data)
 		if err != nil {
 			return p.ParseError(ctx, requestID, err)
@@ -1053,26 +1495,151 @@ func (p *profileHandler) EditMedicine() echo.HandlerFunc {
 	}
 }
 
-func (p *profileHandler) Barcode() echo.HandlerFunc {
+func (p *profileHandler) ChangeStock() echo.HandlerFunc {
 	return func(ctx echo.Context) error {
-		_, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
 		if err != nil {
 			return p.ParseError(ctx, requestID, err)
 		}
 
-		file, err := ctx.FormFile("file")
-		if err != nil {
-			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusInternalServerError)
+		stockData := models.StockChangeDTO{}
+
+		if err = ctx.Bind(&stockData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
 		}
 
-		src, err := file.Open()
+		data := &profile.StockChange{
+			UserID:     userID,
+			IDMedicine: stockData.ID,
+			Delta:      stockData.Delta,
+			Reason:     stockData.Reason,
+		}
+		_, err = p.profileMicroservice.ChangeStock(context.Background(), data)
 		if err != nil {
-			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusInternalServerError)
+			return p.ParseError(ctx, requestID, err)
 		}
 
-		buffer := make([]byte, file.Size)
-		_, err = src.Read(buffer)
-		if err != nil {
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.StockIsChanged,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) SetMinCount() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		minCountData := models.MinCountDTO{}
+
+		if err = ctx.Bind(&minCountData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.MinCountData{
+			UserID:     userID,
+			IDMedicine: minCountData.ID,
+			MinCount:   minCountData.MinCount,
+		}
+		_, err = p.profileMicroservice.SetMinCount(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.MinCountIsSet,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) GetStockHistory() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		medicineID, err := strconv.ParseInt(ctx.QueryParam("id"), 10, 64)
+		if err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.MedicineRequest{
+			UserID:     userID,
+			IDMedicine: medicineID,
+		}
+		history, err := p.profileMicroservice.GetStockHistory(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		entries := make([]models.StockEntry, 0)
+		for _, entry := range history.Entries {
+			entries = append(entries, models.StockEntry{
+				ID:       entry.ID,
+				IDUser:   entry.IDUser,
+				NameUser: entry.NameUser,
+				Delta:    entry.Delta,
+				Reason:   entry.Reason,
+				Time:     entry.Time,
+			})
+		}
+
+		resp, err := easyjson.Marshal(&models.ResponseStockHistory{
+			Status:  http.StatusOK,
+			Count:   history.Count,
+			Unit:    history.Unit,
+			History: entries,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) Barcode() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		_, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		file, err := ctx.FormFile("file")
+		if err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusInternalServerError)
+		}
+
+		src, err := file.Open()
+		if err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusInternalServerError)
+		}
+
+		buffer := make([]byte, file.Size)
+		_, err = src.Read(buffer)
+		if err != nil {
 			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusInternalServerError)
 		}
 		err = src.Close()
@@ -1242,7 +1809,6 @@ func (p *profileHandler) AddNotification() echo.HandlerFunc {
 			IDToUser:     0,
 			NameTo:       "",
 			Time:         "",
-			TimeZone:     0,
 			CountDays:    0,
 		}
 
@@ -1250,23 +1816,8 @@ func (p *profileHandler) AddNotification() echo.HandlerFunc {
 			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
 		}
 
-		timeZone := ""
-		if notificationData.TimeZone > 0 {
-			timeZone = "+" + strconv.Itoa(int(notificationData.TimeZone))
-		} else {
-			timeZone = strconv.Itoa(int(notificationData.TimeZone))
-		}
-
-		loc := time.FixedZone("UTC"+timeZone, int(notificationData.TimeZone)*3600)
-		currentTime := time.Now().In(loc)
-
-		var firstDay time.Time
-		if currentTime.Format("15:04") > notificationData.Time {
-			firstDay = currentTime.AddDate(0, 0, 1)
-		} else {
-			firstDay = currentTime
-		}
-
+		// время приёма задаётся по местному времени получателя, дни считаются в его часовом поясе
+		warnings := make([]models.Warning, 0)
 		for i := 0; i < int(notificationData.CountDays); i++ {
 			data := &profile.NotificationData{
 				IDFrom:       userID,
@@ -1275,13 +1826,43 @@ func (p *profileHandler) AddNotification() echo.HandlerFunc {
 				NameTo:       notificationData.NameTo,
 				IDMedicine:   notificationData.IDMedicine,
 				NameMedicine: notificationData.NameMedicine,
-				Time:         firstDay.Add(time.Hour*24*time.Duration(i)).Format("2006-01-02") + " " + notificationData.Time + timeZone,
+				Clock:        notificationData.Time,
+				Day:          int64(i),
+				Override:     notificationData.Override,
 			}
 
-			_, err = p.profileMicroservice.AddNotification(context.Background(), data)
+			result, err := p.profileMicroservice.AddNotification(context.Background(), data)
 			if err != nil {
 				return p.ParseError(ctx, requestID, err)
 			}
+
+			if i == 0 {
+				for _, warning := range result.Warnings {
+					warnings = append(warnings, models.Warning{
+						Type:       warning.Type,
+						Ingredient: warning.Ingredient,
+						Message:    warning.Message,
+						Severity:   warning.Severity,
+					})
+				}
+			}
+
+			if !result.Added {
+				p.logger.Info(
+					zap.String("ID", requestID),
+					zap.Int("ANSWER STATUS", http.StatusConflict),
+				)
+
+				resp, err := easyjson.Marshal(&models.ResponseWarnings{
+					Status:   http.StatusConflict,
+					Message:  constants.NotificationHasWarnings,
+					Warnings: warnings,
+				})
+				if err != nil {
+					return ctx.NoContent(http.StatusInternalServerError)
+				}
+				return ctx.JSONBlob(http.StatusConflict, resp)
+			}
 		}
 
 		p.logger.Info(
@@ -1289,9 +1870,10 @@ func (p *profileHandler) AddNotification() echo.HandlerFunc {
 			zap.Int("ANSWER STATUS", http.StatusOK),
 		)
 
-		resp, err := easyjson.Marshal(&models.Response{
-			Status:  http.StatusOK,
-			Message: constants.NotificationsAreAdded,
+		resp, err := easyjson.Marshal(&models.ResponseWarnings{
+			Status:   http.StatusOK,
+			Message:  constants.NotificationsAreAdded,
+			Warnings: warnings,
 		})
 		if err != nil {
 			return ctx.NoContent(http.StatusInternalServerError)
@@ -1342,9 +1924,38 @@ func (p *profileHandler) GetNotification() echo.HandlerFunc {
 			return p.ParseError(ctx, requestID, err)
 		}
 
-		data := &profile.UserID{
-			ID: userID,
+		page, err := pageFromQuery(ctx)
+		if err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.NotificationFilter{
+			UserID:   userID,
+			From:     ctx.QueryParam("from"),
+			To:       ctx.QueryParam("to"),
+			Accepted: ctx.QueryParam("accepted"),
+			Page:     page,
+		}
+
+		if ctx.QueryParam("member") != "" {
+			data.IDPerson, err = strconv.ParseInt(ctx.QueryParam("member"), 10, 64)
+			if err != nil {
+				return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+			}
+
+			data.IsUser, err = strconv.ParseBool(ctx.QueryParam("is_user"))
+			if err != nil {
+				return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+			}
+		}
+
+		if ctx.QueryParam("medicine") != "" {
+			data.IDMedicine, err = strconv.ParseInt(ctx.QueryParam("medicine"), 10, 64)
+			if err != nil {
+				return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+			}
 		}
+
 		notifications, err := p.profileMicroservice.GetNotifications(context.Background(), data)
 		if err != nil {
 			return p.ParseError(ctx, requestID, err)
@@ -1366,6 +1977,7 @@ func (p *profileHandler) GetNotification() echo.HandlerFunc {
 		resp, err := easyjson.Marshal(&models.ResponseNotification{
 			Status:        200,
 			Notifications: notificationResult,
+			NextCursor:    notifications.NextCursor,
 		})
 		if err != nil {
 			return ctx.NoContent(http.StatusInternalServerError)
@@ -1376,7 +1988,7 @@ func (p *profileHandler) GetNotification() echo.HandlerFunc {
 
 func (p *profileHandler) AcceptMedicine() echo.HandlerFunc {
 	return func(ctx echo.Context) error {
-		_, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
 		if err != nil {
 			return p.ParseError(ctx, requestID, err)
 		}
@@ -1388,8 +2000,9 @@ func (p *profileHandler) AcceptMedicine() echo.HandlerFunc {
 		}
 
 		data := &profile.Accept{
-			ID:    dataAccept.IDNotification,
-			Count: dataAccept.Count,
+			ID:     dataAccept.IDNotification,
+			Count:  dataAccept.Count,
+			UserID: userID,
 		}
 		_, err = p.profileMicroservice.AcceptNotification(context.Background(), data)
 		if err != nil {
@@ -1406,3 +2019,2227 @@ func (p *profileHandler) AcceptMedicine() echo.HandlerFunc {
 		return ctx.JSONBlob(http.StatusOK, resp)
 	}
 }
+
+// RescheduleNotification откладывает напоминание на minutes минут или переносит один приём
+// на время time (RFC3339) либо на местное время clock дня day
+func (p *profileHandler) RescheduleNotification() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		rescheduleData := models.RescheduleDTO{}
+
+		if err = ctx.Bind(&rescheduleData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.RescheduleData{
+			UserID:  userID,
+			ID:      rescheduleData.IDNotification,
+			Minutes: rescheduleData.Minutes,
+			Time:    rescheduleData.Time,
+			Clock:   rescheduleData.Clock,
+			Day:     rescheduleData.Day,
+		}
+		result, err := p.profileMicroservice.RescheduleNotification(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.ResponseReschedule{
+			Status: http.StatusOK,
+			Time:   result.Time,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) GetShoppingList() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		data := &profile.UserID{
+			ID: userID,
+		}
+		list, err := p.profileMicroservice.GetShoppingList(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		items := make([]models.ShoppingItem, 0)
+		for _, item := range list.Items {
+			items = append(items, models.ShoppingItem{
+				ID:         item.ID,
+				IDUser:     item.IDUser,
+				IDMedicine: item.IDMedicine,
+				Name:       item.Name,
+				Count:      item.Count,
+				Unit:       item.Unit,
+				Reason:     item.Reason,
+				IsBought:   item.IsBought,
+				IDBoughtBy: item.IDBoughtBy,
+				Created:    item.Created,
+			})
+		}
+
+		resp, err := easyjson.Marshal(&models.ResponseShoppingList{
+			Status: http.StatusOK,
+			Items:  items,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) AddShoppingItem() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		itemData := models.ShoppingItemDTO{}
+
+		if err = ctx.Bind(&itemData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.ShoppingItemData{
+			UserID:     userID,
+			IDMedicine: itemData.IDMedicine,
+			Name:       itemData.Name,
+			Count:      itemData.Count,
+			Unit:       itemData.Unit,
+		}
+		_, err = p.profileMicroservice.AddShoppingItem(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.ShoppingItemIsAdded,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) CheckShoppingItem() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		itemData := models.ShoppingItemIDDTO{}
+
+		if err = ctx.Bind(&itemData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.ShoppingItemRequest{
+			UserID: userID,
+			ID:     itemData.ID,
+		}
+		_, err = p.profileMicroservice.CheckShoppingItem(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.ShoppingItemIsChecked,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) DeleteShoppingItem() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		itemData := models.ShoppingItemIDDTO{}
+
+		if err = ctx.Bind(&itemData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.ShoppingItemRequest{
+			UserID: userID,
+			ID:     itemData.ID,
+		}
+		_, err = p.profileMicroservice.DeleteShoppingItem(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.ShoppingItemIsDeleted,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) GetKits() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		data := &profile.UserID{
+			ID: userID,
+		}
+		kits, err := p.profileMicroservice.GetKits(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		kitResult := make([]models.Kit, 0)
+		for _, kit := range kits.Kits {
+			kitResult = append(kitResult, models.Kit{
+				ID:       kit.ID,
+				IDUser:   kit.IDUser,
+				Name:     kit.Name,
+				Location: kit.Location,
+			})
+		}
+
+		resp, err := easyjson.Marshal(&models.ResponseKits{
+			Status: http.StatusOK,
+			Kits:   kitResult,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) AddKit() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		kitData := models.Kit{}
+
+		if err = ctx.Bind(&kitData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.KitData{
+			UserID: userID,
+			Kit: &profile.Kit{
+				Name:     kitData.Name,
+				Location: kitData.Location,
+			},
+		}
+		_, err = p.profileMicroservice.AddKit(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.KitIsAdded,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) EditKit() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		kitData := models.Kit{}
+
+		if err = ctx.Bind(&kitData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.KitData{
+			UserID: userID,
+			Kit: &profile.Kit{
+				ID:       kitData.ID,
+				Name:     kitData.Name,
+				Location: kitData.Location,
+			},
+		}
+		_, err = p.profileMicroservice.EditKit(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.KitIsEdited,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) DeleteKit() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		kitData := models.KitIDDTO{}
+
+		if err = ctx.Bind(&kitData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.KitRequest{
+			UserID: userID,
+			ID:     kitData.ID,
+		}
+		_, err = p.profileMicroservice.DeleteKit(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.KitIsDeleted,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) MoveMedicine() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		moveData := models.MoveMedicineDTO{}
+
+		if err = ctx.Bind(&moveData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.MoveMedicineData{
+			UserID:     userID,
+			IDMedicine: moveData.ID,
+			IDKit:      moveData.IDKit,
+			Count:      moveData.Count,
+		}
+		_, err = p.profileMicroservice.MoveMedicine(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.MedicineIsMoved,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) GetTemplates() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		data := &profile.UserID{
+			ID: userID,
+		}
+		kitTemplates, err := p.profileMicroservice.GetTemplates(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		templateResult := make([]models.Template, 0)
+		for _, template := range kitTemplates.Templates {
+			items := make([]models.TemplateItem, 0)
+			for _, item := range template.Items {
+				items = append(items, models.TemplateItem{
+					Name:    item.Name,
+					Keyword: item.Keyword,
+					Count:   item.Count,
+					Unit:    item.Unit,
+				})
+			}
+			templateResult = append(templateResult, models.Template{
+				ID:     template.ID,
+				IDUser: template.IDUser,
+				Name:   template.Name,
+				Items:  items,
+			})
+		}
+
+		resp, err := easyjson.Marshal(&models.ResponseTemplates{
+			Status:    http.StatusOK,
+			Templates: templateResult,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) AddTemplate() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		templateData := models.Template{}
+
+		if err = ctx.Bind(&templateData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		items := make([]*profile.TemplateItem, 0)
+		for _, item := range templateData.Items {
+			items = append(items, &profile.TemplateItem{
+				Name:    item.Name,
+				Keyword: item.Keyword,
+				Count:   item.Count,
+				Unit:    item.Unit,
+			})
+		}
+
+		data := &profile.TemplateData{
+			UserID: userID,
+			Template: &profile.Template{
+				Name:  templateData.Name,
+				Items: items,
+			},
+		}
+		_, err = p.profileMicroservice.AddTemplate(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.TemplateIsAdded,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+// ImportTemplate загружает шаблоны из csv-файла в том же формате, что и встроенный справочник
+func (p *profileHandler) ImportTemplate() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		file, err := ctx.FormFile("file")
+		if err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		src, err := file.Open()
+		if err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusInternalServerError)
+		}
+		defer func(src multipart.File) {
+			err = src.Close()
+			if err != nil {
+				return
+			}
+		}(src)
+
+		kitTemplates, err := templates.Parse(src)
+		if err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		for _, template := range kitTemplates {
+			items := make([]*profile.TemplateItem, 0)
+			for _, item := range template.Items {
+				items = append(items, &profile.TemplateItem{
+					Name:    item.Name,
+					Keyword: item.Keyword,
+					Count:   item.Count,
+					Unit:    item.Unit,
+				})
+			}
+
+			data := &profile.TemplateData{
+				UserID: userID,
+				Template: &profile.Template{
+					Name:  template.Name,
+					Items: items,
+				},
+			}
+			_, err = p.profileMicroservice.AddTemplate(context.Background(), data)
+			if err != nil {
+				return p.ParseError(ctx, requestID, err)
+			}
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.TemplateIsAdded,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) DeleteTemplate() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		templateData := models.TemplateIDDTO{}
+
+		if err = ctx.Bind(&templateData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.TemplateRequest{
+			UserID: userID,
+			ID:     templateData.ID,
+		}
+		_, err = p.profileMicroservice.DeleteTemplate(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.TemplateIsDeleted,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) CheckKit() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		checkData := models.KitCheckDTO{}
+
+		if err = ctx.Bind(&checkData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.KitCheck{
+			UserID:            userID,
+			IDKit:             checkData.IDKit,
+			IDTemplate:        checkData.IDTemplate,
+			AddToShoppingList: checkData.Shopping,
+		}
+		report, err := p.profileMicroservice.CheckKit(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		itemResult := make([]models.KitReportItem, 0)
+		for _, item := range report.Items {
+			itemResult = append(itemResult, models.KitReportItem{
+				Name:      item.Name,
+				Required:  item.Required,
+				Available: item.Available,
+				Expired:   item.Expired,
+				Unit:      item.Unit,
+				Status:    item.Status,
+			})
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.ResponseKitReport{
+			Status: http.StatusOK,
+			Items:  itemResult,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) SearchMedicine() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		data := &profile.MedicineSearch{
+			UserID: userID,
+			Text:   ctx.QueryParam("q"),
+			Sort:   ctx.QueryParam("sort"),
+		}
+
+		if ctx.QueryParam("limit") != "" {
+			data.Limit, err = strconv.ParseInt(ctx.QueryParam("limit"), 10, 64)
+			if err != nil {
+				return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+			}
+		}
+
+		if ctx.QueryParam("offset") != "" {
+			data.Offset, err = strconv.ParseInt(ctx.QueryParam("offset"), 10, 64)
+			if err != nil {
+				return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+			}
+		}
+
+		result, err := p.profileMicroservice.SearchMedicine(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		medicineResult := make([]models.Medicine, 0)
+		for _, medicine := range result.Medicines {
+			medicineResult = append(medicineResult, models.Medicine{
+				ID:          medicine.ID,
+				Name:        medicine.Medicine.Name,
+				Image:       medicine.Medicine.Image,
+				IsTablets:   medicine.Medicine.IsTablets,
+				Count:       medicine.Medicine.Count,
+				Form:        medicine.Medicine.Form,
+				Strength:    medicine.Medicine.Strength,
+				Unit:        medicine.Medicine.Unit,
+				Ingredients: medicine.Medicine.Ingredients,
+				MinCount:    medicine.Medicine.MinCount,
+				ExpiryDate:  medicine.Medicine.ExpiryDate,
+				IDKit:       medicine.Medicine.IDKit,
+				KitName:     medicine.Medicine.KitName,
+			})
+		}
+
+		resp, err := easyjson.Marshal(&models.ResponseMedicineSearch{
+			Status:   http.StatusOK,
+			Medicine: medicineResult,
+			HasMore:  result.HasMore,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+// ExportMedicine выгружает лекарства пользователя или его семьи в csv или json (параметр format)
+func (p *profileHandler) ExportMedicine() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		format := ctx.QueryParam("format")
+		if format == "" {
+			format = inventory.FormatCSV
+		}
+		if format != inventory.FormatCSV && format != inventory.FormatJSON {
+			return constants.RespError(ctx, p.logger, requestID, inventory.ErrWrongFormat.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.MedicineFilter{
+			UserID: userID,
+		}
+
+		if ctx.QueryParam("kit") != "" {
+			data.IDKit, err = strconv.ParseInt(ctx.QueryParam("kit"), 10, 64)
+			if err != nil {
+				return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+			}
+		}
+
+		medicines, err := p.profileMicroservice.GetMedicine(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		rows := make([]inventory.Row, 0, len(medicines.MedicineArr))
+		for _, medicine := range medicines.MedicineArr {
+			rows = append(rows, inventory.Row{
+				Name:        medicine.Medicine.Name,
+				Lot:         medicine.Medicine.Lot,
+				Count:       medicine.Medicine.Count,
+				Unit:        medicine.Medicine.Unit,
+				Form:        medicine.Medicine.Form,
+				Strength:    medicine.Medicine.Strength,
+				Ingredients: medicine.Medicine.Ingredients,
+				MinCount:    medicine.Medicine.MinCount,
+				ExpiryDate:  medicine.Medicine.ExpiryDate,
+				Kit:         medicine.Medicine.KitName,
+				Location:    medicine.Medicine.KitLocation,
+				Image:       medicine.Medicine.Image,
+			})
+		}
+
+		var buffer bytes.Buffer
+		contentType := "text/csv; charset=utf-8"
+		if format == inventory.FormatJSON {
+			contentType = echo.MIMEApplicationJSONCharsetUTF8
+			err = inventory.WriteJSON(&buffer, rows)
+		} else {
+			err = inventory.WriteCSV(&buffer, rows)
+		}
+		if err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusInternalServerError)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		ctx.Response().Header().Set(echo.HeaderContentDisposition, "attachment; filename=medicines."+format)
+		return ctx.Blob(http.StatusOK, contentType, buffer.Bytes())
+	}
+}
+
+// ImportMedicine загружает лекарства из csv- или json-файла в формате ExportMedicine
+// и возвращает результат по каждой строке файла
+func (p *profileHandler) ImportMedicine() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		file, err := ctx.FormFile("file")
+		if err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		format := ctx.QueryParam("format")
+		if format == "" {
+			format = strings.ToLower(strings.TrimPrefix(filepath.Ext(file.Filename), "."))
+		}
+		if format != inventory.FormatCSV && format != inventory.FormatJSON {
+			return constants.RespError(ctx, p.logger, requestID, inventory.ErrWrongFormat.Error(), http.StatusBadRequest)
+		}
+
+		src, err := file.Open()
+		if err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusInternalServerError)
+		}
+		defer func(src multipart.File) {
+			err = src.Close()
+			if err != nil {
+				return
+			}
+		}(src)
+
+		var rows []inventory.Row
+		rowErrors := make([]inventory.RowError, 0)
+		if format == inventory.FormatJSON {
+			rows, err = inventory.ParseJSON(src)
+		} else {
+			rows, rowErrors, err = inventory.ParseCSV(src)
+		}
+		if err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		// номера строк нужны для отчёта, строки csv с ошибками разбора в загрузку не попадают
+		skipped := make(map[int64]bool, len(rowErrors))
+		for _, rowError := range rowErrors {
+			skipped[rowError.Row] = true
+		}
+
+		data := &profile.MedicineImport{
+			UserID: userID,
+		}
+		number := int64(0)
+		for _, row := range rows {
+			number++
+			for skipped[number] {
+				number++
+			}
+			data.Rows = append(data.Rows, &profile.ImportRow{
+				Row: number,
+				Medicine: &profile.Medicine{
+					Name:        row.Name,
+					Lot:         row.Lot,
+					Count:       row.Count,
+					Unit:        row.Unit,
+					Form:        row.Form,
+					Strength:    row.Strength,
+					Ingredients: row.Ingredients,
+					MinCount:    row.MinCount,
+					ExpiryDate:  row.ExpiryDate,
+					KitName:     row.Kit,
+					KitLocation: row.Location,
+				},
+			})
+		}
+
+		report, err := p.profileMicroservice.ImportMedicines(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		results := make([]models.ImportResult, 0, len(report.Results)+len(rowErrors))
+		for _, result := range report.Results {
+			results = append(results, models.ImportResult{
+				Row:        result.Row,
+				IDMedicine: result.IDMedicine,
+				Created:    result.Created,
+				Error:      result.Error,
+			})
+		}
+		for _, rowError := range rowErrors {
+			results = append(results, models.ImportResult{
+				Row:   rowError.Row,
+				Error: rowError.Message,
+			})
+		}
+		sort.Slice(results, func(i, j int) bool {
+			return results[i].Row < results[j].Row
+		})
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.ResponseImport{
+			Status:  http.StatusOK,
+			Results: results,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) DisposeMedicine() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		disposeData := models.DisposeMedicineDTO{}
+
+		if err = ctx.Bind(&disposeData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.DisposeData{
+			UserID:     userID,
+			IDMedicine: disposeData.ID,
+			Reason:     disposeData.Reason,
+			Date:       disposeData.Date,
+		}
+		_, err = p.profileMicroservice.DisposeMedicine(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.MedicineIsDisposed,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) GetTrash() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		data := &profile.UserID{
+			ID: userID,
+		}
+		medicines, err := p.profileMicroservice.GetTrash(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		medicineResult := make([]models.Medicine, 0)
+		for _, medicine := range medicines.MedicineArr {
+			medicineResult = append(medicineResult, models.Medicine{
+				ID:            medicine.ID,
+				Name:          medicine.Medicine.Name,
+				Image:         medicine.Medicine.Image,
+				IsTablets:     medicine.Medicine.IsTablets,
+				Count:         medicine.Medicine.Count,
+				Form:          medicine.Medicine.Form,
+				Strength:      medicine.Medicine.Strength,
+				Unit:          medicine.Medicine.Unit,
+				Ingredients:   medicine.Medicine.Ingredients,
+				MinCount:      medicine.Medicine.MinCount,
+				ExpiryDate:    medicine.Medicine.ExpiryDate,
+				IDKit:         medicine.Medicine.IDKit,
+				KitName:       medicine.Medicine.KitName,
+				DisposeReason: medicine.Medicine.DisposeReason,
+				DisposedDate:  medicine.Medicine.DisposedDate,
+			})
+		}
+
+		resp, err := easyjson.Marshal(&models.ResponseMedicine{
+			Status:   http.StatusOK,
+			Medicine: medicineResult,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) RestoreMedicine() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		medicineData := models.MedecineIDDTO{}
+
+		if err = ctx.Bind(&medicineData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.MedicineRequest{
+			UserID:     userID,
+			IDMedicine: medicineData.ID,
+		}
+		_, err = p.profileMicroservice.RestoreMedicine(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.MedicineIsRestored,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func calendarPerson(ctx echo.Context, userID int64) (*profile.Person, error) {
+	calendarData := models.CalendarDTO{}
+	if err := ctx.Bind(&calendarData); err != nil {
+		return nil, err
+	}
+
+	if calendarData.ID == 0 {
+		return &profile.Person{UserID: userID, IsUser: true, IDPerson: userID}, nil
+	}
+	return &profile.Person{UserID: userID, IsUser: calendarData.IsUser, IDPerson: calendarData.ID}, nil
+}
+
+// AddCalendar выдаёт секретную ссылку на календарь приёмов; повторный вызов заменяет прежнюю ссылку
+func (p *profileHandler) AddCalendar() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		data, err := calendarPerson(ctx, userID)
+		if err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		token, err := p.profileMicroservice.CreateCalendarToken(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.ResponseCalendar{
+			Status: http.StatusOK,
+			Token:  token.Token,
+			URL:    ctx.Scheme() + "://" + ctx.Request().Host + constants.CalendarURL + "/" + token.Token + ".ics",
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) DeleteCalendar() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		data, err := calendarPerson(ctx, userID)
+		if err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		_, err = p.profileMicroservice.RevokeCalendarToken(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.CalendarIsRevoked,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+// GetCalendar отдаёт календарь приёмов в формате iCalendar; сессия не нужна,
+// доступ определяется секретным токеном из ссылки
+func (p *profileHandler) GetCalendar() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		requestID, ok := ctx.Get("REQUEST_ID").(string)
+		if !ok {
+			return constants.RespError(ctx, p.logger, requestID, constants.NoRequestID, http.StatusInternalServerError)
+		}
+
+		data := &profile.CalendarToken{
+			Token: strings.TrimSuffix(ctx.Param("token"), ".ics"),
+		}
+		feed, err := p.profileMicroservice.GetCalendar(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		events := make([]calendar.Event, 0, len(feed.Events))
+		for _, event := range feed.Events {
+			start, err := time.Parse(time.RFC3339, event.NotificationData.Time)
+			if err != nil {
+				return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusInternalServerError)
+			}
+
+			events = append(events, calendar.Event{
+				UID:         strconv.FormatInt(event.ID, 10) + "@myaidkit.ru",
+				Summary:     event.NotificationData.NameMedicine,
+				Description: feed.Name + ": " + event.NotificationData.NameMedicine,
+				Start:       start,
+				Duration:    constants.CalendarEventMinutes * time.Minute,
+				Alarm:       constants.CalendarAlarmMinutes * time.Minute,
+			})
+		}
+
+		var buffer bytes.Buffer
+		if err = calendar.Write(&buffer, feed.Name, events, time.Now()); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusInternalServerError)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		return ctx.Blob(http.StatusOK, calendar.ContentType, buffer.Bytes())
+	}
+}
+
+var stockReasonNames = map[string]string{
+	stock.ReasonIntake:     "приём",
+	stock.ReasonRestock:    "пополнение",
+	stock.ReasonCorrection: "корректировка",
+	stock.ReasonDisposal:   "списание",
+	stock.ReasonExpiry:     "истёк срок",
+	stock.ReasonTransfer:   "перемещение",
+}
+
+var doseChangeNames = map[string]string{
+	constants.DoseSnoozed:     "отложен",
+	constants.DoseRescheduled: "перенесён",
+	constants.DoseSkipped:     "пропущен",
+}
+
+// reportTime переводит время из RFC 3339 в вид для печати, сохраняя местное время
+func reportTime(value string) string {
+	at, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return value
+	}
+	return at.Format("2006-01-02 15:04")
+}
+
+func reportList(values []string) string {
+	if len(values) == 0 {
+		return "не указаны"
+	}
+	return strings.Join(values, ", ")
+}
+
+// adherenceDocument раскладывает отчёт о приёме лекарств по разделам документа для печати
+func adherenceDocument(adherence *profile.AdherenceReport, now time.Time) *report.Document {
+	document := report.New("Отчёт о приёме лекарств: " + adherence.Name)
+
+	document.Heading(adherence.Name)
+	if adherence.Health.BirthDate != "" {
+		document.Text("Дата рождения: " + adherence.Health.BirthDate)
+	}
+	document.Text("Период: " + adherence.From + " - " + adherence.To)
+	document.Text("Часовой пояс: " + adherence.TimeZone)
+	document.Text("Отчёт сформирован: " + schedule.In(now, adherence.TimeZone).Format("2006-01-02 15:04"))
+	document.Text("Аллергии: " + reportList(adherence.Health.Allergies))
+	document.Text("Хронические заболевания: " + reportList(adherence.Health.ChronicConditions))
+
+	document.Heading("Текущая схема приёма")
+	if len(adherence.Regimen) == 0 {
+		document.Text("Запланированных приёмов нет")
+	} else {
+		rows := make([][]string, 0, len(adherence.Regimen))
+		for _, item := range adherence.Regimen {
+			dose := "-"
+			if item.Dose > 0 {
+				dose = strconv.FormatFloat(item.Dose, 'f', -1, 64) + " " + item.Unit
+			}
+			rows = append(rows, []string{item.Name, item.Strength, dose, strings.Join(item.Times, ", ")})
+		}
+		document.Table([]string{"Лекарство", "Дозировка", "Доза", "Время приёма"}, rows)
+	}
+
+	document.Heading("Соблюдение схемы")
+	if len(adherence.Doses) == 0 {
+		document.Text("За период приёмов по напоминаниям не было")
+	} else {
+		type summary struct {
+			name          string
+			taken, missed int
+		}
+		order := make([]int64, 0)
+		summaries := make(map[int64]*summary)
+		doses := make([][]string, 0, len(adherence.Doses))
+		for _, dose := range adherence.Doses {
+			item, ok := summaries[dose.IDMedicine]
+			if !ok {
+				item = &summary{name: dose.NameMedicine}
+				summaries[dose.IDMedicine] = item
+				order = append(order, dose.IDMedicine)
+			}
+
+			state := "пропущен"
+			if dose.Taken {
+				item.taken++
+				state = "принят"
+			} else {
+				item.missed++
+			}
+			doses = append(doses, []string{reportTime(dose.Time), dose.NameMedicine, state})
+		}
+
+		rows := make([][]string, 0, len(order))
+		for _, id := range order {
+			item := summaries[id]
+			total := item.taken + item.missed
+			rows = append(rows, []string{item.name, strconv.Itoa(total), strconv.Itoa(item.taken), strconv.Itoa(item.missed),
+				strconv.Itoa(item.taken*100/total) + "%"})
+		}
+		document.Table([]string{"Лекарство", "Всего", "Принято", "Пропущено", "Соблюдение"}, rows)
+		document.Text("")
+		document.Table([]string{"Время", "Лекарство", "Приём"}, doses)
+	}
+
+	document.Heading("Изменения остатков")
+	if len(adherence.Stock) == 0 {
+		document.Text("За период остатки не менялись")
+	} else {
+		rows := make([][]string, 0, len(adherence.Stock))
+		for _, entry := range adherence.Stock {
+			reason, ok := stockReasonNames[entry.Entry.Reason]
+			if !ok {
+				reason = entry.Entry.Reason
+			}
+			delta := strconv.FormatInt(entry.Entry.Delta, 10)
+			if entry.Entry.Delta > 0 {
+				delta = "+" + delta
+			}
+			rows = append(rows, []string{reportTime(entry.Entry.Time), entry.NameMedicine, delta + " " + entry.Unit, reason, entry.Entry.NameUser})
+		}
+		document.Table([]string{"Время", "Лекарство", "Изменение", "Причина", "Кто"}, rows)
+	}
+
+	if len(adherence.Changes) != 0 {
+		document.Heading("Переносы приёмов")
+		rows := make([][]string, 0, len(adherence.Changes))
+		for _, change := range adherence.Changes {
+			action, ok := doseChangeNames[change.Action]
+			if !ok {
+				action = change.Action
+			}
+			rows = append(rows, []string{reportTime(change.From), reportTime(change.To), change.NameMedicine, action})
+		}
+		document.Table([]string{"Было", "Стало", "Лекарство", "Изменение"}, rows)
+	}
+
+	return document
+}
+
+// GetReport отдаёт PDF-отчёт для врача о приёме лекарств человеком за период from - to
+func (p *profileHandler) GetReport() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		person, err := personFromQuery(ctx, userID)
+		if err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.ReportRequest{
+			UserID:   person.UserID,
+			IsUser:   person.IsUser,
+			IDPerson: person.IDPerson,
+			From:     ctx.QueryParam("from"),
+			To:       ctx.QueryParam("to"),
+		}
+		adherence, err := p.profileMicroservice.GetAdherenceReport(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		var buffer bytes.Buffer
+		if err = adherenceDocument(adherence, time.Now()).Write(&buffer); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusInternalServerError)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		ctx.Response().Header().Set(echo.HeaderContentDisposition, "attachment; filename=report-"+adherence.From+"-"+adherence.To+".pdf")
+		return ctx.Blob(http.StatusOK, report.ContentType, buffer.Bytes())
+	}
+}
+
+// fhirBundle переводит отчёт о приёме в ресурсы FHIR R4: схему приёма — в Medication и
+// MedicationStatement, прошедшие приёмы — в MedicationAdministration, пропущенные со статусом not-done
+func fhirBundle(adherence *profile.AdherenceReport, now time.Time) *fhir.Bundle {
+	bundle := fhir.NewBundle(now)
+
+	patientID := "member-" + strconv.FormatInt(adherence.Health.IDPerson, 10)
+	if adherence.Health.IsUser {
+		patientID = "user-" + strconv.FormatInt(adherence.Health.IDPerson, 10)
+	}
+	patient := fhir.Reference{Reference: fhir.URN(fhir.ResourcePatient, patientID), Display: adherence.Name}
+	bundle.Add(fhir.ResourcePatient, patientID, fhir.Patient{
+		ResourceType: fhir.ResourcePatient,
+		ID:           patientID,
+		Name:         []fhir.HumanName{{Text: adherence.Name}},
+		BirthDate:    adherence.Health.BirthDate,
+	})
+
+	medications := make(map[int64]fhir.Reference)
+	addMedication := func(id int64, name, form string, ingredients []string) fhir.Reference {
+		medicationID := "medicine-" + strconv.FormatInt(id, 10)
+		medication := fhir.Medication{
+			ResourceType: fhir.ResourceMedication,
+			ID:           medicationID,
+			Code:         fhir.CodeableConcept{Text: name},
+		}
+		if form != "" {
+			medication.Form = &fhir.CodeableConcept{Text: form}
+		}
+		for _, ingredient := range ingredients {
+			medication.Ingredient = append(medication.Ingredient, fhir.Ingredient{ItemCodeableConcept: fhir.CodeableConcept{Text: ingredient}})
+		}
+		bundle.Add(fhir.ResourceMedication, medicationID, medication)
+
+		reference := fhir.Reference{Reference: fhir.URN(fhir.ResourceMedication, medicationID), Display: name}
+		medications[id] = reference
+		return reference
+	}
+
+	for _, item := range adherence.Regimen {
+		medication := addMedication(item.IDMedicine, strings.TrimSpace(item.Name+" "+item.Strength), item.Form, item.Ingredients)
+
+		dosage := fhir.Dosage{}
+		if len(item.Times) != 0 {
+			dosage.Timing = &fhir.Timing{}
+			for _, clock := range item.Times {
+				dosage.Timing.Repeat.TimeOfDay = append(dosage.Timing.Repeat.TimeOfDay, fhir.TimeOfDay(clock))
+			}
+		}
+		if item.Dose > 0 {
+			dosage.DoseAndRate = []fhir.DoseAndRate{{DoseQuantity: fhir.Quantity{Value: item.Dose, Unit: item.Unit}}}
+		}
+
+		statementID := "statement-" + strconv.FormatInt(item.IDMedicine, 10)
+		statement := fhir.MedicationStatement{
+			ResourceType:        fhir.ResourceStatement,
+			ID:                  statementID,
+			Status:              fhir.StatementActive,
+			MedicationReference: medication,
+			Subject:             patient,
+		}
+		if dosage.Timing != nil || len(dosage.DoseAndRate) != 0 {
+			statement.Dosage = []fhir.Dosage{dosage}
+		}
+		bundle.Add(fhir.ResourceStatement, statementID, statement)
+	}
+
+	// лекарства, которые принимались за период, но больше не назначены
+	for _, dose := range adherence.Doses {
+		if _, ok := medications[dose.IDMedicine]; ok {
+			continue
+		}
+		medication := addMedication(dose.IDMedicine, dose.NameMedicine, "", nil)
+		statementID := "statement-" + strconv.FormatInt(dose.IDMedicine, 10)
+		bundle.Add(fhir.ResourceStatement, statementID, fhir.MedicationStatement{
+			ResourceType:        fhir.ResourceStatement,
+			ID:                  statementID,
+			Status:              fhir.StatementCompleted,
+			MedicationReference: medication,
+			Subject:             patient,
+		})
+	}
+
+	for _, dose := range adherence.Doses {
+		// приём, ещё не перенесённый из напоминаний в историю, получает id напоминания
+		administrationID := "notification-" + strconv.FormatInt(dose.ID, 10)
+		if dose.Logged {
+			administrationID = "dose-" + strconv.FormatInt(dose.ID, 10)
+		}
+		administrationStatus := fhir.AdministrationNotDone
+		if dose.Taken {
+			administrationStatus = fhir.AdministrationDone
+		}
+		bundle.Add(fhir.ResourceAdministration, administrationID, fhir.MedicationAdministration{
+			ResourceType:        fhir.ResourceAdministration,
+			ID:                  administrationID,
+			Status:              administrationStatus,
+			MedicationReference: medications[dose.IDMedicine],
+			Subject:             patient,
+			EffectiveDateTime:   dose.Time,
+		})
+	}
+
+	return bundle
+}
+
+// ExportFHIR выгружает лекарства человека и его приёмы за период from - to пакетом ресурсов FHIR R4
+func (p *profileHandler) ExportFHIR() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		person, err := personFromQuery(ctx, userID)
+		if err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.ReportRequest{
+			UserID:   person.UserID,
+			IsUser:   person.IsUser,
+			IDPerson: person.IDPerson,
+			From:     ctx.QueryParam("from"),
+			To:       ctx.QueryParam("to"),
+		}
+		adherence, err := p.profileMicroservice.GetAdherenceReport(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		var buffer bytes.Buffer
+		if err = fhirBundle(adherence, time.Now()).Write(&buffer); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusInternalServerError)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		ctx.Response().Header().Set(echo.HeaderContentDisposition, "attachment; filename=medications-"+adherence.From+"-"+adherence.To+".json")
+		return ctx.Blob(http.StatusOK, fhir.ContentType, buffer.Bytes())
+	}
+}
+
+func (p *profileHandler) GetNotificationSettings() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		data := &profile.UserID{ID: userID}
+		settings, err := p.profileMicroservice.GetNotificationSettings(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.ResponseNotificationSettings{
+			Status: http.StatusOK,
+			Settings: &models.NotificationSettings{
+				Channels:   settings.Channels,
+				QuietFrom:  settings.QuietFrom,
+				QuietTo:    settings.QuietTo,
+				Dependents: settings.Dependents,
+			},
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+// EditNotificationSettings сохраняет каналы доставки, тихие часы и режим уведомлений о приёмах членов семьи
+func (p *profileHandler) EditNotificationSettings() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		settingsData := models.NotificationSettings{}
+
+		if err = ctx.Bind(&settingsData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.NotificationSettings{
+			UserID:     userID,
+			Channels:   settingsData.Channels,
+			QuietFrom:  settingsData.QuietFrom,
+			QuietTo:    settingsData.QuietTo,
+			Dependents: settingsData.Dependents,
+		}
+		_, err = p.profileMicroservice.EditNotificationSettings(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.SettingsAreEdited,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+// AddWebhook регистрирует вебхук; секрет для проверки подписи возвращается только в этом ответе
+func (p *profileHandler) AddWebhook() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		webhookData := models.WebhookDTO{}
+
+		if err = ctx.Bind(&webhookData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.Webhook{
+			UserID: userID,
+			URL:    webhookData.URL,
+		}
+		webhook, err := p.profileMicroservice.AddWebhook(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.ResponseWebhook{
+			Status: http.StatusOK,
+			Webhook: &models.Webhook{
+				ID:      webhook.ID,
+				URL:     webhook.URL,
+				Secret:  webhook.Secret,
+				Created: webhook.Created,
+			},
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) GetWebhooks() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		data := &profile.UserID{
+			ID: userID,
+		}
+		list, err := p.profileMicroservice.GetWebhooks(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		webhooks := make([]models.Webhook, 0)
+		for _, webhook := range list.Webhooks {
+			webhooks = append(webhooks, models.Webhook{
+				ID:      webhook.ID,
+				URL:     webhook.URL,
+				Created: webhook.Created,
+			})
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.ResponseWebhooks{
+			Status:   http.StatusOK,
+			Webhooks: webhooks,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) DeleteWebhook() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		webhookData := models.WebhookIDDTO{}
+
+		if err = ctx.Bind(&webhookData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.WebhookRequest{
+			UserID: userID,
+			ID:     webhookData.ID,
+		}
+		_, err = p.profileMicroservice.DeleteWebhook(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.WebhookIsDeleted,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+// AddTelegram выдаёт код привязки чата; если задано имя бота, возвращается и ссылка,
+// открывающая бота сразу с этим кодом
+func (p *profileHandler) AddTelegram() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		data := &profile.UserID{
+			ID: userID,
+		}
+		code, err := p.profileMicroservice.CreateTelegramCode(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		var link string
+		if bot := os.Getenv("TELEGRAM_BOT"); bot != "" {
+			link = "https://t.me/" + bot + "?start=" + code.Code
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.ResponseTelegram{
+			Status:  http.StatusOK,
+			Code:    code.Code,
+			Expires: code.Expires,
+			URL:     link,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) DeleteTelegram() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		data := &profile.UserID{
+			ID: userID,
+		}
+		_, err = p.profileMicroservice.UnlinkTelegram(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.TelegramIsUnlinked,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+// TelegramUpdate принимает события бота, которые Telegram присылает на адрес из setWebhook.
+// Подлинность запроса проверяется по секрету TELEGRAM_SECRET; Telegram повторяет события,
+// на которые не получил ответ 200, поэтому ошибки обработки только записываются в лог
+func (p *profileHandler) TelegramUpdate() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		requestID, ok := ctx.Get("REQUEST_ID").(string)
+		if !ok {
+			return constants.RespError(ctx, p.logger, requestID, constants.NoRequestID, http.StatusInternalServerError)
+		}
+
+		secret := os.Getenv("TELEGRAM_SECRET")
+		if secret == "" || subtle.ConstantTimeCompare([]byte(ctx.Request().Header.Get(telegram.SecretHeader)), []byte(secret)) != 1 {
+			return constants.RespError(ctx, p.logger, requestID, constants.ErrWrongTelegramSecret.Error(), http.StatusForbidden)
+		}
+
+		update := telegram.Update{}
+		if err := json.NewDecoder(ctx.Request().Body).Decode(&update); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		client := telegram.NewClient(os.Getenv("TELEGRAM_API_URL"), os.Getenv("TELEGRAM_TOKEN"), telegramHTTPClient)
+
+		var err error
+		switch {
+		case update.CallbackQuery != nil && update.CallbackQuery.Message != nil:
+			err = p.telegramCallback(client, update.CallbackQuery)
+		case update.Message != nil:
+			err = p.telegramMessage(client, update.Message)
+		}
+		if err != nil {
+			p.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+			)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		return ctx.NoContent(http.StatusOK)
+	}
+}
+
+// telegramMessage привязывает чат по команде "/start <код>" и подсказывает, как получить код
+func (p *profileHandler) telegramMessage(client *telegram.Client, message *telegram.Message) error {
+	code, ok := telegram.StartCode(message.Text)
+	if !ok {
+		return client.SendMessage(message.Chat.ID, "Чтобы получать напоминания о приёме лекарств, получите код привязки "+
+			"в настройках уведомлений MyAidKit и отправьте его командой /start <код>.", nil)
+	}
+
+	data := &profile.TelegramLink{
+		Code:   code,
+		ChatID: message.Chat.ID,
+	}
+	_, err := p.profileMicroservice.LinkTelegram(context.Background(), data)
+	if status.Code(err) == codes.NotFound {
+		return client.SendMessage(message.Chat.ID, "Код не найден или устарел. Получите новый код в настройках уведомлений MyAidKit.", nil)
+	}
+	if err != nil {
+		sendErr := client.SendMessage(message.Chat.ID, "Не удалось привязать чат, попробуйте позже.", nil)
+		if sendErr != nil {
+			return sendErr
+		}
+		return err
+	}
+
+	return client.SendMessage(message.Chat.ID, "Чат привязан к MyAidKit. Включите канал telegram в настройках уведомлений, "+
+		"чтобы получать сюда напоминания.", nil)
+}
+
+// telegramResults — ответы на нажатие кнопок под напоминанием
+var telegramResults = map[string]string{
+	telegram.ActionTaken:  "Приём отмечен",
+	telegram.ActionSkip:   "Приём пропущен",
+	telegram.ActionSnooze: "Напомним через " + strconv.Itoa(constants.SnoozeMinutes) + " минут",
+}
+
+// telegramCallback выполняет действие кнопки и заменяет кнопки под напоминанием его результатом
+func (p *profileHandler) telegramCallback(client *telegram.Client, callback *telegram.CallbackQuery) error {
+	action, idNotification, err := telegram.ParseCallback(callback.Data)
+	if err != nil {
+		return client.AnswerCallbackQuery(callback.ID, "Неизвестное действие")
+	}
+
+	data := &profile.TelegramCallback{
+		ChatID:         callback.Message.Chat.ID,
+		Action:         action,
+		IDNotification: idNotification,
+	}
+	_, err = p.profileMicroservice.HandleTelegramCallback(context.Background(), data)
+
+	var result string
+	switch status.Code(err) {
+	case codes.OK:
+		result = telegramResults[action]
+	case codes.AlreadyExists:
+		result = "Приём уже отмечен"
+	case codes.NotFound, codes.PermissionDenied:
+		result = "Напоминание недоступно"
+	default:
+		if answerErr := client.AnswerCallbackQuery(callback.ID, "Не удалось выполнить действие, попробуйте позже"); answerErr != nil {
+			return answerErr
+		}
+		return err
+	}
+
+	if err = client.AnswerCallbackQuery(callback.ID, result); err != nil {
+		return err
+	}
+	return client.EditMessageText(callback.Message.Chat.ID, callback.Message.MessageID, callback.Message.Text+"\n\n"+result)
+}
+
+// GetPushKey возвращает открытый ключ VAPID, с которым браузер оформляет подписку
+func (p *profileHandler) GetPushKey() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		_, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		key := os.Getenv("VAPID_PUBLIC_KEY")
+		if key == "" {
+			return constants.RespError(ctx, p.logger, requestID, constants.ErrPushDisabled.Error(), http.StatusServiceUnavailable)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.ResponsePushKey{
+			Status: http.StatusOK,
+			Key:    key,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) AddPushSubscription() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		subscriptionData := models.PushSubscriptionDTO{}
+
+		if err = ctx.Bind(&subscriptionData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		// подписка живёт, пока жива сессия, из которой она оформлена
+		cookie, err := ctx.Cookie("Session_cookie")
+		if err != nil {
+			return constants.RespError(ctx, p.logger, requestID, constants.SessionRequired, http.StatusBadRequest)
+		}
+
+		data := &profile.PushSubscription{
+			UserID:   userID,
+			Endpoint: subscriptionData.Endpoint,
+			P256Dh:   subscriptionData.Keys.P256dh,
+			Auth:     subscriptionData.Keys.Auth,
+			Session:  cookie.Value,
+		}
+		_, err = p.profileMicroservice.AddPushSubscription(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.PushIsSubscribed,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+// DeletePushSubscription удаляет подписку браузера; при выходе из аккаунта подписки сессии удаляются сами
+func (p *profileHandler) DeletePushSubscription() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		subscriptionData := models.PushSubscriptionDTO{}
+
+		if err = ctx.Bind(&subscriptionData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		data := &profile.PushSubscription{
+			UserID:   userID,
+			Endpoint: subscriptionData.Endpoint,
+		}
+		_, err = p.profileMicroservice.DeletePushSubscription(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.PushIsUnsubscribed,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+// prnPerson возвращает человека из тела запроса; без id это сам пользователь
+func prnPerson(userID int64, isUser bool, id int64) (bool, int64) {
+	if id == 0 {
+		return true, userID
+	}
+	return isUser, id
+}
+
+func (p *profileHandler) GetPRNRules() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		data, err := personFromQuery(ctx, userID)
+		if err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		list, err := p.profileMicroservice.GetPRNRules(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		rules := make([]models.PRNRule, 0)
+		for _, rule := range list.Rules {
+			rules = append(rules, models.PRNRule{
+				IDMedicine:   rule.IDMedicine,
+				NameMedicine: rule.NameMedicine,
+				MinInterval:  rule.MinInterval,
+				MaxDaily:     rule.MaxDaily,
+				LastTime:     rule.LastTime,
+				Taken:        rule.Taken,
+				NextAllowed:  rule.NextAllowed,
+			})
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.ResponsePRNRules{
+			Status: http.StatusOK,
+			Rules:  rules,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) EditPRNRule() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		ruleData := models.PRNRuleDTO{}
+
+		if err = ctx.Bind(&ruleData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		isUser, idPerson := prnPerson(userID, ruleData.IsUser, ruleData.ID)
+		data := &profile.PRNRule{
+			UserID:      userID,
+			IsUser:      isUser,
+			IDPerson:    idPerson,
+			IDMedicine:  ruleData.IDMedicine,
+			MinInterval: ruleData.MinInterval,
+			MaxDaily:    ruleData.MaxDaily,
+		}
+		_, err = p.profileMicroservice.SetPRNRule(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.PRNRuleIsSet,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+func (p *profileHandler) DeletePRNRule() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		ruleData := models.PRNRuleDTO{}
+
+		if err = ctx.Bind(&ruleData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		isUser, idPerson := prnPerson(userID, ruleData.IsUser, ruleData.ID)
+		data := &profile.PRNRule{
+			UserID:     userID,
+			IsUser:     isUser,
+			IDPerson:   idPerson,
+			IDMedicine: ruleData.IDMedicine,
+		}
+		_, err = p.profileMicroservice.DeletePRNRule(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", http.StatusOK),
+		)
+
+		resp, err := easyjson.Marshal(&models.Response{
+			Status:  http.StatusOK,
+			Message: constants.PRNRuleIsDeleted,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
+
+// AddPRNIntake отмечает приём лекарства по необходимости. Приём, нарушающий интервал или суточный
+// максимум, не записывается: возвращается 409 с предупреждениями и временем, когда приём допустим;
+// повторный запрос с override записывает его
+func (p *profileHandler) AddPRNIntake() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		intakeData := models.PRNIntakeDTO{Count: 1}
+
+		if err = ctx.Bind(&intakeData); err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		isUser, idPerson := prnPerson(userID, intakeData.IsUser, intakeData.ID)
+		data := &profile.PRNIntake{
+			UserID:     userID,
+			IsUser:     isUser,
+			IDPerson:   idPerson,
+			IDMedicine: intakeData.IDMedicine,
+			Count:      intakeData.Count,
+			Override:   intakeData.Override,
+		}
+		result, err := p.profileMicroservice.LogPRNIntake(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		warnings := make([]models.Warning, 0)
+		for _, warning := range result.Warnings {
+			warnings = append(warnings, models.Warning{
+				Type:     warning.Type,
+				Message:  warning.Message,
+				Severity: warning.Severity,
+			})
+		}
+
+		answer := http.StatusOK
+		message := constants.PRNIntakeIsAdded
+		if !result.Added {
+			answer = http.StatusConflict
+			message = constants.PRNIntakeHasWarnings
+		}
+
+		p.logger.Info(
+			zap.String("ID", requestID),
+			zap.Int("ANSWER STATUS", answer),
+		)
+
+		resp, err := easyjson.Marshal(&models.ResponsePRNIntake{
+			Status:      answer,
+			Message:     message,
+			Warnings:    warnings,
+			NextAllowed: result.NextAllowed,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(answer, resp)
+	}
+}
+
+func (p *profileHandler) AuditRegimen() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userID, requestID, err := constants.DefaultUserChecks(ctx, p.logger)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		data, err := personFromQuery(ctx, userID)
+		if err != nil {
+			return constants.RespError(ctx, p.logger, requestID, err.Error(), http.StatusBadRequest)
+		}
+
+		found, err := p.profileMicroservice.AuditRegimen(context.Background(), data)
+		if err != nil {
+			return p.ParseError(ctx, requestID, err)
+		}
+
+		interactionResult := make([]models.Interaction, 0)
+		for _, interaction := range found.Interactions {
+			interactionResult = append(interactionResult, models.Interaction{
+				IDMedicineA:   interaction.IDMedicineA,
+				NameMedicineA: interaction.NameMedicineA,
+				IDMedicineB:   interaction.IDMedicineB,
+				NameMedicineB: interaction.NameMedicineB,
+				IngredientA:   interaction.IngredientA,
+				IngredientB:   interaction.IngredientB,
+				Severity:      interaction.Severity,
+				Description:   interaction.Description,
+			})
+		}
+
+		resp, err := easyjson.Marshal(&models.ResponseInteractions{
+			Status:       http.StatusOK,
+			Interactions: interactionResult,
+		})
+		if err != nil {
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return ctx.JSONBlob(http.StatusOK, resp)
+	}
+}
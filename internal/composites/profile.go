@@ -1,9 +1,13 @@
 package composites
 
 import (
+	"main/internal/constants"
 	"main/internal/microservices/profile"
 	"main/internal/microservices/profile/repository"
 	"main/internal/microservices/profile/usecase"
+	"main/internal/microservices/profile/utils/ingredients"
+	"main/internal/microservices/profile/utils/interactions"
+	"main/internal/microservices/profile/utils/templates"
 )
 
 type ProfileComposite struct {
@@ -14,7 +18,27 @@ type ProfileComposite struct {
 func NewProfileComposite(postgresComposite *PostgresDBComposite, minioComposite *MinioComposite,
 	redisComposite *RedisComposite) (*ProfileComposite, error) {
 	storage := repository.NewStorage(postgresComposite.DB, minioComposite.client, redisComposite.redis)
-	service := usecase.NewService(storage)
+	dataset, err := ingredients.Load(constants.IngredientsFile)
+	if err != nil {
+		return nil, err
+	}
+	table, err := interactions.Load(constants.InteractionsFile)
+	if err != nil {
+		return nil, err
+	}
+	err = storage.ImportInteractions(table)
+	if err != nil {
+		return nil, err
+	}
+	kitTemplates, err := templates.Load(constants.TemplatesFile)
+	if err != nil {
+		return nil, err
+	}
+	err = storage.ImportTemplates(kitTemplates)
+	if err != nil {
+		return nil, err
+	}
+	service := usecase.NewService(storage, dataset)
 	return &ProfileComposite{
 		Storage: storage,
 		Service: service,
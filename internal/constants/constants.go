@@ -23,15 +23,78 @@ var (
 
 	ErrFamilyAlreadyExists   = errors.New("family already exists")
 	ErrNoFamily              = errors.New("no family")
+	ErrNoMember              = errors.New("no member")
 	ErrNotMainUser           = errors.New("not main user")
 	ErrMainUser              = errors.New("main user")
 	ErrNotAvailableForDelete = errors.New("not available for delete")
 	ErrNotAvailableForAdd    = errors.New("not available for add")
+	ErrNotInFamily           = errors.New("not in family")
+	ErrWrongInvite           = errors.New("invitation is invalid or expired")
+	ErrNoStockUnit           = errors.New("medicine has no stock unit")
+	ErrBuiltinTemplate       = errors.New("built-in template can not be changed")
+	ErrRestorePeriod         = errors.New("medicine can not be restored after retention period")
+	ErrWrongSort             = errors.New("wrong sort order")
+	ErrImportRow             = errors.New("row could not be imported")
+	ErrWrongFilter           = errors.New("wrong filter")
+	ErrNoCalendar            = errors.New("no calendar")
+	ErrWrongPeriod           = errors.New("wrong report period")
+	ErrNoWebhook             = errors.New("no webhook")
+	ErrTooManyWebhooks       = errors.New("too many webhooks")
+	ErrNoTelegram            = errors.New("no telegram chat")
+	ErrWrongTelegramCode     = errors.New("wrong or expired telegram code")
+	ErrNoNotification        = errors.New("no notification")
+	ErrNotificationAccepted  = errors.New("notification is already accepted")
+	ErrWrongReschedule       = errors.New("wrong reschedule time")
+	ErrWrongTelegramSecret   = errors.New("wrong telegram secret")
+	ErrNoPushSubscription    = errors.New("no push subscription")
+	ErrPushSubscriptionTaken = errors.New("push subscription belongs to another user")
+	ErrNoSession             = errors.New("session has expired")
+	ErrPushDisabled          = errors.New("web push is not configured")
+	ErrNoPRNRule             = errors.New("no as-needed rule")
+	ErrWrongCount            = errors.New("wrong count")
 )
 
 const (
 	DefaultImage               = "default_avatar.webp"
 	DefaultMedicine            = "default_medicine.webp"
+	IngredientsFile            = "ingredients.csv"
+	InteractionsFile           = "interactions.csv"
+	TemplatesFile              = "templates.csv"
+	WarningInteraction         = "interaction"
+	WarningDuplication         = "duplication"
+	LowStockDays               = 3
+	TrashRetentionDays         = 30
+	CalendarHistoryDays        = 1
+	CalendarEventMinutes       = 15
+	CalendarAlarmMinutes       = 10
+	MaxWebhooks                = 5
+	TelegramCodeMinutes        = 15
+	MemberInviteDays           = 7
+	SnoozeMinutes              = 15
+	SnoozeMaxMinutes           = 24 * 60
+	ReportDays                 = 30
+	ReportMaxDays              = 366
+	SearchSimilarity           = 0.3
+	SearchLimit                = 20
+	SearchMaxLimit             = 100
+	SortRelevance              = "relevance"
+	SortName                   = "name"
+	SortExpiry                 = "expiry"
+	SortCount                  = "count"
+	SortID                     = "id"
+	SortTime                   = "time"
+	SortMedicine               = "medicine"
+	NotificationsAccepted      = "accepted"
+	NotificationsPending       = "pending"
+	FamilyUsers                = "users"
+	FamilyMembers              = "members"
+	ShoppingManual             = "manual"
+	ShoppingLowStock           = "low_stock"
+	ShoppingExpired            = "expired"
+	ShoppingTemplate           = "template"
+	DoseSnoozed                = "snoozed"
+	DoseRescheduled            = "rescheduled"
+	DoseSkipped                = "skipped"
 	UserObjectsBucketName      = "avatars"
 	MedicinesObjectsBucketName = "medicines"
 	SessionRequired            = "Session required"
@@ -45,17 +108,44 @@ const (
 	FamilyIsCreated            = "Family is created"
 	FamilyIsDeleted            = "Family is deleted"
 	UserIsDeleted              = "User is deleted"
+	FamilyIsLeft               = "Family is left"
 	MemberIsDeleted            = "Member is deleted"
 	MedicineIsDeleted          = "Medicine is deleted"
 	MemberIsAdded              = "Member is added"
+	MemberIsPromoted           = "Member is promoted"
 	MedicineIsAdded            = "Medicine is added"
 	NotMainUser                = "Not main user"
 	InvitationIsSent           = "Invitation is sent"
 	InvitationIsAccepted       = "Invitation is accepted"
 	MedicineIsEdited           = "Medicine is eddited"
 	NotificationsAreAdded      = "Notifications are added"
+	NotificationHasWarnings    = "Notification has warnings"
 	NotificationIsDeleted      = "Notification is deleted"
 	MedicineIsAccepted         = "Medicine is accepted"
+	HealthIsEdited             = "Health is edited"
+	StockIsChanged             = "Stock is changed"
+	MinCountIsSet              = "Min count is set"
+	ShoppingItemIsAdded        = "Shopping item is added"
+	ShoppingItemIsChecked      = "Shopping item is checked"
+	ShoppingItemIsDeleted      = "Shopping item is deleted"
+	KitIsAdded                 = "Kit is added"
+	KitIsEdited                = "Kit is edited"
+	KitIsDeleted               = "Kit is deleted"
+	MedicineIsMoved            = "Medicine is moved"
+	TemplateIsAdded            = "Template is added"
+	TemplateIsDeleted          = "Template is deleted"
+	MedicineIsDisposed         = "Medicine is disposed"
+	MedicineIsRestored         = "Medicine is restored"
+	CalendarIsRevoked          = "Calendar is revoked"
+	SettingsAreEdited          = "Settings are edited"
+	WebhookIsDeleted           = "Webhook is deleted"
+	TelegramIsUnlinked         = "Telegram is unlinked"
+	PushIsSubscribed           = "Push is subscribed"
+	PushIsUnsubscribed         = "Push is unsubscribed"
+	PRNRuleIsSet               = "As-needed rule is set"
+	PRNRuleIsDeleted           = "As-needed rule is deleted"
+	PRNIntakeIsAdded           = "Intake is added"
+	PRNIntakeHasWarnings       = "Intake has warnings"
 )
 
 const (
@@ -73,7 +163,9 @@ const (
 	DeleteFamilyURL       = "/api/v1/delete"
 	RemoveMemberUrl       = "/api/v1/remove/member"
 	RemoveUserUrl         = "/api/v1/remove/user"
+	LeaveFamilyURL        = "/api/v1/leave"
 	AddMembersToFamilyURL = "/api/v1/add"
+	PromoteMemberURL      = "/api/v1/promote"
 	GetFamilyURL          = "/api/v1/family"
 	DeleteMedicine        = "/api/v1/remove/medicine"
 	AddMedicineURL        = "/api/v1/add/medicine"
@@ -85,6 +177,53 @@ const (
 	AddNotificationURL    = "/api/v1/add/notification"
 	GetNotificationURL    = "/api/v1/notifications"
 	AcceptMedicineURL     = "/api/v1/accept"
+	HealthURL             = "/api/v1/health"
+	EditHealthURL         = "/api/v1/edit/health"
+	InteractionsURL       = "/api/v1/interactions"
+	ChangeStockURL        = "/api/v1/medicine/stock"
+	StockHistoryURL       = "/api/v1/medicine/history"
+	MinCountURL           = "/api/v1/medicine/min"
+	ShoppingListURL       = "/api/v1/shopping"
+	AddShoppingItemURL    = "/api/v1/add/shopping"
+	CheckShoppingItemURL  = "/api/v1/check/shopping"
+	DeleteShoppingItemURL = "/api/v1/remove/shopping"
+	KitsURL               = "/api/v1/kits"
+	AddKitURL             = "/api/v1/add/kit"
+	EditKitURL            = "/api/v1/edit/kit"
+	DeleteKitURL          = "/api/v1/remove/kit"
+	MoveMedicineURL       = "/api/v1/move/medicine"
+	TemplatesURL          = "/api/v1/templates"
+	AddTemplateURL        = "/api/v1/add/template"
+	ImportTemplateURL     = "/api/v1/import/template"
+	DeleteTemplateURL     = "/api/v1/remove/template"
+	CheckKitURL           = "/api/v1/check/kit"
+	DisposeMedicineURL    = "/api/v1/dispose/medicine"
+	TrashURL              = "/api/v1/trash"
+	RestoreMedicineURL    = "/api/v1/restore/medicine"
+	SearchMedicineURL     = "/api/v1/medicine/search"
+	ExportMedicineURL     = "/api/v1/export/medicine"
+	ImportMedicineURL     = "/api/v1/import/medicine"
+	AddCalendarURL        = "/api/v1/add/calendar"
+	DeleteCalendarURL     = "/api/v1/remove/calendar"
+	CalendarURL           = "/api/v1/calendar"
+	ReportURL             = "/api/v1/report"
+	ExportFHIRURL         = "/api/v1/export/fhir"
+	SettingsURL           = "/api/v1/settings/notifications"
+	EditSettingsURL       = "/api/v1/edit/settings/notifications"
+	WebhooksURL           = "/api/v1/webhooks"
+	AddWebhookURL         = "/api/v1/add/webhook"
+	DeleteWebhookURL      = "/api/v1/remove/webhook"
+	AddTelegramURL        = "/api/v1/add/telegram"
+	DeleteTelegramURL     = "/api/v1/remove/telegram"
+	TelegramUpdateURL     = "/api/v1/telegram/update"
+	PushKeyURL            = "/api/v1/push/key"
+	AddPushURL            = "/api/v1/add/push"
+	DeletePushURL         = "/api/v1/remove/push"
+	RescheduleURL         = "/api/v1/reschedule/notification"
+	PRNRulesURL           = "/api/v1/prn"
+	EditPRNURL            = "/api/v1/edit/prn"
+	DeletePRNURL          = "/api/v1/remove/prn"
+	AddPRNIntakeURL       = "/api/v1/add/prn/intake"
 )
 
 var (
@@ -12,4 +12,5 @@ type Storage interface {
 	StoreSession(userID int64) (string, error)
 	GetUserID(session string) (int64, error)
 	DeleteSession(session string) error
+	DeletePushSubscriptions(session string) error
 }
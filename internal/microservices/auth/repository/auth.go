@@ -222,3 +222,15 @@ func (s Storage) DeleteSession(session string) error {
 
 	return nil
 }
+
+// DeletePushSubscriptions удаляет подписки браузера, оформленные из сессии
+func (s Storage) DeletePushSubscriptions(session string) error {
+	sqlScript := "DELETE FROM push_subscriptions WHERE session = $1"
+
+	_, err := s.db.Exec(sqlScript, hash.Session(session))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
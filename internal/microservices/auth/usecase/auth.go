@@ -103,6 +103,11 @@ func (s *Service) LogOut(ctx context.Context, cookie *proto.Cookie) (*proto.Empt
 	if err != nil {
 		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
 	}
+
+	err = s.storage.DeletePushSubscriptions(cookie.Cookie)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
 	return &proto.Empty{}, nil
 }
 
@@ -1,6 +1,9 @@
 package hash
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -22,3 +25,9 @@ func ComparePasswords(hashedPwd string, salt string, plainPwd string) (bool, err
 
 	return true, nil
 }
+
+// Session возвращает отпечаток идентификатора сессии, который можно хранить в базе вместо самой сессии
+func Session(session string) string {
+	sum := sha256.Sum256([]byte(session))
+	return hex.EncodeToString(sum[:])
+}
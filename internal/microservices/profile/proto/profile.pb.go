@@ -27,13 +27,14 @@ type ProfileData struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Surname string `protobuf:"bytes,2,opt,name=Surname,proto3" json:"Surname,omitempty"`
-	Email   string `protobuf:"bytes,3,opt,name=Email,proto3" json:"Email,omitempty"`
-	Avatar  string `protobuf:"bytes,4,opt,name=Avatar,proto3" json:"Avatar,omitempty"`
-	Date    string `protobuf:"bytes,5,opt,name=Date,proto3" json:"Date,omitempty"`
-	Main    bool   `protobuf:"varint,6,opt,name=Main,proto3" json:"Main,omitempty"`
-	Adult   bool   `protobuf:"varint,7,opt,name=Adult,proto3" json:"Adult,omitempty"`
+	Name     string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Surname  string `protobuf:"bytes,2,opt,name=Surname,proto3" json:"Surname,omitempty"`
+	Email    string `protobuf:"bytes,3,opt,name=Email,proto3" json:"Email,omitempty"`
+	Avatar   string `protobuf:"bytes,4,opt,name=Avatar,proto3" json:"Avatar,omitempty"`
+	Date     string `protobuf:"bytes,5,opt,name=Date,proto3" json:"Date,omitempty"`
+	Main     bool   `protobuf:"varint,6,opt,name=Main,proto3" json:"Main,omitempty"`
+	Adult    bool   `protobuf:"varint,7,opt,name=Adult,proto3" json:"Adult,omitempty"`
+	TimeZone string `protobuf:"bytes,8,opt,name=TimeZone,proto3" json:"TimeZone,omitempty"`
 }
 
 func (x *ProfileData) Reset() {
@@ -117,6 +118,13 @@ func (x *ProfileData) GetAdult() bool {
 	return false
 }
 
+func (x *ProfileData) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
 type EditProfileData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -127,6 +135,7 @@ type EditProfileData struct {
 	Surname  string `protobuf:"bytes,3,opt,name=Surname,proto3" json:"Surname,omitempty"`
 	Password string `protobuf:"bytes,4,opt,name=Password,proto3" json:"Password,omitempty"`
 	Date     string `protobuf:"bytes,5,opt,name=Date,proto3" json:"Date,omitempty"`
+	TimeZone string `protobuf:"bytes,6,opt,name=TimeZone,proto3" json:"TimeZone,omitempty"`
 }
 
 func (x *EditProfileData) Reset() {
@@ -196,6 +205,13 @@ func (x *EditProfileData) GetDate() string {
 	return ""
 }
 
+func (x *EditProfileData) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
 type EditAvatarData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -558,20 +574,18 @@ func (x *MemberData) GetAvatar() string {
 	return ""
 }
 
-type ResponseMemberData struct {
+type PromoteMemberData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID      int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name    string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	Avatar  string `protobuf:"bytes,3,opt,name=Avatar,proto3" json:"Avatar,omitempty"`
-	IsAdult bool   `protobuf:"varint,4,opt,name=IsAdult,proto3" json:"IsAdult,omitempty"`
-	IsUser  bool   `protobuf:"varint,5,opt,name=IsUser,proto3" json:"IsUser,omitempty"`
+	IDFamily int64  `protobuf:"varint,1,opt,name=IDFamily,proto3" json:"IDFamily,omitempty"`
+	IDMember int64  `protobuf:"varint,2,opt,name=IDMember,proto3" json:"IDMember,omitempty"`
+	Email    string `protobuf:"bytes,3,opt,name=Email,proto3" json:"Email,omitempty"`
 }
 
-func (x *ResponseMemberData) Reset() {
-	*x = ResponseMemberData{}
+func (x *PromoteMemberData) Reset() {
+	*x = PromoteMemberData{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_profile_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -579,13 +593,13 @@ func (x *ResponseMemberData) Reset() {
 	}
 }
 
-func (x *ResponseMemberData) String() string {
+func (x *PromoteMemberData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseMemberData) ProtoMessage() {}
+func (*PromoteMemberData) ProtoMessage() {}
 
-func (x *ResponseMemberData) ProtoReflect() protoreflect.Message {
+func (x *PromoteMemberData) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -597,56 +611,45 @@ func (x *ResponseMemberData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseMemberData.ProtoReflect.Descriptor instead.
-func (*ResponseMemberData) Descriptor() ([]byte, []int) {
+// Deprecated: Use PromoteMemberData.ProtoReflect.Descriptor instead.
+func (*PromoteMemberData) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ResponseMemberData) GetID() int64 {
+func (x *PromoteMemberData) GetIDFamily() int64 {
 	if x != nil {
-		return x.ID
+		return x.IDFamily
 	}
 	return 0
 }
 
-func (x *ResponseMemberData) GetName() string {
+func (x *PromoteMemberData) GetIDMember() int64 {
 	if x != nil {
-		return x.Name
+		return x.IDMember
 	}
-	return ""
+	return 0
 }
 
-func (x *ResponseMemberData) GetAvatar() string {
+func (x *PromoteMemberData) GetEmail() string {
 	if x != nil {
-		return x.Avatar
+		return x.Email
 	}
 	return ""
 }
 
-func (x *ResponseMemberData) GetIsAdult() bool {
-	if x != nil {
-		return x.IsAdult
-	}
-	return false
-}
-
-func (x *ResponseMemberData) GetIsUser() bool {
-	if x != nil {
-		return x.IsUser
-	}
-	return false
-}
-
-type ResponseMemberDataArr struct {
+type MemberInvite struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ResponseMemberData []*ResponseMemberData `protobuf:"bytes,1,rep,name=ResponseMemberData,proto3" json:"ResponseMemberData,omitempty"`
+	UserID   int64  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IDMember int64  `protobuf:"varint,2,opt,name=IDMember,proto3" json:"IDMember,omitempty"`
+	Email    string `protobuf:"bytes,3,opt,name=Email,proto3" json:"Email,omitempty"`
+	IsAdult  bool   `protobuf:"varint,4,opt,name=IsAdult,proto3" json:"IsAdult,omitempty"`
 }
 
-func (x *ResponseMemberDataArr) Reset() {
-	*x = ResponseMemberDataArr{}
+func (x *MemberInvite) Reset() {
+	*x = MemberInvite{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_profile_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -654,13 +657,13 @@ func (x *ResponseMemberDataArr) Reset() {
 	}
 }
 
-func (x *ResponseMemberDataArr) String() string {
+func (x *MemberInvite) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseMemberDataArr) ProtoMessage() {}
+func (*MemberInvite) ProtoMessage() {}
 
-func (x *ResponseMemberDataArr) ProtoReflect() protoreflect.Message {
+func (x *MemberInvite) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -672,30 +675,49 @@ func (x *ResponseMemberDataArr) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseMemberDataArr.ProtoReflect.Descriptor instead.
-func (*ResponseMemberDataArr) Descriptor() ([]byte, []int) {
+// Deprecated: Use MemberInvite.ProtoReflect.Descriptor instead.
+func (*MemberInvite) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ResponseMemberDataArr) GetResponseMemberData() []*ResponseMemberData {
+func (x *MemberInvite) GetUserID() int64 {
 	if x != nil {
-		return x.ResponseMemberData
+		return x.UserID
 	}
-	return nil
+	return 0
 }
 
-type HasFamilyResp struct {
+func (x *MemberInvite) GetIDMember() int64 {
+	if x != nil {
+		return x.IDMember
+	}
+	return 0
+}
+
+func (x *MemberInvite) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *MemberInvite) GetIsAdult() bool {
+	if x != nil {
+		return x.IsAdult
+	}
+	return false
+}
+
+type MemberInviteToken struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Has        bool  `protobuf:"varint,1,opt,name=Has,proto3" json:"Has,omitempty"`
-	IDMainUser int64 `protobuf:"varint,2,opt,name=IDMainUser,proto3" json:"IDMainUser,omitempty"`
-	IDFamily   int64 `protobuf:"varint,3,opt,name=IDFamily,proto3" json:"IDFamily,omitempty"`
+	Token string `protobuf:"bytes,1,opt,name=Token,proto3" json:"Token,omitempty"`
 }
 
-func (x *HasFamilyResp) Reset() {
-	*x = HasFamilyResp{}
+func (x *MemberInviteToken) Reset() {
+	*x = MemberInviteToken{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_profile_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -703,13 +725,13 @@ func (x *HasFamilyResp) Reset() {
 	}
 }
 
-func (x *HasFamilyResp) String() string {
+func (x *MemberInviteToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HasFamilyResp) ProtoMessage() {}
+func (*MemberInviteToken) ProtoMessage() {}
 
-func (x *HasFamilyResp) ProtoReflect() protoreflect.Message {
+func (x *MemberInviteToken) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -721,43 +743,29 @@ func (x *HasFamilyResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HasFamilyResp.ProtoReflect.Descriptor instead.
-func (*HasFamilyResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use MemberInviteToken.ProtoReflect.Descriptor instead.
+func (*MemberInviteToken) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *HasFamilyResp) GetHas() bool {
-	if x != nil {
-		return x.Has
-	}
-	return false
-}
-
-func (x *HasFamilyResp) GetIDMainUser() int64 {
-	if x != nil {
-		return x.IDMainUser
-	}
-	return 0
-}
-
-func (x *HasFamilyResp) GetIDFamily() int64 {
+func (x *MemberInviteToken) GetToken() string {
 	if x != nil {
-		return x.IDFamily
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-type Delete struct {
+type AcceptMemberInviteData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID       *UserID `protobuf:"bytes,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
-	UserToDelete *UserID `protobuf:"bytes,2,opt,name=UserToDelete,proto3" json:"UserToDelete,omitempty"`
+	Token string `protobuf:"bytes,1,opt,name=Token,proto3" json:"Token,omitempty"`
+	Email string `protobuf:"bytes,2,opt,name=Email,proto3" json:"Email,omitempty"`
 }
 
-func (x *Delete) Reset() {
-	*x = Delete{}
+func (x *AcceptMemberInviteData) Reset() {
+	*x = AcceptMemberInviteData{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_profile_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -765,13 +773,13 @@ func (x *Delete) Reset() {
 	}
 }
 
-func (x *Delete) String() string {
+func (x *AcceptMemberInviteData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Delete) ProtoMessage() {}
+func (*AcceptMemberInviteData) ProtoMessage() {}
 
-func (x *Delete) ProtoReflect() protoreflect.Message {
+func (x *AcceptMemberInviteData) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -783,35 +791,39 @@ func (x *Delete) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Delete.ProtoReflect.Descriptor instead.
-func (*Delete) Descriptor() ([]byte, []int) {
+// Deprecated: Use AcceptMemberInviteData.ProtoReflect.Descriptor instead.
+func (*AcceptMemberInviteData) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Delete) GetUserID() *UserID {
+func (x *AcceptMemberInviteData) GetToken() string {
 	if x != nil {
-		return x.UserID
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-func (x *Delete) GetUserToDelete() *UserID {
+func (x *AcceptMemberInviteData) GetEmail() string {
 	if x != nil {
-		return x.UserToDelete
+		return x.Email
 	}
-	return nil
+	return ""
 }
 
-type EmailData struct {
+type ResponseMemberData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email string `protobuf:"bytes,1,opt,name=Email,proto3" json:"Email,omitempty"`
+	ID      int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Avatar  string `protobuf:"bytes,3,opt,name=Avatar,proto3" json:"Avatar,omitempty"`
+	IsAdult bool   `protobuf:"varint,4,opt,name=IsAdult,proto3" json:"IsAdult,omitempty"`
+	IsUser  bool   `protobuf:"varint,5,opt,name=IsUser,proto3" json:"IsUser,omitempty"`
 }
 
-func (x *EmailData) Reset() {
-	*x = EmailData{}
+func (x *ResponseMemberData) Reset() {
+	*x = ResponseMemberData{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_profile_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -819,13 +831,13 @@ func (x *EmailData) Reset() {
 	}
 }
 
-func (x *EmailData) String() string {
+func (x *ResponseMemberData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmailData) ProtoMessage() {}
+func (*ResponseMemberData) ProtoMessage() {}
 
-func (x *EmailData) ProtoReflect() protoreflect.Message {
+func (x *ResponseMemberData) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -837,28 +849,57 @@ func (x *EmailData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmailData.ProtoReflect.Descriptor instead.
-func (*EmailData) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResponseMemberData.ProtoReflect.Descriptor instead.
+func (*ResponseMemberData) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *EmailData) GetEmail() string {
+func (x *ResponseMemberData) GetID() int64 {
 	if x != nil {
-		return x.Email
+		return x.ID
+	}
+	return 0
+}
+
+func (x *ResponseMemberData) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-type Exists struct {
+func (x *ResponseMemberData) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *ResponseMemberData) GetIsAdult() bool {
+	if x != nil {
+		return x.IsAdult
+	}
+	return false
+}
+
+func (x *ResponseMemberData) GetIsUser() bool {
+	if x != nil {
+		return x.IsUser
+	}
+	return false
+}
+
+type ResponseMemberDataArr struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Exists bool `protobuf:"varint,1,opt,name=Exists,proto3" json:"Exists,omitempty"`
+	ResponseMemberData []*ResponseMemberData `protobuf:"bytes,1,rep,name=ResponseMemberData,proto3" json:"ResponseMemberData,omitempty"`
+	NextCursor         string                `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
 }
 
-func (x *Exists) Reset() {
-	*x = Exists{}
+func (x *ResponseMemberDataArr) Reset() {
+	*x = ResponseMemberDataArr{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_profile_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -866,13 +907,13 @@ func (x *Exists) Reset() {
 	}
 }
 
-func (x *Exists) String() string {
+func (x *ResponseMemberDataArr) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Exists) ProtoMessage() {}
+func (*ResponseMemberDataArr) ProtoMessage() {}
 
-func (x *Exists) ProtoReflect() protoreflect.Message {
+func (x *ResponseMemberDataArr) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -884,31 +925,38 @@ func (x *Exists) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Exists.ProtoReflect.Descriptor instead.
-func (*Exists) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResponseMemberDataArr.ProtoReflect.Descriptor instead.
+func (*ResponseMemberDataArr) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Exists) GetExists() bool {
+func (x *ResponseMemberDataArr) GetResponseMemberData() []*ResponseMemberData {
 	if x != nil {
-		return x.Exists
+		return x.ResponseMemberData
 	}
-	return false
+	return nil
 }
 
-type Medicine struct {
+func (x *ResponseMemberDataArr) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type Page struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Image     string `protobuf:"bytes,1,opt,name=Image,proto3" json:"Image,omitempty"`
-	Name      string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	IsTablets bool   `protobuf:"varint,3,opt,name=IsTablets,proto3" json:"IsTablets,omitempty"`
-	Count     int64  `protobuf:"varint,4,opt,name=Count,proto3" json:"Count,omitempty"`
+	Sort   string `protobuf:"bytes,1,opt,name=Sort,proto3" json:"Sort,omitempty"`
+	Desc   bool   `protobuf:"varint,2,opt,name=Desc,proto3" json:"Desc,omitempty"`
+	Cursor string `protobuf:"bytes,3,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
+	Limit  int64  `protobuf:"varint,4,opt,name=Limit,proto3" json:"Limit,omitempty"`
 }
 
-func (x *Medicine) Reset() {
-	*x = Medicine{}
+func (x *Page) Reset() {
+	*x = Page{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_profile_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -916,13 +964,13 @@ func (x *Medicine) Reset() {
 	}
 }
 
-func (x *Medicine) String() string {
+func (x *Page) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Medicine) ProtoMessage() {}
+func (*Page) ProtoMessage() {}
 
-func (x *Medicine) ProtoReflect() protoreflect.Message {
+func (x *Page) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -934,49 +982,52 @@ func (x *Medicine) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Medicine.ProtoReflect.Descriptor instead.
-func (*Medicine) Descriptor() ([]byte, []int) {
+// Deprecated: Use Page.ProtoReflect.Descriptor instead.
+func (*Page) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Medicine) GetImage() string {
+func (x *Page) GetSort() string {
 	if x != nil {
-		return x.Image
+		return x.Sort
 	}
 	return ""
 }
 
-func (x *Medicine) GetName() string {
+func (x *Page) GetDesc() bool {
 	if x != nil {
-		return x.Name
+		return x.Desc
 	}
-	return ""
+	return false
 }
 
-func (x *Medicine) GetIsTablets() bool {
+func (x *Page) GetCursor() string {
 	if x != nil {
-		return x.IsTablets
+		return x.Cursor
 	}
-	return false
+	return ""
 }
 
-func (x *Medicine) GetCount() int64 {
+func (x *Page) GetLimit() int64 {
 	if x != nil {
-		return x.Count
+		return x.Limit
 	}
 	return 0
 }
 
-type DeleteMed struct {
+type FamilyFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MedicineID int64 `protobuf:"varint,1,opt,name=MedicineID,proto3" json:"MedicineID,omitempty"`
+	UserID     int64  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Kind       string `protobuf:"bytes,2,opt,name=Kind,proto3" json:"Kind,omitempty"`
+	OnlyAdults bool   `protobuf:"varint,3,opt,name=OnlyAdults,proto3" json:"OnlyAdults,omitempty"`
+	Page       *Page  `protobuf:"bytes,4,opt,name=Page,proto3" json:"Page,omitempty"`
 }
 
-func (x *DeleteMed) Reset() {
-	*x = DeleteMed{}
+func (x *FamilyFilter) Reset() {
+	*x = FamilyFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_profile_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -984,13 +1035,13 @@ func (x *DeleteMed) Reset() {
 	}
 }
 
-func (x *DeleteMed) String() string {
+func (x *FamilyFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMed) ProtoMessage() {}
+func (*FamilyFilter) ProtoMessage() {}
 
-func (x *DeleteMed) ProtoReflect() protoreflect.Message {
+func (x *FamilyFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1002,29 +1053,51 @@ func (x *DeleteMed) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMed.ProtoReflect.Descriptor instead.
-func (*DeleteMed) Descriptor() ([]byte, []int) {
+// Deprecated: Use FamilyFilter.ProtoReflect.Descriptor instead.
+func (*FamilyFilter) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *DeleteMed) GetMedicineID() int64 {
+func (x *FamilyFilter) GetUserID() int64 {
 	if x != nil {
-		return x.MedicineID
+		return x.UserID
 	}
 	return 0
 }
 
-type AddMed struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *FamilyFilter) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
 
-	UserID   int64     `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
-	Medicine *Medicine `protobuf:"bytes,2,opt,name=Medicine,proto3" json:"Medicine,omitempty"`
+func (x *FamilyFilter) GetOnlyAdults() bool {
+	if x != nil {
+		return x.OnlyAdults
+	}
+	return false
 }
 
-func (x *AddMed) Reset() {
-	*x = AddMed{}
+func (x *FamilyFilter) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type HasFamilyResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Has        bool  `protobuf:"varint,1,opt,name=Has,proto3" json:"Has,omitempty"`
+	IDMainUser int64 `protobuf:"varint,2,opt,name=IDMainUser,proto3" json:"IDMainUser,omitempty"`
+	IDFamily   int64 `protobuf:"varint,3,opt,name=IDFamily,proto3" json:"IDFamily,omitempty"`
+}
+
+func (x *HasFamilyResp) Reset() {
+	*x = HasFamilyResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_profile_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1032,13 +1105,13 @@ func (x *AddMed) Reset() {
 	}
 }
 
-func (x *AddMed) String() string {
+func (x *HasFamilyResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddMed) ProtoMessage() {}
+func (*HasFamilyResp) ProtoMessage() {}
 
-func (x *AddMed) ProtoReflect() protoreflect.Message {
+func (x *HasFamilyResp) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1050,36 +1123,43 @@ func (x *AddMed) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddMed.ProtoReflect.Descriptor instead.
-func (*AddMed) Descriptor() ([]byte, []int) {
+// Deprecated: Use HasFamilyResp.ProtoReflect.Descriptor instead.
+func (*HasFamilyResp) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *AddMed) GetUserID() int64 {
+func (x *HasFamilyResp) GetHas() bool {
 	if x != nil {
-		return x.UserID
+		return x.Has
+	}
+	return false
+}
+
+func (x *HasFamilyResp) GetIDMainUser() int64 {
+	if x != nil {
+		return x.IDMainUser
 	}
 	return 0
 }
 
-func (x *AddMed) GetMedicine() *Medicine {
+func (x *HasFamilyResp) GetIDFamily() int64 {
 	if x != nil {
-		return x.Medicine
+		return x.IDFamily
 	}
-	return nil
+	return 0
 }
 
-type GetMedicineData struct {
+type Delete struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID       int64     `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Medicine *Medicine `protobuf:"bytes,2,opt,name=Medicine,proto3" json:"Medicine,omitempty"`
+	UserID       *UserID `protobuf:"bytes,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	UserToDelete *UserID `protobuf:"bytes,2,opt,name=UserToDelete,proto3" json:"UserToDelete,omitempty"`
 }
 
-func (x *GetMedicineData) Reset() {
-	*x = GetMedicineData{}
+func (x *Delete) Reset() {
+	*x = Delete{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_profile_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1087,13 +1167,13 @@ func (x *GetMedicineData) Reset() {
 	}
 }
 
-func (x *GetMedicineData) String() string {
+func (x *Delete) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMedicineData) ProtoMessage() {}
+func (*Delete) ProtoMessage() {}
 
-func (x *GetMedicineData) ProtoReflect() protoreflect.Message {
+func (x *Delete) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1105,35 +1185,37 @@ func (x *GetMedicineData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMedicineData.ProtoReflect.Descriptor instead.
-func (*GetMedicineData) Descriptor() ([]byte, []int) {
+// Deprecated: Use Delete.ProtoReflect.Descriptor instead.
+func (*Delete) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetMedicineData) GetID() int64 {
+func (x *Delete) GetUserID() *UserID {
 	if x != nil {
-		return x.ID
+		return x.UserID
 	}
-	return 0
+	return nil
 }
 
-func (x *GetMedicineData) GetMedicine() *Medicine {
+func (x *Delete) GetUserToDelete() *UserID {
 	if x != nil {
-		return x.Medicine
+		return x.UserToDelete
 	}
 	return nil
 }
 
-type MedicineArr struct {
+type Person struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MedicineArr []*GetMedicineData `protobuf:"bytes,1,rep,name=MedicineArr,proto3" json:"MedicineArr,omitempty"`
+	UserID   int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IsUser   bool  `protobuf:"varint,2,opt,name=IsUser,proto3" json:"IsUser,omitempty"`
+	IDPerson int64 `protobuf:"varint,3,opt,name=IDPerson,proto3" json:"IDPerson,omitempty"`
 }
 
-func (x *MedicineArr) Reset() {
-	*x = MedicineArr{}
+func (x *Person) Reset() {
+	*x = Person{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_profile_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1141,13 +1223,13 @@ func (x *MedicineArr) Reset() {
 	}
 }
 
-func (x *MedicineArr) String() string {
+func (x *Person) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MedicineArr) ProtoMessage() {}
+func (*Person) ProtoMessage() {}
 
-func (x *MedicineArr) ProtoReflect() protoreflect.Message {
+func (x *Person) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1159,36 +1241,48 @@ func (x *MedicineArr) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MedicineArr.ProtoReflect.Descriptor instead.
-func (*MedicineArr) Descriptor() ([]byte, []int) {
+// Deprecated: Use Person.ProtoReflect.Descriptor instead.
+func (*Person) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *MedicineArr) GetMedicineArr() []*GetMedicineData {
+func (x *Person) GetUserID() int64 {
 	if x != nil {
-		return x.MedicineArr
+		return x.UserID
 	}
-	return nil
+	return 0
 }
 
-type NotificationData struct {
+func (x *Person) GetIsUser() bool {
+	if x != nil {
+		return x.IsUser
+	}
+	return false
+}
+
+func (x *Person) GetIDPerson() int64 {
+	if x != nil {
+		return x.IDPerson
+	}
+	return 0
+}
+
+type HealthData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IDFrom       int64  `protobuf:"varint,1,opt,name=IDFrom,proto3" json:"IDFrom,omitempty"`
-	IsUser       bool   `protobuf:"varint,2,opt,name=IsUser,proto3" json:"IsUser,omitempty"`
-	IDTo         int64  `protobuf:"varint,3,opt,name=IDTo,proto3" json:"IDTo,omitempty"`
-	NameTo       string `protobuf:"bytes,4,opt,name=NameTo,proto3" json:"NameTo,omitempty"`
-	IDMedicine   int64  `protobuf:"varint,5,opt,name=IDMedicine,proto3" json:"IDMedicine,omitempty"`
-	NameMedicine string `protobuf:"bytes,6,opt,name=NameMedicine,proto3" json:"NameMedicine,omitempty"`
-	IsTablets    bool   `protobuf:"varint,7,opt,name=IsTablets,proto3" json:"IsTablets,omitempty"`
-	Time         string `protobuf:"bytes,8,opt,name=time,proto3" json:"time,omitempty"`
-	IsAccepted   bool   `protobuf:"varint,9,opt,name=IsAccepted,proto3" json:"IsAccepted,omitempty"`
+	IsUser            bool     `protobuf:"varint,1,opt,name=IsUser,proto3" json:"IsUser,omitempty"`
+	IDPerson          int64    `protobuf:"varint,2,opt,name=IDPerson,proto3" json:"IDPerson,omitempty"`
+	BirthDate         string   `protobuf:"bytes,3,opt,name=BirthDate,proto3" json:"BirthDate,omitempty"`
+	Weight            float64  `protobuf:"fixed64,4,opt,name=Weight,proto3" json:"Weight,omitempty"`
+	Allergies         []string `protobuf:"bytes,5,rep,name=Allergies,proto3" json:"Allergies,omitempty"`
+	ChronicConditions []string `protobuf:"bytes,6,rep,name=ChronicConditions,proto3" json:"ChronicConditions,omitempty"`
+	Notes             string   `protobuf:"bytes,7,opt,name=Notes,proto3" json:"Notes,omitempty"`
 }
 
-func (x *NotificationData) Reset() {
-	*x = NotificationData{}
+func (x *HealthData) Reset() {
+	*x = HealthData{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_profile_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1196,13 +1290,13 @@ func (x *NotificationData) Reset() {
 	}
 }
 
-func (x *NotificationData) String() string {
+func (x *HealthData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NotificationData) ProtoMessage() {}
+func (*HealthData) ProtoMessage() {}
 
-func (x *NotificationData) ProtoReflect() protoreflect.Message {
+func (x *HealthData) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1214,85 +1308,71 @@ func (x *NotificationData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NotificationData.ProtoReflect.Descriptor instead.
-func (*NotificationData) Descriptor() ([]byte, []int) {
+// Deprecated: Use HealthData.ProtoReflect.Descriptor instead.
+func (*HealthData) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *NotificationData) GetIDFrom() int64 {
-	if x != nil {
-		return x.IDFrom
-	}
-	return 0
-}
-
-func (x *NotificationData) GetIsUser() bool {
+func (x *HealthData) GetIsUser() bool {
 	if x != nil {
 		return x.IsUser
 	}
 	return false
 }
 
-func (x *NotificationData) GetIDTo() int64 {
+func (x *HealthData) GetIDPerson() int64 {
 	if x != nil {
-		return x.IDTo
+		return x.IDPerson
 	}
 	return 0
 }
 
-func (x *NotificationData) GetNameTo() string {
+func (x *HealthData) GetBirthDate() string {
 	if x != nil {
-		return x.NameTo
+		return x.BirthDate
 	}
 	return ""
 }
 
-func (x *NotificationData) GetIDMedicine() int64 {
+func (x *HealthData) GetWeight() float64 {
 	if x != nil {
-		return x.IDMedicine
+		return x.Weight
 	}
 	return 0
 }
 
-func (x *NotificationData) GetNameMedicine() string {
+func (x *HealthData) GetAllergies() []string {
 	if x != nil {
-		return x.NameMedicine
+		return x.Allergies
 	}
-	return ""
+	return nil
 }
 
-func (x *NotificationData) GetIsTablets() bool {
+func (x *HealthData) GetChronicConditions() []string {
 	if x != nil {
-		return x.IsTablets
+		return x.ChronicConditions
 	}
-	return false
+	return nil
 }
 
-func (x *NotificationData) GetTime() string {
+func (x *HealthData) GetNotes() string {
 	if x != nil {
-		return x.Time
+		return x.Notes
 	}
 	return ""
 }
 
-func (x *NotificationData) GetIsAccepted() bool {
-	if x != nil {
-		return x.IsAccepted
-	}
-	return false
-}
-
-type GetNotificationData struct {
+type EditHealthData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID               int64             `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	NotificationData *NotificationData `protobuf:"bytes,2,opt,name=NotificationData,proto3" json:"NotificationData,omitempty"`
+	UserID     int64       `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	HealthData *HealthData `protobuf:"bytes,2,opt,name=HealthData,proto3" json:"HealthData,omitempty"`
 }
 
-func (x *GetNotificationData) Reset() {
-	*x = GetNotificationData{}
+func (x *EditHealthData) Reset() {
+	*x = EditHealthData{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_profile_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1300,13 +1380,13 @@ func (x *GetNotificationData) Reset() {
 	}
 }
 
-func (x *GetNotificationData) String() string {
+func (x *EditHealthData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNotificationData) ProtoMessage() {}
+func (*EditHealthData) ProtoMessage() {}
 
-func (x *GetNotificationData) ProtoReflect() protoreflect.Message {
+func (x *EditHealthData) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1318,35 +1398,35 @@ func (x *GetNotificationData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNotificationData.ProtoReflect.Descriptor instead.
-func (*GetNotificationData) Descriptor() ([]byte, []int) {
+// Deprecated: Use EditHealthData.ProtoReflect.Descriptor instead.
+func (*EditHealthData) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetNotificationData) GetID() int64 {
+func (x *EditHealthData) GetUserID() int64 {
 	if x != nil {
-		return x.ID
+		return x.UserID
 	}
 	return 0
 }
 
-func (x *GetNotificationData) GetNotificationData() *NotificationData {
+func (x *EditHealthData) GetHealthData() *HealthData {
 	if x != nil {
-		return x.NotificationData
+		return x.HealthData
 	}
 	return nil
 }
 
-type DeleteNotificationData struct {
+type EmailData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NotificationID int64 `protobuf:"varint,1,opt,name=NotificationID,proto3" json:"NotificationID,omitempty"`
+	Email string `protobuf:"bytes,1,opt,name=Email,proto3" json:"Email,omitempty"`
 }
 
-func (x *DeleteNotificationData) Reset() {
-	*x = DeleteNotificationData{}
+func (x *EmailData) Reset() {
+	*x = EmailData{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_profile_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1354,13 +1434,13 @@ func (x *DeleteNotificationData) Reset() {
 	}
 }
 
-func (x *DeleteNotificationData) String() string {
+func (x *EmailData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteNotificationData) ProtoMessage() {}
+func (*EmailData) ProtoMessage() {}
 
-func (x *DeleteNotificationData) ProtoReflect() protoreflect.Message {
+func (x *EmailData) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1372,28 +1452,28 @@ func (x *DeleteNotificationData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteNotificationData.ProtoReflect.Descriptor instead.
-func (*DeleteNotificationData) Descriptor() ([]byte, []int) {
+// Deprecated: Use EmailData.ProtoReflect.Descriptor instead.
+func (*EmailData) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *DeleteNotificationData) GetNotificationID() int64 {
+func (x *EmailData) GetEmail() string {
 	if x != nil {
-		return x.NotificationID
+		return x.Email
 	}
-	return 0
+	return ""
 }
 
-type NotificationArr struct {
+type Exists struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GetNotificationData []*GetNotificationData `protobuf:"bytes,1,rep,name=GetNotificationData,proto3" json:"GetNotificationData,omitempty"`
+	Exists bool `protobuf:"varint,1,opt,name=Exists,proto3" json:"Exists,omitempty"`
 }
 
-func (x *NotificationArr) Reset() {
-	*x = NotificationArr{}
+func (x *Exists) Reset() {
+	*x = Exists{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_profile_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1401,13 +1481,13 @@ func (x *NotificationArr) Reset() {
 	}
 }
 
-func (x *NotificationArr) String() string {
+func (x *Exists) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NotificationArr) ProtoMessage() {}
+func (*Exists) ProtoMessage() {}
 
-func (x *NotificationArr) ProtoReflect() protoreflect.Message {
+func (x *Exists) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1419,29 +1499,46 @@ func (x *NotificationArr) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NotificationArr.ProtoReflect.Descriptor instead.
-func (*NotificationArr) Descriptor() ([]byte, []int) {
+// Deprecated: Use Exists.ProtoReflect.Descriptor instead.
+func (*Exists) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *NotificationArr) GetGetNotificationData() []*GetNotificationData {
+func (x *Exists) GetExists() bool {
 	if x != nil {
-		return x.GetNotificationData
+		return x.Exists
 	}
-	return nil
+	return false
 }
 
-type Accept struct {
+type Medicine struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID    int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Count int64 `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	Image          string   `protobuf:"bytes,1,opt,name=Image,proto3" json:"Image,omitempty"`
+	Name           string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	IsTablets      bool     `protobuf:"varint,3,opt,name=IsTablets,proto3" json:"IsTablets,omitempty"`
+	Count          int64    `protobuf:"varint,4,opt,name=Count,proto3" json:"Count,omitempty"`
+	Form           string   `protobuf:"bytes,5,opt,name=Form,proto3" json:"Form,omitempty"`
+	Strength       string   `protobuf:"bytes,6,opt,name=Strength,proto3" json:"Strength,omitempty"`
+	Unit           string   `protobuf:"bytes,7,opt,name=Unit,proto3" json:"Unit,omitempty"`
+	Ingredients    []string `protobuf:"bytes,8,rep,name=Ingredients,proto3" json:"Ingredients,omitempty"`
+	MinCount       int64    `protobuf:"varint,9,opt,name=MinCount,proto3" json:"MinCount,omitempty"`
+	DaysOfSupply   int64    `protobuf:"varint,10,opt,name=DaysOfSupply,proto3" json:"DaysOfSupply,omitempty"`
+	RunOutDate     string   `protobuf:"bytes,11,opt,name=RunOutDate,proto3" json:"RunOutDate,omitempty"`
+	ExpiryDate     string   `protobuf:"bytes,12,opt,name=ExpiryDate,proto3" json:"ExpiryDate,omitempty"`
+	IDKit          int64    `protobuf:"varint,13,opt,name=IDKit,proto3" json:"IDKit,omitempty"`
+	KitName        string   `protobuf:"bytes,14,opt,name=KitName,proto3" json:"KitName,omitempty"`
+	DisposeReason  string   `protobuf:"bytes,15,opt,name=DisposeReason,proto3" json:"DisposeReason,omitempty"`
+	DisposedDate   string   `protobuf:"bytes,16,opt,name=DisposedDate,proto3" json:"DisposedDate,omitempty"`
+	Lot            string   `protobuf:"bytes,17,opt,name=Lot,proto3" json:"Lot,omitempty"`
+	KitLocation    string   `protobuf:"bytes,18,opt,name=KitLocation,proto3" json:"KitLocation,omitempty"`
+	IngredientsSet bool     `protobuf:"varint,19,opt,name=IngredientsSet,proto3" json:"IngredientsSet,omitempty"`
 }
 
-func (x *Accept) Reset() {
-	*x = Accept{}
+func (x *Medicine) Reset() {
+	*x = Medicine{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_profile_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1449,13 +1546,13 @@ func (x *Accept) Reset() {
 	}
 }
 
-func (x *Accept) String() string {
+func (x *Medicine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Accept) ProtoMessage() {}
+func (*Medicine) ProtoMessage() {}
 
-func (x *Accept) ProtoReflect() protoreflect.Message {
+func (x *Medicine) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1467,399 +1564,6561 @@ func (x *Accept) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Accept.ProtoReflect.Descriptor instead.
-func (*Accept) Descriptor() ([]byte, []int) {
+// Deprecated: Use Medicine.ProtoReflect.Descriptor instead.
+func (*Medicine) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *Accept) GetID() int64 {
+func (x *Medicine) GetImage() string {
 	if x != nil {
-		return x.ID
+		return x.Image
 	}
-	return 0
+	return ""
 }
 
-func (x *Accept) GetCount() int64 {
+func (x *Medicine) GetName() string {
 	if x != nil {
-		return x.Count
+		return x.Name
 	}
-	return 0
-}
-
-type Empty struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+	return ""
 }
 
-func (x *Empty) Reset() {
-	*x = Empty{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[24]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Medicine) GetIsTablets() bool {
+	if x != nil {
+		return x.IsTablets
 	}
+	return false
 }
 
-func (x *Empty) String() string {
+func (x *Medicine) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Medicine) GetForm() string {
+	if x != nil {
+		return x.Form
+	}
+	return ""
+}
+
+func (x *Medicine) GetStrength() string {
+	if x != nil {
+		return x.Strength
+	}
+	return ""
+}
+
+func (x *Medicine) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *Medicine) GetIngredients() []string {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+func (x *Medicine) GetMinCount() int64 {
+	if x != nil {
+		return x.MinCount
+	}
+	return 0
+}
+
+func (x *Medicine) GetDaysOfSupply() int64 {
+	if x != nil {
+		return x.DaysOfSupply
+	}
+	return 0
+}
+
+func (x *Medicine) GetRunOutDate() string {
+	if x != nil {
+		return x.RunOutDate
+	}
+	return ""
+}
+
+func (x *Medicine) GetExpiryDate() string {
+	if x != nil {
+		return x.ExpiryDate
+	}
+	return ""
+}
+
+func (x *Medicine) GetIDKit() int64 {
+	if x != nil {
+		return x.IDKit
+	}
+	return 0
+}
+
+func (x *Medicine) GetKitName() string {
+	if x != nil {
+		return x.KitName
+	}
+	return ""
+}
+
+func (x *Medicine) GetDisposeReason() string {
+	if x != nil {
+		return x.DisposeReason
+	}
+	return ""
+}
+
+func (x *Medicine) GetDisposedDate() string {
+	if x != nil {
+		return x.DisposedDate
+	}
+	return ""
+}
+
+func (x *Medicine) GetLot() string {
+	if x != nil {
+		return x.Lot
+	}
+	return ""
+}
+
+func (x *Medicine) GetKitLocation() string {
+	if x != nil {
+		return x.KitLocation
+	}
+	return ""
+}
+
+func (x *Medicine) GetIngredientsSet() bool {
+	if x != nil {
+		return x.IngredientsSet
+	}
+	return false
+}
+
+type ImportRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Row      int64     `protobuf:"varint,1,opt,name=Row,proto3" json:"Row,omitempty"`
+	Medicine *Medicine `protobuf:"bytes,2,opt,name=Medicine,proto3" json:"Medicine,omitempty"`
+}
+
+func (x *ImportRow) Reset() {
+	*x = ImportRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportRow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Empty) ProtoMessage() {}
+func (*ImportRow) ProtoMessage() {}
 
-func (x *Empty) ProtoReflect() protoreflect.Message {
+func (x *ImportRow) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{24}
-}
-
-var File_profile_proto protoreflect.FileDescriptor
-
-var file_profile_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x53, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53,
-	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06,
-	0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x76,
-	0x61, 0x74, 0x61, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x61, 0x69, 0x6e,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x4d, 0x61, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05,
-	0x41, 0x64, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x41, 0x64, 0x75,
-	0x6c, 0x74, 0x22, 0x7f, 0x0a, 0x0f, 0x45, 0x64, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x75, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x44,
-	0x61, 0x74, 0x65, 0x22, 0x38, 0x0a, 0x0e, 0x45, 0x64, 0x69, 0x74, 0x41, 0x76, 0x61, 0x74, 0x61,
-	0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x22, 0x8b, 0x01,
-	0x0a, 0x0f, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49,
-	0x44, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x42,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x1e, 0x0a, 0x08, 0x46,
-	0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x18, 0x0a, 0x06, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x49, 0x44, 0x22, 0x4d, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x46, 0x61,
-	0x6d, 0x69, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x49, 0x73,
-	0x41, 0x64, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x49, 0x73, 0x41,
-	0x64, 0x75, 0x6c, 0x74, 0x22, 0x74, 0x0a, 0x0a, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x44, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x44, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x1e,
-	0x0a, 0x0a, 0x49, 0x44, 0x4d, 0x61, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x49, 0x44, 0x4d, 0x61, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12,
-	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x22, 0x82, 0x01, 0x0a, 0x12, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49,
-	0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x18, 0x0a,
-	0x07, 0x49, 0x73, 0x41, 0x64, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x49, 0x73, 0x41, 0x64, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x73, 0x55, 0x73, 0x65,
-	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x22,
-	0x64, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x44, 0x61, 0x74, 0x61, 0x41, 0x72, 0x72, 0x12, 0x4b, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x12, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x44, 0x61, 0x74, 0x61, 0x22, 0x5d, 0x0a, 0x0d, 0x48, 0x61, 0x73, 0x46, 0x61, 0x6d, 0x69,
-	0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x48, 0x61, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x03, 0x48, 0x61, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x44, 0x4d, 0x61,
-	0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x49, 0x44,
-	0x4d, 0x61, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x44, 0x46, 0x61,
-	0x6d, 0x69, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x44, 0x46, 0x61,
-	0x6d, 0x69, 0x6c, 0x79, 0x22, 0x66, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x27,
-	0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52,
-	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x33, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x54,
-	0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x0c,
-	0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x21, 0x0a, 0x09,
-	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61,
-	0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22,
-	0x20, 0x0a, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x78, 0x69,
-	0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74,
-	0x73, 0x22, 0x68, 0x0a, 0x08, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2b, 0x0a, 0x09, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x65, 0x64, 0x69,
-	0x63, 0x69, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x4d, 0x65,
-	0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x49, 0x44, 0x22, 0x4f, 0x0a, 0x06, 0x41, 0x64, 0x64, 0x4d,
-	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x08, 0x4d, 0x65,
-	0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x52,
-	0x08, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x22, 0x50, 0x0a, 0x0f, 0x47, 0x65, 0x74,
-	0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x08,
-	0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e,
-	0x65, 0x52, 0x08, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x22, 0x49, 0x0a, 0x0b, 0x4d,
-	0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x41, 0x72, 0x72, 0x12, 0x3a, 0x0a, 0x0b, 0x4d, 0x65,
-	0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x41, 0x72, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64,
-	0x69, 0x63, 0x69, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x4d, 0x65, 0x64, 0x69, 0x63,
-	0x69, 0x6e, 0x65, 0x41, 0x72, 0x72, 0x22, 0x84, 0x02, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x49,
-	0x44, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x49, 0x44, 0x46,
-	0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x49,
-	0x44, 0x54, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x49, 0x44, 0x54, 0x6f, 0x12,
-	0x16, 0x0a, 0x06, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x44, 0x4d, 0x65, 0x64,
-	0x69, 0x63, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x49, 0x44, 0x4d,
-	0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x4e, 0x61, 0x6d, 0x65, 0x4d,
-	0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x4e,
-	0x61, 0x6d, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x49,
-	0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x49, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
-	0x0a, 0x49, 0x73, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x49, 0x73, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x22, 0x6c, 0x0a,
-	0x13, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x02, 0x49, 0x44, 0x12, 0x45, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x10, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0x40, 0x0a, 0x16, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x0e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x4e,
-	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x61, 0x0a,
-	0x0f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x72,
-	0x12, 0x4e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x13, 0x47, 0x65, 0x74,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61,
-	0x22, 0x2e, 0x0a, 0x06, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0x80, 0x0a, 0x0a, 0x07, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00,
-	0x12, 0x39, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
-	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x45,
-	0x64, 0x69, 0x74, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x44, 0x61,
-	0x74, 0x61, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x76,
-	0x61, 0x74, 0x61, 0x72, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x55,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x1a, 0x11,
-	0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72,
-	0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x18, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
-	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x46, 0x61, 0x6d, 0x69,
-	0x6c, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x41, 0x64, 0x64,
-	0x54, 0x6f, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0c, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x0e, 0x2e, 0x70, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x31, 0x0a,
-	0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x0f, 0x2e,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x0e,
-	0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x35, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x61,
-	0x6d, 0x69, 0x6c, 0x79, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x09, 0x41, 0x64,
-	0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0e, 0x2e, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3e,
-	0x0a, 0x09, 0x47, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x0f, 0x2e, 0x70, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x1e, 0x2e, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x41, 0x72, 0x72, 0x22, 0x00, 0x12, 0x36,
-	0x0a, 0x09, 0x48, 0x61, 0x73, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x0f, 0x2e, 0x70, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x16, 0x2e, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78,
-	0x69, 0x73, 0x74, 0x73, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45,
-	0x6d, 0x61, 0x69, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x41,
-	0x64, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x64, 0x1a, 0x0e, 0x2e, 0x70, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x36, 0x0a,
-	0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x12,
-	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x4d, 0x65, 0x64, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69,
-	0x63, 0x69, 0x6e, 0x65, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e,
-	0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x41, 0x72, 0x72, 0x22, 0x00, 0x12, 0x3a, 0x0a,
-	0x0c, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x2e,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63,
-	0x69, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0f, 0x41, 0x64, 0x64,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x12, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61,
-	0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x3f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72,
-	0x72, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x03, 0x5a, 0x01,
-	0x2f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_profile_proto_rawDescOnce sync.Once
-	file_profile_proto_rawDescData = file_profile_proto_rawDesc
-)
-
-func file_profile_proto_rawDescGZIP() []byte {
-	file_profile_proto_rawDescOnce.Do(func() {
-		file_profile_proto_rawDescData = protoimpl.X.CompressGZIP(file_profile_proto_rawDescData)
-	})
-	return file_profile_proto_rawDescData
-}
-
-var file_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
-var file_profile_proto_goTypes = []interface{}{
-	(*ProfileData)(nil),            // 0: profile.ProfileData
-	(*EditProfileData)(nil),        // 1: profile.EditProfileData
-	(*EditAvatarData)(nil),         // 2: profile.EditAvatarData
-	(*UploadInputFile)(nil),        // 3: profile.UploadInputFile
-	(*FileName)(nil),               // 4: profile.FileName
-	(*UserID)(nil),                 // 5: profile.UserID
-	(*AddToFamily)(nil),            // 6: profile.AddToFamily
-	(*MemberData)(nil),             // 7: profile.MemberData
-	(*ResponseMemberData)(nil),     // 8: profile.ResponseMemberData
-	(*ResponseMemberDataArr)(nil),  // 9: profile.ResponseMemberDataArr
-	(*HasFamilyResp)(nil),          // 10: profile.HasFamilyResp
-	(*Delete)(nil),                 // 11: profile.Delete
-	(*EmailData)(nil),              // 12: profile.EmailData
-	(*Exists)(nil),                 // 13: profile.Exists
-	(*Medicine)(nil),               // 14: profile.Medicine
-	(*DeleteMed)(nil),              // 15: profile.DeleteMed
-	(*AddMed)(nil),                 // 16: profile.AddMed
-	(*GetMedicineData)(nil),        // 17: profile.GetMedicineData
-	(*MedicineArr)(nil),            // 18: profile.MedicineArr
-	(*NotificationData)(nil),       // 19: profile.NotificationData
-	(*GetNotificationData)(nil),    // 20: profile.GetNotificationData
-	(*DeleteNotificationData)(nil), // 21: profile.DeleteNotificationData
-	(*NotificationArr)(nil),        // 22: profile.NotificationArr
-	(*Accept)(nil),                 // 23: profile.Accept
-	(*Empty)(nil),                  // 24: profile.Empty
-}
-var file_profile_proto_depIdxs = []int32{
-	8,  // 0: profile.ResponseMemberDataArr.ResponseMemberData:type_name -> profile.ResponseMemberData
-	5,  // 1: profile.Delete.UserID:type_name -> profile.UserID
-	5,  // 2: profile.Delete.UserToDelete:type_name -> profile.UserID
-	14, // 3: profile.AddMed.Medicine:type_name -> profile.Medicine
-	14, // 4: profile.GetMedicineData.Medicine:type_name -> profile.Medicine
-	17, // 5: profile.MedicineArr.MedicineArr:type_name -> profile.GetMedicineData
-	19, // 6: profile.GetNotificationData.NotificationData:type_name -> profile.NotificationData
-	20, // 7: profile.NotificationArr.GetNotificationData:type_name -> profile.GetNotificationData
-	5,  // 8: profile.Profile.GetUserProfile:input_type -> profile.UserID
-	1,  // 9: profile.Profile.EditProfile:input_type -> profile.EditProfileData
-	2,  // 10: profile.Profile.EditAvatar:input_type -> profile.EditAvatarData
-	3,  // 11: profile.Profile.UploadAvatar:input_type -> profile.UploadInputFile
-	5,  // 12: profile.Profile.GetAvatar:input_type -> profile.UserID
-	6,  // 13: profile.Profile.AcceptInvitationToFamily:input_type -> profile.AddToFamily
-	5,  // 14: profile.Profile.CreateFamily:input_type -> profile.UserID
-	5,  // 15: profile.Profile.DeleteFamily:input_type -> profile.UserID
-	11, // 16: profile.Profile.DeleteFromFamily:input_type -> profile.Delete
-	11, // 17: profile.Profile.DeleteMember:input_type -> profile.Delete
-	7,  // 18: profile.Profile.AddMember:input_type -> profile.MemberData
-	5,  // 19: profile.Profile.GetFamily:input_type -> profile.UserID
-	5,  // 20: profile.Profile.HasFamily:input_type -> profile.UserID
-	12, // 21: profile.Profile.UserExists:input_type -> profile.EmailData
-	16, // 22: profile.Profile.AddMedicine:input_type -> profile.AddMed
-	15, // 23: profile.Profile.DeleteMedicine:input_type -> profile.DeleteMed
-	5,  // 24: profile.Profile.GetMedicine:input_type -> profile.UserID
-	17, // 25: profile.Profile.EditMedicine:input_type -> profile.GetMedicineData
-	19, // 26: profile.Profile.AddNotification:input_type -> profile.NotificationData
-	21, // 27: profile.Profile.DeleteNotification:input_type -> profile.DeleteNotificationData
-	5,  // 28: profile.Profile.GetNotifications:input_type -> profile.UserID
-	23, // 29: profile.Profile.AcceptNotification:input_type -> profile.Accept
-	0,  // 30: profile.Profile.GetUserProfile:output_type -> profile.ProfileData
-	24, // 31: profile.Profile.EditProfile:output_type -> profile.Empty
-	24, // 32: profile.Profile.EditAvatar:output_type -> profile.Empty
-	4,  // 33: profile.Profile.UploadAvatar:output_type -> profile.FileName
-	4,  // 34: profile.Profile.GetAvatar:output_type -> profile.FileName
-	24, // 35: profile.Profile.AcceptInvitationToFamily:output_type -> profile.Empty
-	24, // 36: profile.Profile.CreateFamily:output_type -> profile.Empty
-	24, // 37: profile.Profile.DeleteFamily:output_type -> profile.Empty
-	24, // 38: profile.Profile.DeleteFromFamily:output_type -> profile.Empty
-	24, // 39: profile.Profile.DeleteMember:output_type -> profile.Empty
-	24, // 40: profile.Profile.AddMember:output_type -> profile.Empty
-	9,  // 41: profile.Profile.GetFamily:output_type -> profile.ResponseMemberDataArr
-	10, // 42: profile.Profile.HasFamily:output_type -> profile.HasFamilyResp
-	13, // 43: profile.Profile.UserExists:output_type -> profile.Exists
-	24, // 44: profile.Profile.AddMedicine:output_type -> profile.Empty
-	24, // 45: profile.Profile.DeleteMedicine:output_type -> profile.Empty
-	18, // 46: profile.Profile.GetMedicine:output_type -> profile.MedicineArr
-	24, // 47: profile.Profile.EditMedicine:output_type -> profile.Empty
-	24, // 48: profile.Profile.AddNotification:output_type -> profile.Empty
-	24, // 49: profile.Profile.DeleteNotification:output_type -> profile.Empty
-	22, // 50: profile.Profile.GetNotifications:output_type -> profile.NotificationArr
-	24, // 51: profile.Profile.AcceptNotification:output_type -> profile.Empty
-	30, // [30:52] is the sub-list for method output_type
-	8,  // [8:30] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
-}
-
-func init() { file_profile_proto_init() }
-func file_profile_proto_init() {
-	if File_profile_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_profile_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProfileData); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRow.ProtoReflect.Descriptor instead.
+func (*ImportRow) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ImportRow) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRow) GetMedicine() *Medicine {
+	if x != nil {
+		return x.Medicine
+	}
+	return nil
+}
+
+type MedicineImport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64        `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Rows   []*ImportRow `protobuf:"bytes,2,rep,name=Rows,proto3" json:"Rows,omitempty"`
+}
+
+func (x *MedicineImport) Reset() {
+	*x = MedicineImport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MedicineImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MedicineImport) ProtoMessage() {}
+
+func (x *MedicineImport) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MedicineImport.ProtoReflect.Descriptor instead.
+func (*MedicineImport) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MedicineImport) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *MedicineImport) GetRows() []*ImportRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type ImportResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Row        int64  `protobuf:"varint,1,opt,name=Row,proto3" json:"Row,omitempty"`
+	IDMedicine int64  `protobuf:"varint,2,opt,name=IDMedicine,proto3" json:"IDMedicine,omitempty"`
+	Created    bool   `protobuf:"varint,3,opt,name=Created,proto3" json:"Created,omitempty"`
+	Error      string `protobuf:"bytes,4,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *ImportResult) Reset() {
+	*x = ImportResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResult) ProtoMessage() {}
+
+func (x *ImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportResult.ProtoReflect.Descriptor instead.
+func (*ImportResult) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ImportResult) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportResult) GetIDMedicine() int64 {
+	if x != nil {
+		return x.IDMedicine
+	}
+	return 0
+}
+
+func (x *ImportResult) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+func (x *ImportResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ImportReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*ImportResult `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"`
+}
+
+func (x *ImportReport) Reset() {
+	*x = ImportReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportReport) ProtoMessage() {}
+
+func (x *ImportReport) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportReport.ProtoReflect.Descriptor instead.
+func (*ImportReport) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ImportReport) GetResults() []*ImportResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type DeleteMed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MedicineID int64 `protobuf:"varint,1,opt,name=MedicineID,proto3" json:"MedicineID,omitempty"`
+}
+
+func (x *DeleteMed) Reset() {
+	*x = DeleteMed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteMed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMed) ProtoMessage() {}
+
+func (x *DeleteMed) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMed.ProtoReflect.Descriptor instead.
+func (*DeleteMed) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteMed) GetMedicineID() int64 {
+	if x != nil {
+		return x.MedicineID
+	}
+	return 0
+}
+
+type DisposeData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     int64  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IDMedicine int64  `protobuf:"varint,2,opt,name=IDMedicine,proto3" json:"IDMedicine,omitempty"`
+	Reason     string `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	Date       string `protobuf:"bytes,4,opt,name=Date,proto3" json:"Date,omitempty"`
+}
+
+func (x *DisposeData) Reset() {
+	*x = DisposeData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisposeData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisposeData) ProtoMessage() {}
+
+func (x *DisposeData) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisposeData.ProtoReflect.Descriptor instead.
+func (*DisposeData) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DisposeData) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *DisposeData) GetIDMedicine() int64 {
+	if x != nil {
+		return x.IDMedicine
+	}
+	return 0
+}
+
+func (x *DisposeData) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DisposeData) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type AddMed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   int64     `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Medicine *Medicine `protobuf:"bytes,2,opt,name=Medicine,proto3" json:"Medicine,omitempty"`
+}
+
+func (x *AddMed) Reset() {
+	*x = AddMed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddMed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMed) ProtoMessage() {}
+
+func (x *AddMed) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMed.ProtoReflect.Descriptor instead.
+func (*AddMed) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AddMed) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *AddMed) GetMedicine() *Medicine {
+	if x != nil {
+		return x.Medicine
+	}
+	return nil
+}
+
+type GetMedicineData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID       int64     `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Medicine *Medicine `protobuf:"bytes,2,opt,name=Medicine,proto3" json:"Medicine,omitempty"`
+	UserID   int64     `protobuf:"varint,3,opt,name=UserID,proto3" json:"UserID,omitempty"`
+}
+
+func (x *GetMedicineData) Reset() {
+	*x = GetMedicineData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMedicineData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMedicineData) ProtoMessage() {}
+
+func (x *GetMedicineData) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMedicineData.ProtoReflect.Descriptor instead.
+func (*GetMedicineData) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetMedicineData) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *GetMedicineData) GetMedicine() *Medicine {
+	if x != nil {
+		return x.Medicine
+	}
+	return nil
+}
+
+func (x *GetMedicineData) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type MedicineArr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MedicineArr []*GetMedicineData `protobuf:"bytes,1,rep,name=MedicineArr,proto3" json:"MedicineArr,omitempty"`
+	NextCursor  string             `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+}
+
+func (x *MedicineArr) Reset() {
+	*x = MedicineArr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MedicineArr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MedicineArr) ProtoMessage() {}
+
+func (x *MedicineArr) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MedicineArr.ProtoReflect.Descriptor instead.
+func (*MedicineArr) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MedicineArr) GetMedicineArr() []*GetMedicineData {
+	if x != nil {
+		return x.MedicineArr
+	}
+	return nil
+}
+
+func (x *MedicineArr) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type MedicineSearch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Text   string `protobuf:"bytes,2,opt,name=Text,proto3" json:"Text,omitempty"`
+	Sort   string `protobuf:"bytes,3,opt,name=Sort,proto3" json:"Sort,omitempty"`
+	Limit  int64  `protobuf:"varint,4,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Offset int64  `protobuf:"varint,5,opt,name=Offset,proto3" json:"Offset,omitempty"`
+}
+
+func (x *MedicineSearch) Reset() {
+	*x = MedicineSearch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MedicineSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MedicineSearch) ProtoMessage() {}
+
+func (x *MedicineSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MedicineSearch.ProtoReflect.Descriptor instead.
+func (*MedicineSearch) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *MedicineSearch) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *MedicineSearch) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *MedicineSearch) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *MedicineSearch) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *MedicineSearch) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type MedicineSearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Medicines []*GetMedicineData `protobuf:"bytes,1,rep,name=Medicines,proto3" json:"Medicines,omitempty"`
+	HasMore   bool               `protobuf:"varint,2,opt,name=HasMore,proto3" json:"HasMore,omitempty"`
+}
+
+func (x *MedicineSearchResult) Reset() {
+	*x = MedicineSearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MedicineSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MedicineSearchResult) ProtoMessage() {}
+
+func (x *MedicineSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MedicineSearchResult.ProtoReflect.Descriptor instead.
+func (*MedicineSearchResult) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MedicineSearchResult) GetMedicines() []*GetMedicineData {
+	if x != nil {
+		return x.Medicines
+	}
+	return nil
+}
+
+func (x *MedicineSearchResult) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type MedicineFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID        int64  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IDKit         int64  `protobuf:"varint,2,opt,name=IDKit,proto3" json:"IDKit,omitempty"`
+	IDOwner       int64  `protobuf:"varint,3,opt,name=IDOwner,proto3" json:"IDOwner,omitempty"`
+	ExpiresBefore string `protobuf:"bytes,4,opt,name=ExpiresBefore,proto3" json:"ExpiresBefore,omitempty"`
+	Page          *Page  `protobuf:"bytes,5,opt,name=Page,proto3" json:"Page,omitempty"`
+}
+
+func (x *MedicineFilter) Reset() {
+	*x = MedicineFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MedicineFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MedicineFilter) ProtoMessage() {}
+
+func (x *MedicineFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MedicineFilter.ProtoReflect.Descriptor instead.
+func (*MedicineFilter) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MedicineFilter) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *MedicineFilter) GetIDKit() int64 {
+	if x != nil {
+		return x.IDKit
+	}
+	return 0
+}
+
+func (x *MedicineFilter) GetIDOwner() int64 {
+	if x != nil {
+		return x.IDOwner
+	}
+	return 0
+}
+
+func (x *MedicineFilter) GetExpiresBefore() string {
+	if x != nil {
+		return x.ExpiresBefore
+	}
+	return ""
+}
+
+func (x *MedicineFilter) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type Kit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID       int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	IDUser   int64  `protobuf:"varint,2,opt,name=IDUser,proto3" json:"IDUser,omitempty"`
+	Name     string `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	Location string `protobuf:"bytes,4,opt,name=Location,proto3" json:"Location,omitempty"`
+}
+
+func (x *Kit) Reset() {
+	*x = Kit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Kit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Kit) ProtoMessage() {}
+
+func (x *Kit) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Kit.ProtoReflect.Descriptor instead.
+func (*Kit) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Kit) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Kit) GetIDUser() int64 {
+	if x != nil {
+		return x.IDUser
+	}
+	return 0
+}
+
+func (x *Kit) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Kit) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+type KitData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Kit    *Kit  `protobuf:"bytes,2,opt,name=Kit,proto3" json:"Kit,omitempty"`
+}
+
+func (x *KitData) Reset() {
+	*x = KitData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KitData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KitData) ProtoMessage() {}
+
+func (x *KitData) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KitData.ProtoReflect.Descriptor instead.
+func (*KitData) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *KitData) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *KitData) GetKit() *Kit {
+	if x != nil {
+		return x.Kit
+	}
+	return nil
+}
+
+type KitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	ID     int64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *KitRequest) Reset() {
+	*x = KitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KitRequest) ProtoMessage() {}
+
+func (x *KitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KitRequest.ProtoReflect.Descriptor instead.
+func (*KitRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *KitRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *KitRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type KitArr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kits []*Kit `protobuf:"bytes,1,rep,name=Kits,proto3" json:"Kits,omitempty"`
+}
+
+func (x *KitArr) Reset() {
+	*x = KitArr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KitArr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KitArr) ProtoMessage() {}
+
+func (x *KitArr) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KitArr.ProtoReflect.Descriptor instead.
+func (*KitArr) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *KitArr) GetKits() []*Kit {
+	if x != nil {
+		return x.Kits
+	}
+	return nil
+}
+
+type TemplateItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Keyword string `protobuf:"bytes,2,opt,name=Keyword,proto3" json:"Keyword,omitempty"`
+	Count   int64  `protobuf:"varint,3,opt,name=Count,proto3" json:"Count,omitempty"`
+	Unit    string `protobuf:"bytes,4,opt,name=Unit,proto3" json:"Unit,omitempty"`
+}
+
+func (x *TemplateItem) Reset() {
+	*x = TemplateItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateItem) ProtoMessage() {}
+
+func (x *TemplateItem) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateItem.ProtoReflect.Descriptor instead.
+func (*TemplateItem) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TemplateItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TemplateItem) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *TemplateItem) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *TemplateItem) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type Template struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID     int64           `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	IDUser int64           `protobuf:"varint,2,opt,name=IDUser,proto3" json:"IDUser,omitempty"`
+	Name   string          `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	Items  []*TemplateItem `protobuf:"bytes,4,rep,name=Items,proto3" json:"Items,omitempty"`
+}
+
+func (x *Template) Reset() {
+	*x = Template{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Template) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Template) ProtoMessage() {}
+
+func (x *Template) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Template.ProtoReflect.Descriptor instead.
+func (*Template) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Template) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Template) GetIDUser() int64 {
+	if x != nil {
+		return x.IDUser
+	}
+	return 0
+}
+
+func (x *Template) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Template) GetItems() []*TemplateItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type TemplateData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   int64     `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Template *Template `protobuf:"bytes,2,opt,name=Template,proto3" json:"Template,omitempty"`
+}
+
+func (x *TemplateData) Reset() {
+	*x = TemplateData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateData) ProtoMessage() {}
+
+func (x *TemplateData) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateData.ProtoReflect.Descriptor instead.
+func (*TemplateData) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *TemplateData) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *TemplateData) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type TemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	ID     int64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *TemplateRequest) Reset() {
+	*x = TemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateRequest) ProtoMessage() {}
+
+func (x *TemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateRequest.ProtoReflect.Descriptor instead.
+func (*TemplateRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TemplateRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *TemplateRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type TemplateArr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Templates []*Template `protobuf:"bytes,1,rep,name=Templates,proto3" json:"Templates,omitempty"`
+}
+
+func (x *TemplateArr) Reset() {
+	*x = TemplateArr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateArr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateArr) ProtoMessage() {}
+
+func (x *TemplateArr) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateArr.ProtoReflect.Descriptor instead.
+func (*TemplateArr) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TemplateArr) GetTemplates() []*Template {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type KitCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID            int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IDKit             int64 `protobuf:"varint,2,opt,name=IDKit,proto3" json:"IDKit,omitempty"`
+	IDTemplate        int64 `protobuf:"varint,3,opt,name=IDTemplate,proto3" json:"IDTemplate,omitempty"`
+	AddToShoppingList bool  `protobuf:"varint,4,opt,name=AddToShoppingList,proto3" json:"AddToShoppingList,omitempty"`
+}
+
+func (x *KitCheck) Reset() {
+	*x = KitCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KitCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KitCheck) ProtoMessage() {}
+
+func (x *KitCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KitCheck.ProtoReflect.Descriptor instead.
+func (*KitCheck) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *KitCheck) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *KitCheck) GetIDKit() int64 {
+	if x != nil {
+		return x.IDKit
+	}
+	return 0
+}
+
+func (x *KitCheck) GetIDTemplate() int64 {
+	if x != nil {
+		return x.IDTemplate
+	}
+	return 0
+}
+
+func (x *KitCheck) GetAddToShoppingList() bool {
+	if x != nil {
+		return x.AddToShoppingList
+	}
+	return false
+}
+
+type KitReportItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Required  int64  `protobuf:"varint,2,opt,name=Required,proto3" json:"Required,omitempty"`
+	Available int64  `protobuf:"varint,3,opt,name=Available,proto3" json:"Available,omitempty"`
+	Expired   int64  `protobuf:"varint,4,opt,name=Expired,proto3" json:"Expired,omitempty"`
+	Unit      string `protobuf:"bytes,5,opt,name=Unit,proto3" json:"Unit,omitempty"`
+	Status    string `protobuf:"bytes,6,opt,name=Status,proto3" json:"Status,omitempty"`
+}
+
+func (x *KitReportItem) Reset() {
+	*x = KitReportItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KitReportItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KitReportItem) ProtoMessage() {}
+
+func (x *KitReportItem) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KitReportItem.ProtoReflect.Descriptor instead.
+func (*KitReportItem) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *KitReportItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KitReportItem) GetRequired() int64 {
+	if x != nil {
+		return x.Required
+	}
+	return 0
+}
+
+func (x *KitReportItem) GetAvailable() int64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *KitReportItem) GetExpired() int64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+func (x *KitReportItem) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *KitReportItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type KitReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*KitReportItem `protobuf:"bytes,1,rep,name=Items,proto3" json:"Items,omitempty"`
+}
+
+func (x *KitReport) Reset() {
+	*x = KitReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KitReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KitReport) ProtoMessage() {}
+
+func (x *KitReport) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KitReport.ProtoReflect.Descriptor instead.
+func (*KitReport) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *KitReport) GetItems() []*KitReportItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type MoveMedicineData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IDMedicine int64 `protobuf:"varint,2,opt,name=IDMedicine,proto3" json:"IDMedicine,omitempty"`
+	IDKit      int64 `protobuf:"varint,3,opt,name=IDKit,proto3" json:"IDKit,omitempty"`
+	Count      int64 `protobuf:"varint,4,opt,name=Count,proto3" json:"Count,omitempty"`
+}
+
+func (x *MoveMedicineData) Reset() {
+	*x = MoveMedicineData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveMedicineData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveMedicineData) ProtoMessage() {}
+
+func (x *MoveMedicineData) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveMedicineData.ProtoReflect.Descriptor instead.
+func (*MoveMedicineData) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *MoveMedicineData) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *MoveMedicineData) GetIDMedicine() int64 {
+	if x != nil {
+		return x.IDMedicine
+	}
+	return 0
+}
+
+func (x *MoveMedicineData) GetIDKit() int64 {
+	if x != nil {
+		return x.IDKit
+	}
+	return 0
+}
+
+func (x *MoveMedicineData) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type MedicineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IDMedicine int64 `protobuf:"varint,2,opt,name=IDMedicine,proto3" json:"IDMedicine,omitempty"`
+}
+
+func (x *MedicineRequest) Reset() {
+	*x = MedicineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MedicineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MedicineRequest) ProtoMessage() {}
+
+func (x *MedicineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MedicineRequest.ProtoReflect.Descriptor instead.
+func (*MedicineRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MedicineRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *MedicineRequest) GetIDMedicine() int64 {
+	if x != nil {
+		return x.IDMedicine
+	}
+	return 0
+}
+
+type StockChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     int64  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IDMedicine int64  `protobuf:"varint,2,opt,name=IDMedicine,proto3" json:"IDMedicine,omitempty"`
+	Delta      int64  `protobuf:"varint,3,opt,name=Delta,proto3" json:"Delta,omitempty"`
+	Reason     string `protobuf:"bytes,4,opt,name=Reason,proto3" json:"Reason,omitempty"`
+}
+
+func (x *StockChange) Reset() {
+	*x = StockChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StockChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockChange) ProtoMessage() {}
+
+func (x *StockChange) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockChange.ProtoReflect.Descriptor instead.
+func (*StockChange) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *StockChange) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *StockChange) GetIDMedicine() int64 {
+	if x != nil {
+		return x.IDMedicine
+	}
+	return 0
+}
+
+func (x *StockChange) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *StockChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type MinCountData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IDMedicine int64 `protobuf:"varint,2,opt,name=IDMedicine,proto3" json:"IDMedicine,omitempty"`
+	MinCount   int64 `protobuf:"varint,3,opt,name=MinCount,proto3" json:"MinCount,omitempty"`
+}
+
+func (x *MinCountData) Reset() {
+	*x = MinCountData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MinCountData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinCountData) ProtoMessage() {}
+
+func (x *MinCountData) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinCountData.ProtoReflect.Descriptor instead.
+func (*MinCountData) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *MinCountData) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *MinCountData) GetIDMedicine() int64 {
+	if x != nil {
+		return x.IDMedicine
+	}
+	return 0
+}
+
+func (x *MinCountData) GetMinCount() int64 {
+	if x != nil {
+		return x.MinCount
+	}
+	return 0
+}
+
+type ShoppingItemData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     int64  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IDMedicine int64  `protobuf:"varint,2,opt,name=IDMedicine,proto3" json:"IDMedicine,omitempty"`
+	Name       string `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	Count      int64  `protobuf:"varint,4,opt,name=Count,proto3" json:"Count,omitempty"`
+	Unit       string `protobuf:"bytes,5,opt,name=Unit,proto3" json:"Unit,omitempty"`
+}
+
+func (x *ShoppingItemData) Reset() {
+	*x = ShoppingItemData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShoppingItemData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingItemData) ProtoMessage() {}
+
+func (x *ShoppingItemData) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingItemData.ProtoReflect.Descriptor instead.
+func (*ShoppingItemData) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ShoppingItemData) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ShoppingItemData) GetIDMedicine() int64 {
+	if x != nil {
+		return x.IDMedicine
+	}
+	return 0
+}
+
+func (x *ShoppingItemData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShoppingItemData) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ShoppingItemData) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type ShoppingItemRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	ID     int64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *ShoppingItemRequest) Reset() {
+	*x = ShoppingItemRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShoppingItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingItemRequest) ProtoMessage() {}
+
+func (x *ShoppingItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingItemRequest.ProtoReflect.Descriptor instead.
+func (*ShoppingItemRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ShoppingItemRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ShoppingItemRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type ShoppingItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	IDUser     int64  `protobuf:"varint,2,opt,name=IDUser,proto3" json:"IDUser,omitempty"`
+	IDMedicine int64  `protobuf:"varint,3,opt,name=IDMedicine,proto3" json:"IDMedicine,omitempty"`
+	Name       string `protobuf:"bytes,4,opt,name=Name,proto3" json:"Name,omitempty"`
+	Count      int64  `protobuf:"varint,5,opt,name=Count,proto3" json:"Count,omitempty"`
+	Unit       string `protobuf:"bytes,6,opt,name=Unit,proto3" json:"Unit,omitempty"`
+	Reason     string `protobuf:"bytes,7,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	IsBought   bool   `protobuf:"varint,8,opt,name=IsBought,proto3" json:"IsBought,omitempty"`
+	IDBoughtBy int64  `protobuf:"varint,9,opt,name=IDBoughtBy,proto3" json:"IDBoughtBy,omitempty"`
+	Created    string `protobuf:"bytes,10,opt,name=Created,proto3" json:"Created,omitempty"`
+}
+
+func (x *ShoppingItem) Reset() {
+	*x = ShoppingItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShoppingItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingItem) ProtoMessage() {}
+
+func (x *ShoppingItem) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingItem.ProtoReflect.Descriptor instead.
+func (*ShoppingItem) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ShoppingItem) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *ShoppingItem) GetIDUser() int64 {
+	if x != nil {
+		return x.IDUser
+	}
+	return 0
+}
+
+func (x *ShoppingItem) GetIDMedicine() int64 {
+	if x != nil {
+		return x.IDMedicine
+	}
+	return 0
+}
+
+func (x *ShoppingItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShoppingItem) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ShoppingItem) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *ShoppingItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ShoppingItem) GetIsBought() bool {
+	if x != nil {
+		return x.IsBought
+	}
+	return false
+}
+
+func (x *ShoppingItem) GetIDBoughtBy() int64 {
+	if x != nil {
+		return x.IDBoughtBy
+	}
+	return 0
+}
+
+func (x *ShoppingItem) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+type ShoppingList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*ShoppingItem `protobuf:"bytes,1,rep,name=Items,proto3" json:"Items,omitempty"`
+}
+
+func (x *ShoppingList) Reset() {
+	*x = ShoppingList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShoppingList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingList) ProtoMessage() {}
+
+func (x *ShoppingList) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingList.ProtoReflect.Descriptor instead.
+func (*ShoppingList) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ShoppingList) GetItems() []*ShoppingItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type StockEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID       int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	IDUser   int64  `protobuf:"varint,2,opt,name=IDUser,proto3" json:"IDUser,omitempty"`
+	NameUser string `protobuf:"bytes,3,opt,name=NameUser,proto3" json:"NameUser,omitempty"`
+	Delta    int64  `protobuf:"varint,4,opt,name=Delta,proto3" json:"Delta,omitempty"`
+	Reason   string `protobuf:"bytes,5,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	Time     string `protobuf:"bytes,6,opt,name=Time,proto3" json:"Time,omitempty"`
+}
+
+func (x *StockEntry) Reset() {
+	*x = StockEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StockEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockEntry) ProtoMessage() {}
+
+func (x *StockEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockEntry.ProtoReflect.Descriptor instead.
+func (*StockEntry) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *StockEntry) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *StockEntry) GetIDUser() int64 {
+	if x != nil {
+		return x.IDUser
+	}
+	return 0
+}
+
+func (x *StockEntry) GetNameUser() string {
+	if x != nil {
+		return x.NameUser
+	}
+	return ""
+}
+
+func (x *StockEntry) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *StockEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StockEntry) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type StockHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count   int64         `protobuf:"varint,1,opt,name=Count,proto3" json:"Count,omitempty"`
+	Unit    string        `protobuf:"bytes,2,opt,name=Unit,proto3" json:"Unit,omitempty"`
+	Entries []*StockEntry `protobuf:"bytes,3,rep,name=Entries,proto3" json:"Entries,omitempty"`
+}
+
+func (x *StockHistory) Reset() {
+	*x = StockHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StockHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockHistory) ProtoMessage() {}
+
+func (x *StockHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockHistory.ProtoReflect.Descriptor instead.
+func (*StockHistory) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *StockHistory) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StockHistory) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *StockHistory) GetEntries() []*StockEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type NotificationData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IDFrom       int64  `protobuf:"varint,1,opt,name=IDFrom,proto3" json:"IDFrom,omitempty"`
+	IsUser       bool   `protobuf:"varint,2,opt,name=IsUser,proto3" json:"IsUser,omitempty"`
+	IDTo         int64  `protobuf:"varint,3,opt,name=IDTo,proto3" json:"IDTo,omitempty"`
+	NameTo       string `protobuf:"bytes,4,opt,name=NameTo,proto3" json:"NameTo,omitempty"`
+	IDMedicine   int64  `protobuf:"varint,5,opt,name=IDMedicine,proto3" json:"IDMedicine,omitempty"`
+	NameMedicine string `protobuf:"bytes,6,opt,name=NameMedicine,proto3" json:"NameMedicine,omitempty"`
+	IsTablets    bool   `protobuf:"varint,7,opt,name=IsTablets,proto3" json:"IsTablets,omitempty"`
+	Time         string `protobuf:"bytes,8,opt,name=time,proto3" json:"time,omitempty"`
+	IsAccepted   bool   `protobuf:"varint,9,opt,name=IsAccepted,proto3" json:"IsAccepted,omitempty"`
+	Override     bool   `protobuf:"varint,10,opt,name=Override,proto3" json:"Override,omitempty"`
+	Clock        string `protobuf:"bytes,11,opt,name=Clock,proto3" json:"Clock,omitempty"`
+	Day          int64  `protobuf:"varint,12,opt,name=Day,proto3" json:"Day,omitempty"`
+}
+
+func (x *NotificationData) Reset() {
+	*x = NotificationData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationData) ProtoMessage() {}
+
+func (x *NotificationData) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationData.ProtoReflect.Descriptor instead.
+func (*NotificationData) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *NotificationData) GetIDFrom() int64 {
+	if x != nil {
+		return x.IDFrom
+	}
+	return 0
+}
+
+func (x *NotificationData) GetIsUser() bool {
+	if x != nil {
+		return x.IsUser
+	}
+	return false
+}
+
+func (x *NotificationData) GetIDTo() int64 {
+	if x != nil {
+		return x.IDTo
+	}
+	return 0
+}
+
+func (x *NotificationData) GetNameTo() string {
+	if x != nil {
+		return x.NameTo
+	}
+	return ""
+}
+
+func (x *NotificationData) GetIDMedicine() int64 {
+	if x != nil {
+		return x.IDMedicine
+	}
+	return 0
+}
+
+func (x *NotificationData) GetNameMedicine() string {
+	if x != nil {
+		return x.NameMedicine
+	}
+	return ""
+}
+
+func (x *NotificationData) GetIsTablets() bool {
+	if x != nil {
+		return x.IsTablets
+	}
+	return false
+}
+
+func (x *NotificationData) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *NotificationData) GetIsAccepted() bool {
+	if x != nil {
+		return x.IsAccepted
+	}
+	return false
+}
+
+func (x *NotificationData) GetOverride() bool {
+	if x != nil {
+		return x.Override
+	}
+	return false
+}
+
+func (x *NotificationData) GetClock() string {
+	if x != nil {
+		return x.Clock
+	}
+	return ""
+}
+
+func (x *NotificationData) GetDay() int64 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+type Warning struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type       string `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	Ingredient string `protobuf:"bytes,2,opt,name=Ingredient,proto3" json:"Ingredient,omitempty"`
+	Message    string `protobuf:"bytes,3,opt,name=Message,proto3" json:"Message,omitempty"`
+	Severity   string `protobuf:"bytes,4,opt,name=Severity,proto3" json:"Severity,omitempty"`
+}
+
+func (x *Warning) Reset() {
+	*x = Warning{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Warning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Warning) ProtoMessage() {}
+
+func (x *Warning) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Warning.ProtoReflect.Descriptor instead.
+func (*Warning) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Warning) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Warning) GetIngredient() string {
+	if x != nil {
+		return x.Ingredient
+	}
+	return ""
+}
+
+func (x *Warning) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Warning) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+type Interaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IDMedicineA   int64  `protobuf:"varint,1,opt,name=IDMedicineA,proto3" json:"IDMedicineA,omitempty"`
+	NameMedicineA string `protobuf:"bytes,2,opt,name=NameMedicineA,proto3" json:"NameMedicineA,omitempty"`
+	IDMedicineB   int64  `protobuf:"varint,3,opt,name=IDMedicineB,proto3" json:"IDMedicineB,omitempty"`
+	NameMedicineB string `protobuf:"bytes,4,opt,name=NameMedicineB,proto3" json:"NameMedicineB,omitempty"`
+	IngredientA   string `protobuf:"bytes,5,opt,name=IngredientA,proto3" json:"IngredientA,omitempty"`
+	IngredientB   string `protobuf:"bytes,6,opt,name=IngredientB,proto3" json:"IngredientB,omitempty"`
+	Severity      string `protobuf:"bytes,7,opt,name=Severity,proto3" json:"Severity,omitempty"`
+	Description   string `protobuf:"bytes,8,opt,name=Description,proto3" json:"Description,omitempty"`
+}
+
+func (x *Interaction) Reset() {
+	*x = Interaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Interaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interaction) ProtoMessage() {}
+
+func (x *Interaction) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interaction.ProtoReflect.Descriptor instead.
+func (*Interaction) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *Interaction) GetIDMedicineA() int64 {
+	if x != nil {
+		return x.IDMedicineA
+	}
+	return 0
+}
+
+func (x *Interaction) GetNameMedicineA() string {
+	if x != nil {
+		return x.NameMedicineA
+	}
+	return ""
+}
+
+func (x *Interaction) GetIDMedicineB() int64 {
+	if x != nil {
+		return x.IDMedicineB
+	}
+	return 0
+}
+
+func (x *Interaction) GetNameMedicineB() string {
+	if x != nil {
+		return x.NameMedicineB
+	}
+	return ""
+}
+
+func (x *Interaction) GetIngredientA() string {
+	if x != nil {
+		return x.IngredientA
+	}
+	return ""
+}
+
+func (x *Interaction) GetIngredientB() string {
+	if x != nil {
+		return x.IngredientB
+	}
+	return ""
+}
+
+func (x *Interaction) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *Interaction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type InteractionArr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interactions []*Interaction `protobuf:"bytes,1,rep,name=Interactions,proto3" json:"Interactions,omitempty"`
+}
+
+func (x *InteractionArr) Reset() {
+	*x = InteractionArr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InteractionArr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InteractionArr) ProtoMessage() {}
+
+func (x *InteractionArr) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InteractionArr.ProtoReflect.Descriptor instead.
+func (*InteractionArr) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *InteractionArr) GetInteractions() []*Interaction {
+	if x != nil {
+		return x.Interactions
+	}
+	return nil
+}
+
+type NotificationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Added    bool       `protobuf:"varint,1,opt,name=Added,proto3" json:"Added,omitempty"`
+	Warnings []*Warning `protobuf:"bytes,2,rep,name=Warnings,proto3" json:"Warnings,omitempty"`
+}
+
+func (x *NotificationResult) Reset() {
+	*x = NotificationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationResult) ProtoMessage() {}
+
+func (x *NotificationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationResult.ProtoReflect.Descriptor instead.
+func (*NotificationResult) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *NotificationResult) GetAdded() bool {
+	if x != nil {
+		return x.Added
+	}
+	return false
+}
+
+func (x *NotificationResult) GetWarnings() []*Warning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type GetNotificationData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID               int64             `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	NotificationData *NotificationData `protobuf:"bytes,2,opt,name=NotificationData,proto3" json:"NotificationData,omitempty"`
+}
+
+func (x *GetNotificationData) Reset() {
+	*x = GetNotificationData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNotificationData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationData) ProtoMessage() {}
+
+func (x *GetNotificationData) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationData.ProtoReflect.Descriptor instead.
+func (*GetNotificationData) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetNotificationData) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *GetNotificationData) GetNotificationData() *NotificationData {
+	if x != nil {
+		return x.NotificationData
+	}
+	return nil
+}
+
+type DeleteNotificationData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NotificationID int64 `protobuf:"varint,1,opt,name=NotificationID,proto3" json:"NotificationID,omitempty"`
+}
+
+func (x *DeleteNotificationData) Reset() {
+	*x = DeleteNotificationData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteNotificationData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotificationData) ProtoMessage() {}
+
+func (x *DeleteNotificationData) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotificationData.ProtoReflect.Descriptor instead.
+func (*DeleteNotificationData) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DeleteNotificationData) GetNotificationID() int64 {
+	if x != nil {
+		return x.NotificationID
+	}
+	return 0
+}
+
+type NotificationArr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GetNotificationData []*GetNotificationData `protobuf:"bytes,1,rep,name=GetNotificationData,proto3" json:"GetNotificationData,omitempty"`
+	NextCursor          string                 `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+}
+
+func (x *NotificationArr) Reset() {
+	*x = NotificationArr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationArr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationArr) ProtoMessage() {}
+
+func (x *NotificationArr) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationArr.ProtoReflect.Descriptor instead.
+func (*NotificationArr) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *NotificationArr) GetGetNotificationData() []*GetNotificationData {
+	if x != nil {
+		return x.GetNotificationData
+	}
+	return nil
+}
+
+func (x *NotificationArr) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type NotificationFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     int64  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IsUser     bool   `protobuf:"varint,2,opt,name=IsUser,proto3" json:"IsUser,omitempty"`
+	IDPerson   int64  `protobuf:"varint,3,opt,name=IDPerson,proto3" json:"IDPerson,omitempty"`
+	IDMedicine int64  `protobuf:"varint,4,opt,name=IDMedicine,proto3" json:"IDMedicine,omitempty"`
+	From       string `protobuf:"bytes,5,opt,name=From,proto3" json:"From,omitempty"`
+	To         string `protobuf:"bytes,6,opt,name=To,proto3" json:"To,omitempty"`
+	Accepted   string `protobuf:"bytes,7,opt,name=Accepted,proto3" json:"Accepted,omitempty"`
+	Page       *Page  `protobuf:"bytes,8,opt,name=Page,proto3" json:"Page,omitempty"`
+}
+
+func (x *NotificationFilter) Reset() {
+	*x = NotificationFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationFilter) ProtoMessage() {}
+
+func (x *NotificationFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationFilter.ProtoReflect.Descriptor instead.
+func (*NotificationFilter) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *NotificationFilter) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *NotificationFilter) GetIsUser() bool {
+	if x != nil {
+		return x.IsUser
+	}
+	return false
+}
+
+func (x *NotificationFilter) GetIDPerson() int64 {
+	if x != nil {
+		return x.IDPerson
+	}
+	return 0
+}
+
+func (x *NotificationFilter) GetIDMedicine() int64 {
+	if x != nil {
+		return x.IDMedicine
+	}
+	return 0
+}
+
+func (x *NotificationFilter) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *NotificationFilter) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *NotificationFilter) GetAccepted() string {
+	if x != nil {
+		return x.Accepted
+	}
+	return ""
+}
+
+func (x *NotificationFilter) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type Accept struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID     int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Count  int64 `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	UserID int64 `protobuf:"varint,3,opt,name=UserID,proto3" json:"UserID,omitempty"`
+}
+
+func (x *Accept) Reset() {
+	*x = Accept{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Accept) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Accept) ProtoMessage() {}
+
+func (x *Accept) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Accept.ProtoReflect.Descriptor instead.
+func (*Accept) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Accept) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Accept) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Accept) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type CalendarToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=Token,proto3" json:"Token,omitempty"`
+}
+
+func (x *CalendarToken) Reset() {
+	*x = CalendarToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalendarToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarToken) ProtoMessage() {}
+
+func (x *CalendarToken) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarToken.ProtoReflect.Descriptor instead.
+func (*CalendarToken) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CalendarToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type CalendarFeed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Events []*GetNotificationData `protobuf:"bytes,2,rep,name=Events,proto3" json:"Events,omitempty"`
+}
+
+func (x *CalendarFeed) Reset() {
+	*x = CalendarFeed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalendarFeed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarFeed) ProtoMessage() {}
+
+func (x *CalendarFeed) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarFeed.ProtoReflect.Descriptor instead.
+func (*CalendarFeed) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CalendarFeed) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CalendarFeed) GetEvents() []*GetNotificationData {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type ReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   int64  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IsUser   bool   `protobuf:"varint,2,opt,name=IsUser,proto3" json:"IsUser,omitempty"`
+	IDPerson int64  `protobuf:"varint,3,opt,name=IDPerson,proto3" json:"IDPerson,omitempty"`
+	From     string `protobuf:"bytes,4,opt,name=From,proto3" json:"From,omitempty"`
+	To       string `protobuf:"bytes,5,opt,name=To,proto3" json:"To,omitempty"`
+}
+
+func (x *ReportRequest) Reset() {
+	*x = ReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportRequest) ProtoMessage() {}
+
+func (x *ReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportRequest.ProtoReflect.Descriptor instead.
+func (*ReportRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ReportRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ReportRequest) GetIsUser() bool {
+	if x != nil {
+		return x.IsUser
+	}
+	return false
+}
+
+func (x *ReportRequest) GetIDPerson() int64 {
+	if x != nil {
+		return x.IDPerson
+	}
+	return 0
+}
+
+func (x *ReportRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ReportRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type RegimenItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IDMedicine  int64    `protobuf:"varint,1,opt,name=IDMedicine,proto3" json:"IDMedicine,omitempty"`
+	Name        string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Strength    string   `protobuf:"bytes,3,opt,name=Strength,proto3" json:"Strength,omitempty"`
+	Unit        string   `protobuf:"bytes,4,opt,name=Unit,proto3" json:"Unit,omitempty"`
+	Dose        float64  `protobuf:"fixed64,5,opt,name=Dose,proto3" json:"Dose,omitempty"`
+	Times       []string `protobuf:"bytes,6,rep,name=Times,proto3" json:"Times,omitempty"`
+	Form        string   `protobuf:"bytes,7,opt,name=Form,proto3" json:"Form,omitempty"`
+	Ingredients []string `protobuf:"bytes,8,rep,name=Ingredients,proto3" json:"Ingredients,omitempty"`
+}
+
+func (x *RegimenItem) Reset() {
+	*x = RegimenItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegimenItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegimenItem) ProtoMessage() {}
+
+func (x *RegimenItem) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegimenItem.ProtoReflect.Descriptor instead.
+func (*RegimenItem) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RegimenItem) GetIDMedicine() int64 {
+	if x != nil {
+		return x.IDMedicine
+	}
+	return 0
+}
+
+func (x *RegimenItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegimenItem) GetStrength() string {
+	if x != nil {
+		return x.Strength
+	}
+	return ""
+}
+
+func (x *RegimenItem) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *RegimenItem) GetDose() float64 {
+	if x != nil {
+		return x.Dose
+	}
+	return 0
+}
+
+func (x *RegimenItem) GetTimes() []string {
+	if x != nil {
+		return x.Times
+	}
+	return nil
+}
+
+func (x *RegimenItem) GetForm() string {
+	if x != nil {
+		return x.Form
+	}
+	return ""
+}
+
+func (x *RegimenItem) GetIngredients() []string {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+type DoseEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IDMedicine   int64  `protobuf:"varint,1,opt,name=IDMedicine,proto3" json:"IDMedicine,omitempty"`
+	NameMedicine string `protobuf:"bytes,2,opt,name=NameMedicine,proto3" json:"NameMedicine,omitempty"`
+	Time         string `protobuf:"bytes,3,opt,name=Time,proto3" json:"Time,omitempty"`
+	Taken        bool   `protobuf:"varint,4,opt,name=Taken,proto3" json:"Taken,omitempty"`
+	ID           int64  `protobuf:"varint,5,opt,name=ID,proto3" json:"ID,omitempty"`
+	Logged       bool   `protobuf:"varint,6,opt,name=Logged,proto3" json:"Logged,omitempty"`
+}
+
+func (x *DoseEntry) Reset() {
+	*x = DoseEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoseEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoseEntry) ProtoMessage() {}
+
+func (x *DoseEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoseEntry.ProtoReflect.Descriptor instead.
+func (*DoseEntry) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DoseEntry) GetIDMedicine() int64 {
+	if x != nil {
+		return x.IDMedicine
+	}
+	return 0
+}
+
+func (x *DoseEntry) GetNameMedicine() string {
+	if x != nil {
+		return x.NameMedicine
+	}
+	return ""
+}
+
+func (x *DoseEntry) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *DoseEntry) GetTaken() bool {
+	if x != nil {
+		return x.Taken
+	}
+	return false
+}
+
+func (x *DoseEntry) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *DoseEntry) GetLogged() bool {
+	if x != nil {
+		return x.Logged
+	}
+	return false
+}
+
+type MedicineStockEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NameMedicine string      `protobuf:"bytes,1,opt,name=NameMedicine,proto3" json:"NameMedicine,omitempty"`
+	Unit         string      `protobuf:"bytes,2,opt,name=Unit,proto3" json:"Unit,omitempty"`
+	Entry        *StockEntry `protobuf:"bytes,3,opt,name=Entry,proto3" json:"Entry,omitempty"`
+}
+
+func (x *MedicineStockEntry) Reset() {
+	*x = MedicineStockEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MedicineStockEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MedicineStockEntry) ProtoMessage() {}
+
+func (x *MedicineStockEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MedicineStockEntry.ProtoReflect.Descriptor instead.
+func (*MedicineStockEntry) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *MedicineStockEntry) GetNameMedicine() string {
+	if x != nil {
+		return x.NameMedicine
+	}
+	return ""
+}
+
+func (x *MedicineStockEntry) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *MedicineStockEntry) GetEntry() *StockEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type AdherenceReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string                `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	From     string                `protobuf:"bytes,2,opt,name=From,proto3" json:"From,omitempty"`
+	To       string                `protobuf:"bytes,3,opt,name=To,proto3" json:"To,omitempty"`
+	Health   *HealthData           `protobuf:"bytes,4,opt,name=Health,proto3" json:"Health,omitempty"`
+	Regimen  []*RegimenItem        `protobuf:"bytes,5,rep,name=Regimen,proto3" json:"Regimen,omitempty"`
+	Doses    []*DoseEntry          `protobuf:"bytes,6,rep,name=Doses,proto3" json:"Doses,omitempty"`
+	Stock    []*MedicineStockEntry `protobuf:"bytes,7,rep,name=Stock,proto3" json:"Stock,omitempty"`
+	TimeZone string                `protobuf:"bytes,8,opt,name=TimeZone,proto3" json:"TimeZone,omitempty"`
+	Changes  []*DoseChange         `protobuf:"bytes,9,rep,name=Changes,proto3" json:"Changes,omitempty"`
+}
+
+func (x *AdherenceReport) Reset() {
+	*x = AdherenceReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdherenceReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdherenceReport) ProtoMessage() {}
+
+func (x *AdherenceReport) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdherenceReport.ProtoReflect.Descriptor instead.
+func (*AdherenceReport) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AdherenceReport) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdherenceReport) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *AdherenceReport) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *AdherenceReport) GetHealth() *HealthData {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+func (x *AdherenceReport) GetRegimen() []*RegimenItem {
+	if x != nil {
+		return x.Regimen
+	}
+	return nil
+}
+
+func (x *AdherenceReport) GetDoses() []*DoseEntry {
+	if x != nil {
+		return x.Doses
+	}
+	return nil
+}
+
+func (x *AdherenceReport) GetStock() []*MedicineStockEntry {
+	if x != nil {
+		return x.Stock
+	}
+	return nil
+}
+
+func (x *AdherenceReport) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *AdherenceReport) GetChanges() []*DoseChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type DoseChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IDMedicine   int64  `protobuf:"varint,1,opt,name=IDMedicine,proto3" json:"IDMedicine,omitempty"`
+	NameMedicine string `protobuf:"bytes,2,opt,name=NameMedicine,proto3" json:"NameMedicine,omitempty"`
+	Action       string `protobuf:"bytes,3,opt,name=Action,proto3" json:"Action,omitempty"`
+	From         string `protobuf:"bytes,4,opt,name=From,proto3" json:"From,omitempty"`
+	To           string `protobuf:"bytes,5,opt,name=To,proto3" json:"To,omitempty"`
+}
+
+func (x *DoseChange) Reset() {
+	*x = DoseChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoseChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoseChange) ProtoMessage() {}
+
+func (x *DoseChange) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoseChange.ProtoReflect.Descriptor instead.
+func (*DoseChange) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DoseChange) GetIDMedicine() int64 {
+	if x != nil {
+		return x.IDMedicine
+	}
+	return 0
+}
+
+func (x *DoseChange) GetNameMedicine() string {
+	if x != nil {
+		return x.NameMedicine
+	}
+	return ""
+}
+
+func (x *DoseChange) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *DoseChange) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *DoseChange) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type NotificationSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     int64    `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Channels   []string `protobuf:"bytes,2,rep,name=Channels,proto3" json:"Channels,omitempty"`
+	QuietFrom  string   `protobuf:"bytes,3,opt,name=QuietFrom,proto3" json:"QuietFrom,omitempty"`
+	QuietTo    string   `protobuf:"bytes,4,opt,name=QuietTo,proto3" json:"QuietTo,omitempty"`
+	Dependents string   `protobuf:"bytes,5,opt,name=Dependents,proto3" json:"Dependents,omitempty"`
+}
+
+func (x *NotificationSettings) Reset() {
+	*x = NotificationSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationSettings) ProtoMessage() {}
+
+func (x *NotificationSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationSettings.ProtoReflect.Descriptor instead.
+func (*NotificationSettings) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *NotificationSettings) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *NotificationSettings) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *NotificationSettings) GetQuietFrom() string {
+	if x != nil {
+		return x.QuietFrom
+	}
+	return ""
+}
+
+func (x *NotificationSettings) GetQuietTo() string {
+	if x != nil {
+		return x.QuietTo
+	}
+	return ""
+}
+
+func (x *NotificationSettings) GetDependents() string {
+	if x != nil {
+		return x.Dependents
+	}
+	return ""
+}
+
+type Webhook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID      int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	UserID  int64  `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	URL     string `protobuf:"bytes,3,opt,name=URL,proto3" json:"URL,omitempty"`
+	Secret  string `protobuf:"bytes,4,opt,name=Secret,proto3" json:"Secret,omitempty"`
+	Created string `protobuf:"bytes,5,opt,name=Created,proto3" json:"Created,omitempty"`
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *Webhook) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Webhook) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *Webhook) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Webhook) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+type WebhookArr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhooks []*Webhook `protobuf:"bytes,1,rep,name=Webhooks,proto3" json:"Webhooks,omitempty"`
+}
+
+func (x *WebhookArr) Reset() {
+	*x = WebhookArr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookArr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookArr) ProtoMessage() {}
+
+func (x *WebhookArr) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookArr.ProtoReflect.Descriptor instead.
+func (*WebhookArr) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *WebhookArr) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type WebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	ID     int64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *WebhookRequest) Reset() {
+	*x = WebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookRequest) ProtoMessage() {}
+
+func (x *WebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookRequest.ProtoReflect.Descriptor instead.
+func (*WebhookRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *WebhookRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *WebhookRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type TelegramCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    string `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Expires string `protobuf:"bytes,2,opt,name=Expires,proto3" json:"Expires,omitempty"`
+}
+
+func (x *TelegramCode) Reset() {
+	*x = TelegramCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TelegramCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TelegramCode) ProtoMessage() {}
+
+func (x *TelegramCode) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TelegramCode.ProtoReflect.Descriptor instead.
+func (*TelegramCode) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *TelegramCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *TelegramCode) GetExpires() string {
+	if x != nil {
+		return x.Expires
+	}
+	return ""
+}
+
+type TelegramLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code   string `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	ChatID int64  `protobuf:"varint,2,opt,name=ChatID,proto3" json:"ChatID,omitempty"`
+}
+
+func (x *TelegramLink) Reset() {
+	*x = TelegramLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TelegramLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TelegramLink) ProtoMessage() {}
+
+func (x *TelegramLink) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TelegramLink.ProtoReflect.Descriptor instead.
+func (*TelegramLink) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *TelegramLink) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *TelegramLink) GetChatID() int64 {
+	if x != nil {
+		return x.ChatID
+	}
+	return 0
+}
+
+type TelegramCallback struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatID         int64  `protobuf:"varint,1,opt,name=ChatID,proto3" json:"ChatID,omitempty"`
+	Action         string `protobuf:"bytes,2,opt,name=Action,proto3" json:"Action,omitempty"`
+	IDNotification int64  `protobuf:"varint,3,opt,name=IDNotification,proto3" json:"IDNotification,omitempty"`
+}
+
+func (x *TelegramCallback) Reset() {
+	*x = TelegramCallback{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TelegramCallback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TelegramCallback) ProtoMessage() {}
+
+func (x *TelegramCallback) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TelegramCallback.ProtoReflect.Descriptor instead.
+func (*TelegramCallback) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *TelegramCallback) GetChatID() int64 {
+	if x != nil {
+		return x.ChatID
+	}
+	return 0
+}
+
+func (x *TelegramCallback) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TelegramCallback) GetIDNotification() int64 {
+	if x != nil {
+		return x.IDNotification
+	}
+	return 0
+}
+
+type RescheduleData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID  int64  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	ID      int64  `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	Minutes int64  `protobuf:"varint,3,opt,name=Minutes,proto3" json:"Minutes,omitempty"`
+	Time    string `protobuf:"bytes,4,opt,name=Time,proto3" json:"Time,omitempty"`
+	Clock   string `protobuf:"bytes,5,opt,name=Clock,proto3" json:"Clock,omitempty"`
+	Day     int64  `protobuf:"varint,6,opt,name=Day,proto3" json:"Day,omitempty"`
+}
+
+func (x *RescheduleData) Reset() {
+	*x = RescheduleData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescheduleData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleData) ProtoMessage() {}
+
+func (x *RescheduleData) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleData.ProtoReflect.Descriptor instead.
+func (*RescheduleData) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *RescheduleData) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RescheduleData) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *RescheduleData) GetMinutes() int64 {
+	if x != nil {
+		return x.Minutes
+	}
+	return 0
+}
+
+func (x *RescheduleData) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *RescheduleData) GetClock() string {
+	if x != nil {
+		return x.Clock
+	}
+	return ""
+}
+
+func (x *RescheduleData) GetDay() int64 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+type RescheduleResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time string `protobuf:"bytes,1,opt,name=Time,proto3" json:"Time,omitempty"`
+}
+
+func (x *RescheduleResult) Reset() {
+	*x = RescheduleResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescheduleResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleResult) ProtoMessage() {}
+
+func (x *RescheduleResult) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleResult.ProtoReflect.Descriptor instead.
+func (*RescheduleResult) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *RescheduleResult) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type PushSubscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   int64  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Endpoint string `protobuf:"bytes,2,opt,name=Endpoint,proto3" json:"Endpoint,omitempty"`
+	P256Dh   string `protobuf:"bytes,3,opt,name=P256dh,proto3" json:"P256dh,omitempty"`
+	Auth     string `protobuf:"bytes,4,opt,name=Auth,proto3" json:"Auth,omitempty"`
+	Session  string `protobuf:"bytes,5,opt,name=Session,proto3" json:"Session,omitempty"`
+}
+
+func (x *PushSubscription) Reset() {
+	*x = PushSubscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushSubscription) ProtoMessage() {}
+
+func (x *PushSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushSubscription.ProtoReflect.Descriptor instead.
+func (*PushSubscription) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *PushSubscription) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *PushSubscription) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *PushSubscription) GetP256Dh() string {
+	if x != nil {
+		return x.P256Dh
+	}
+	return ""
+}
+
+func (x *PushSubscription) GetAuth() string {
+	if x != nil {
+		return x.Auth
+	}
+	return ""
+}
+
+func (x *PushSubscription) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+type PRNRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID       int64  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IsUser       bool   `protobuf:"varint,2,opt,name=IsUser,proto3" json:"IsUser,omitempty"`
+	IDPerson     int64  `protobuf:"varint,3,opt,name=IDPerson,proto3" json:"IDPerson,omitempty"`
+	IDMedicine   int64  `protobuf:"varint,4,opt,name=IDMedicine,proto3" json:"IDMedicine,omitempty"`
+	NameMedicine string `protobuf:"bytes,5,opt,name=NameMedicine,proto3" json:"NameMedicine,omitempty"`
+	MinInterval  int64  `protobuf:"varint,6,opt,name=MinInterval,proto3" json:"MinInterval,omitempty"`
+	MaxDaily     int64  `protobuf:"varint,7,opt,name=MaxDaily,proto3" json:"MaxDaily,omitempty"`
+	LastTime     string `protobuf:"bytes,8,opt,name=LastTime,proto3" json:"LastTime,omitempty"`
+	Taken        int64  `protobuf:"varint,9,opt,name=Taken,proto3" json:"Taken,omitempty"`
+	NextAllowed  string `protobuf:"bytes,10,opt,name=NextAllowed,proto3" json:"NextAllowed,omitempty"`
+}
+
+func (x *PRNRule) Reset() {
+	*x = PRNRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PRNRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PRNRule) ProtoMessage() {}
+
+func (x *PRNRule) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PRNRule.ProtoReflect.Descriptor instead.
+func (*PRNRule) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *PRNRule) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *PRNRule) GetIsUser() bool {
+	if x != nil {
+		return x.IsUser
+	}
+	return false
+}
+
+func (x *PRNRule) GetIDPerson() int64 {
+	if x != nil {
+		return x.IDPerson
+	}
+	return 0
+}
+
+func (x *PRNRule) GetIDMedicine() int64 {
+	if x != nil {
+		return x.IDMedicine
+	}
+	return 0
+}
+
+func (x *PRNRule) GetNameMedicine() string {
+	if x != nil {
+		return x.NameMedicine
+	}
+	return ""
+}
+
+func (x *PRNRule) GetMinInterval() int64 {
+	if x != nil {
+		return x.MinInterval
+	}
+	return 0
+}
+
+func (x *PRNRule) GetMaxDaily() int64 {
+	if x != nil {
+		return x.MaxDaily
+	}
+	return 0
+}
+
+func (x *PRNRule) GetLastTime() string {
+	if x != nil {
+		return x.LastTime
+	}
+	return ""
+}
+
+func (x *PRNRule) GetTaken() int64 {
+	if x != nil {
+		return x.Taken
+	}
+	return 0
+}
+
+func (x *PRNRule) GetNextAllowed() string {
+	if x != nil {
+		return x.NextAllowed
+	}
+	return ""
+}
+
+type PRNRuleArr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules []*PRNRule `protobuf:"bytes,1,rep,name=Rules,proto3" json:"Rules,omitempty"`
+}
+
+func (x *PRNRuleArr) Reset() {
+	*x = PRNRuleArr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PRNRuleArr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PRNRuleArr) ProtoMessage() {}
+
+func (x *PRNRuleArr) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PRNRuleArr.ProtoReflect.Descriptor instead.
+func (*PRNRuleArr) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *PRNRuleArr) GetRules() []*PRNRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type PRNIntake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IsUser     bool  `protobuf:"varint,2,opt,name=IsUser,proto3" json:"IsUser,omitempty"`
+	IDPerson   int64 `protobuf:"varint,3,opt,name=IDPerson,proto3" json:"IDPerson,omitempty"`
+	IDMedicine int64 `protobuf:"varint,4,opt,name=IDMedicine,proto3" json:"IDMedicine,omitempty"`
+	Count      int64 `protobuf:"varint,5,opt,name=Count,proto3" json:"Count,omitempty"`
+	Override   bool  `protobuf:"varint,6,opt,name=Override,proto3" json:"Override,omitempty"`
+}
+
+func (x *PRNIntake) Reset() {
+	*x = PRNIntake{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PRNIntake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PRNIntake) ProtoMessage() {}
+
+func (x *PRNIntake) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PRNIntake.ProtoReflect.Descriptor instead.
+func (*PRNIntake) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *PRNIntake) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *PRNIntake) GetIsUser() bool {
+	if x != nil {
+		return x.IsUser
+	}
+	return false
+}
+
+func (x *PRNIntake) GetIDPerson() int64 {
+	if x != nil {
+		return x.IDPerson
+	}
+	return 0
+}
+
+func (x *PRNIntake) GetIDMedicine() int64 {
+	if x != nil {
+		return x.IDMedicine
+	}
+	return 0
+}
+
+func (x *PRNIntake) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PRNIntake) GetOverride() bool {
+	if x != nil {
+		return x.Override
+	}
+	return false
+}
+
+type PRNResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Added       bool       `protobuf:"varint,1,opt,name=Added,proto3" json:"Added,omitempty"`
+	Warnings    []*Warning `protobuf:"bytes,2,rep,name=Warnings,proto3" json:"Warnings,omitempty"`
+	NextAllowed string     `protobuf:"bytes,3,opt,name=NextAllowed,proto3" json:"NextAllowed,omitempty"`
+}
+
+func (x *PRNResult) Reset() {
+	*x = PRNResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PRNResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PRNResult) ProtoMessage() {}
+
+func (x *PRNResult) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PRNResult.ProtoReflect.Descriptor instead.
+func (*PRNResult) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *PRNResult) GetAdded() bool {
+	if x != nil {
+		return x.Added
+	}
+	return false
+}
+
+func (x *PRNResult) GetWarnings() []*Warning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *PRNResult) GetNextAllowed() string {
+	if x != nil {
+		return x.NextAllowed
+	}
+	return ""
+}
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{90}
+}
+
+var File_profile_proto protoreflect.FileDescriptor
+
+var file_profile_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x53, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x76,
+	0x61, 0x74, 0x61, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x61, 0x69, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x4d, 0x61, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x41, 0x64, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x41, 0x64, 0x75,
+	0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0x9b,
+	0x01, 0x0a, 0x0f, 0x45, 0x64, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0x38, 0x0a, 0x0e,
+	0x45, 0x64, 0x69, 0x74, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x22, 0x8b, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x69,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x1e, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x18, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x22, 0x4d,
+	0x0a, 0x0b, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a,
+	0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x49, 0x73, 0x41, 0x64, 0x75, 0x6c, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x49, 0x73, 0x41, 0x64, 0x75, 0x6c, 0x74, 0x22, 0x74, 0x0a,
+	0x0a, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x49,
+	0x44, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49,
+	0x44, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x44, 0x4d, 0x61, 0x69,
+	0x6e, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x49, 0x44, 0x4d,
+	0x61, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x41,
+	0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x76, 0x61,
+	0x74, 0x61, 0x72, 0x22, 0x61, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x44, 0x46, 0x61,
+	0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x44, 0x46, 0x61,
+	0x6d, 0x69, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x44, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x44, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x72, 0x0a, 0x0c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x49, 0x44, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x49, 0x44, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x18, 0x0a, 0x07, 0x49, 0x73, 0x41, 0x64, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x49, 0x73, 0x41, 0x64, 0x75, 0x6c, 0x74, 0x22, 0x29, 0x0a, 0x11, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x44, 0x0a, 0x16, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x82, 0x01, 0x0a, 0x12,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x49, 0x73, 0x41, 0x64, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x49, 0x73, 0x41, 0x64, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x73, 0x55, 0x73,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72,
+	0x22, 0x84, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x41, 0x72, 0x72, 0x12, 0x4b, 0x0a, 0x12, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x12, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78,
+	0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x5c, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x53, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x53,
+	0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x65, 0x73, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x04, 0x44, 0x65, 0x73, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x7d, 0x0a, 0x0c, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a,
+	0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x6e, 0x6c, 0x79, 0x41, 0x64, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x4f, 0x6e, 0x6c, 0x79, 0x41, 0x64, 0x75, 0x6c, 0x74,
+	0x73, 0x12, 0x21, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04,
+	0x50, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x0d, 0x48, 0x61, 0x73, 0x46, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x48, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x03, 0x48, 0x61, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x44, 0x4d, 0x61, 0x69,
+	0x6e, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x49, 0x44, 0x4d,
+	0x61, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x44, 0x46, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x44, 0x46, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x22, 0x66, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x27, 0x0a,
+	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x33, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x0c, 0x55,
+	0x73, 0x65, 0x72, 0x54, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x54, 0x0a, 0x06, 0x50,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x49,
+	0x73, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x44, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x44, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x22, 0xd8, 0x01, 0x0a, 0x0a, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x16, 0x0a, 0x06, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x44, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x44, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x69, 0x72, 0x74, 0x68, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x42, 0x69, 0x72, 0x74, 0x68, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6c,
+	0x6c, 0x65, 0x72, 0x67, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x41,
+	0x6c, 0x6c, 0x65, 0x72, 0x67, 0x69, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x43, 0x68, 0x72, 0x6f,
+	0x6e, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x11, 0x43, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x5d, 0x0a, 0x0e,
+	0x45, 0x64, 0x69, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16,
+	0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x33, 0x0a, 0x0a, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x0a, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x22, 0x21, 0x0a, 0x09, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x20,
+	0x0a, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
+	0x22, 0xa4, 0x04, 0x0a, 0x08, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x46,
+	0x6f, 0x72, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x6f, 0x72, 0x6d, 0x12,
+	0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x55,
+	0x6e, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x6e, 0x69, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x4d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a,
+	0x0c, 0x44, 0x61, 0x79, 0x73, 0x4f, 0x66, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x44, 0x61, 0x79, 0x73, 0x4f, 0x66, 0x53, 0x75, 0x70, 0x70, 0x6c,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x75, 0x6e, 0x4f, 0x75, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x52, 0x75, 0x6e, 0x4f, 0x75, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x44, 0x61, 0x74, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x44, 0x4b, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x49, 0x44, 0x4b, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x4b, 0x69, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4b, 0x69, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x24, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x44, 0x69, 0x73, 0x70, 0x6f, 0x73,
+	0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x70, 0x6f,
+	0x73, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x44,
+	0x69, 0x73, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4c,
+	0x6f, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4c, 0x6f, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x4b, 0x69, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x4b, 0x69, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x26, 0x0a, 0x0e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65,
+	0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x74, 0x22, 0x4c, 0x0a, 0x09, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x6f, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x52, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x52, 0x6f, 0x77, 0x12, 0x2d, 0x0a, 0x08, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69,
+	0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x4d, 0x65, 0x64,
+	0x69, 0x63, 0x69, 0x6e, 0x65, 0x22, 0x50, 0x0a, 0x0e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e,
+	0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x26, 0x0a, 0x04, 0x52, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f,
+	0x77, 0x52, 0x04, 0x52, 0x6f, 0x77, 0x73, 0x22, 0x70, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x52, 0x6f, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x52, 0x6f, 0x77, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x44, 0x4d,
+	0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x49,
+	0x44, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3f, 0x0a, 0x0c, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x2b, 0x0a, 0x09, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x65, 0x64, 0x69, 0x63,
+	0x69, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x4d, 0x65, 0x64,
+	0x69, 0x63, 0x69, 0x6e, 0x65, 0x49, 0x44, 0x22, 0x71, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x70, 0x6f,
+	0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e,
+	0x0a, 0x0a, 0x49, 0x44, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x49, 0x44, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x44, 0x61, 0x74, 0x65, 0x22, 0x4f, 0x0a, 0x06, 0x41, 0x64,
+	0x64, 0x4d, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x08,
+	0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e,
+	0x65, 0x52, 0x08, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x22, 0x68, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x2d,
+	0x0a, 0x08, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63,
+	0x69, 0x6e, 0x65, 0x52, 0x08, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x69, 0x0a, 0x0b, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e,
+	0x65, 0x41, 0x72, 0x72, 0x12, 0x3a, 0x0a, 0x0b, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65,
+	0x41, 0x72, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x0b, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x41, 0x72, 0x72,
+	0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x22, 0x7e, 0x0a, 0x0e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x65,
+	0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x65, 0x78, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x53, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x53, 0x6f,
+	0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x68, 0x0a, 0x14, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x4d, 0x65, 0x64, 0x69,
+	0x63, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x48, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x48, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x0e, 0x4d,
+	0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x44, 0x4b, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x49, 0x44, 0x4b, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x49,
+	0x44, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x49, 0x44,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x50,
+	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x22, 0x5d,
+	0x0a, 0x03, 0x4b, 0x69, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x44, 0x55, 0x73, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x49, 0x44, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x41, 0x0a,
+	0x07, 0x4b, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x1e, 0x0a, 0x03, 0x4b, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4b, 0x69, 0x74, 0x52, 0x03, 0x4b, 0x69, 0x74,
+	0x22, 0x34, 0x0a, 0x0a, 0x4b, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x22, 0x2a, 0x0a, 0x06, 0x4b, 0x69, 0x74, 0x41, 0x72, 0x72,
+	0x12, 0x20, 0x0a, 0x04, 0x4b, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4b, 0x69, 0x74, 0x52, 0x04, 0x4b, 0x69,
+	0x74, 0x73, 0x22, 0x66, 0x0a, 0x0c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x6e, 0x69, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x6e, 0x69, 0x74, 0x22, 0x73, 0x0a, 0x08, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x44, 0x55, 0x73, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x49, 0x44, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22,
+	0x55, 0x0a, 0x0c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x08, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x08, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x39, 0x0a, 0x0f, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49,
+	0x44, 0x22, 0x3e, 0x0a, 0x0b, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x72, 0x72,
+	0x12, 0x2f, 0x0a, 0x09, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x09, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x22, 0x86, 0x01, 0x0a, 0x08, 0x4b, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x16,
+	0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x44, 0x4b, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x49, 0x44, 0x4b, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x49, 0x44, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x49, 0x44, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x11,
+	0x41, 0x64, 0x64, 0x54, 0x6f, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x53, 0x68,
+	0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xa3, 0x01, 0x0a, 0x0d, 0x4b,
+	0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x6e, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x39, 0x0a, 0x09, 0x4b, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2c, 0x0a,
+	0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4b, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x76, 0x0a, 0x10, 0x4d,
+	0x6f, 0x76, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x44, 0x4d, 0x65, 0x64,
+	0x69, 0x63, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x49, 0x44, 0x4d,
+	0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x44, 0x4b, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x49, 0x44, 0x4b, 0x69, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x49, 0x0a, 0x0f, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e,
+	0x0a, 0x0a, 0x49, 0x44, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x49, 0x44, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x22, 0x73,
+	0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x44, 0x4d, 0x65, 0x64, 0x69, 0x63,
+	0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x49, 0x44, 0x4d, 0x65, 0x64,
+	0x69, 0x63, 0x69, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x62, 0x0a, 0x0c, 0x4d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x49,
+	0x44, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x49, 0x44, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4d,
+	0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x4d,
+	0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x10, 0x53, 0x68, 0x6f, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x44, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69,
+	0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x49, 0x44, 0x4d, 0x65, 0x64, 0x69,
+	0x63, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x55, 0x6e, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x6e,
+	0x69, 0x74, 0x22, 0x3d, 0x0a, 0x13, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49,
+	0x44, 0x22, 0x82, 0x02, 0x0a, 0x0c, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x44, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x49, 0x44, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x44,
+	0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x49, 0x44, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x6e, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x49, 0x73, 0x42, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x49, 0x73, 0x42, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x49, 0x44, 0x42, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x42, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x49, 0x44, 0x42, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x42, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x3b, 0x0a, 0x0c, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e,
+	0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x44, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x49, 0x44, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x4e, 0x61,
+	0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4e, 0x61,
+	0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x67, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x63,
+	0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x55, 0x6e, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x6e,
+	0x69, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x53, 0x74,
+	0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x22, 0xc8, 0x02, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x44, 0x46, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x49, 0x44, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x16,
+	0x0a, 0x06, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x49, 0x44, 0x54, 0x6f, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x49, 0x44, 0x54, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x61,
+	0x6d, 0x65, 0x54, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4e, 0x61, 0x6d, 0x65,
+	0x54, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x44, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x49, 0x44, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69,
+	0x6e, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69,
+	0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x65,
+	0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x73, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x49, 0x73,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x76, 0x65, 0x72,
+	0x72, 0x69, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x4f, 0x76, 0x65, 0x72,
+	0x72, 0x69, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x44, 0x61,
+	0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x44, 0x61, 0x79, 0x22, 0x73, 0x0a, 0x07,
+	0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x49,
+	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
+	0x79, 0x22, 0x9f, 0x02, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x44, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x41,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x49, 0x44, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69,
+	0x6e, 0x65, 0x41, 0x12, 0x24, 0x0a, 0x0d, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63,
+	0x69, 0x6e, 0x65, 0x41, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4e, 0x61, 0x6d, 0x65,
+	0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x41, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x44, 0x4d,
+	0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x42, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x49, 0x44, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x42, 0x12, 0x24, 0x0a, 0x0d, 0x4e,
+	0x61, 0x6d, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x42, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65,
+	0x42, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x41,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x41, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x42, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
+	0x69, 0x65, 0x6e, 0x74, 0x42, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x4a, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x72, 0x72, 0x12, 0x38, 0x0a, 0x0c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x58, 0x0a, 0x12, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x64, 0x64, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x08, 0x57,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52,
+	0x08, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x6c, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x45, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x10, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0x40, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x26, 0x0a, 0x0e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x72, 0x12, 0x4e, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x13, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a,
+	0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xe3, 0x01,
+	0x0a, 0x12, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x49, 0x73,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x44, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x44, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x44, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x49, 0x44, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x46, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x54, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64,
+	0x12, 0x21, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x50,
+	0x61, 0x67, 0x65, 0x22, 0x46, 0x0a, 0x06, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a,
+	0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x25, 0x0a, 0x0d, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x58, 0x0a, 0x0c, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x7f, 0x0a, 0x0d,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x49, 0x44, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x49, 0x44, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x72, 0x6f,
+	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x54, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x54, 0x6f, 0x22, 0xd1, 0x01,
+	0x0a, 0x0b, 0x52, 0x65, 0x67, 0x69, 0x6d, 0x65, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1e, 0x0a,
+	0x0a, 0x49, 0x44, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x49, 0x44, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x12, 0x0a,
+	0x04, 0x55, 0x6e, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x6e, 0x69,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x6f, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x44, 0x6f, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x46,
+	0x6f, 0x72, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x6f, 0x72, 0x6d, 0x12,
+	0x20, 0x0a, 0x0b, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0xa1, 0x01, 0x0a, 0x09, 0x44, 0x6f, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x1e, 0x0a, 0x0a, 0x49, 0x44, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x49, 0x44, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x12,
+	0x22, 0x0a, 0x0c, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63,
+	0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x61, 0x6b, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x54, 0x61, 0x6b, 0x65, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x4c,
+	0x6f, 0x67, 0x67, 0x65, 0x64, 0x22, 0x77, 0x0a, 0x12, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e,
+	0x65, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x4e,
+	0x61, 0x6d, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x55, 0x6e, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55,
+	0x6e, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x53, 0x74, 0x6f,
+	0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xce,
+	0x02, 0x0a, 0x0f, 0x41, 0x64, 0x68, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x54, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x54, 0x6f, 0x12, 0x2b, 0x0a, 0x06, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x07, 0x52, 0x65, 0x67, 0x69, 0x6d,
+	0x65, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6d, 0x65, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x07,
+	0x52, 0x65, 0x67, 0x69, 0x6d, 0x65, 0x6e, 0x12, 0x28, 0x0a, 0x05, 0x44, 0x6f, 0x73, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x44, 0x6f, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x44, 0x6f, 0x73, 0x65,
+	0x73, 0x12, 0x31, 0x0a, 0x05, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63,
+	0x69, 0x6e, 0x65, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x53,
+	0x74, 0x6f, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65,
+	0x12, 0x2d, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x44, 0x6f, 0x73, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22,
+	0x8c, 0x01, 0x0a, 0x0a, 0x44, 0x6f, 0x73, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x49, 0x44, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x49, 0x44, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69,
+	0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x72,
+	0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x0e,
+	0x0a, 0x02, 0x54, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x54, 0x6f, 0x22, 0xa2,
+	0x01, 0x0a, 0x14, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x51,
+	0x75, 0x69, 0x65, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x51, 0x75, 0x69, 0x65, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x51, 0x75, 0x69,
+	0x65, 0x74, 0x54, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x51, 0x75, 0x69, 0x65,
+	0x74, 0x54, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x75, 0x0a, 0x07, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x3a, 0x0a, 0x0a, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x41, 0x72, 0x72, 0x12, 0x2c, 0x0a, 0x08, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x08, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0x38, 0x0a, 0x0e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44,
+	0x22, 0x3c, 0x0a, 0x0c, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x22, 0x3a,
+	0x0a, 0x0c, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x12,
+	0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x68, 0x61, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x43, 0x68, 0x61, 0x74, 0x49, 0x44, 0x22, 0x6a, 0x0a, 0x10, 0x54, 0x65,
+	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x16,
+	0x0a, 0x06, 0x43, 0x68, 0x61, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x43, 0x68, 0x61, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26,
+	0x0a, 0x0e, 0x49, 0x44, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x49, 0x44, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8e, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x44, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x44, 0x61, 0x79, 0x22, 0x26, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x8c, 0x01, 0x0a, 0x10, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x32, 0x35, 0x36,
+	0x64, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x32, 0x35, 0x36, 0x64, 0x68,
+	0x12, 0x12, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x41, 0x75, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xab,
+	0x02, 0x0a, 0x07, 0x50, 0x52, 0x4e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x44,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x44,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x44, 0x4d, 0x65, 0x64, 0x69,
+	0x63, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x49, 0x44, 0x4d, 0x65,
+	0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x65,
+	0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x4e, 0x61,
+	0x6d, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x69,
+	0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x4d, 0x69, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
+	0x4d, 0x61, 0x78, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x4d, 0x61, 0x78, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x61, 0x73, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x61, 0x73, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x61, 0x6b, 0x65, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x54, 0x61, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x4e, 0x65,
+	0x78, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x4e, 0x65, 0x78, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x22, 0x34, 0x0a, 0x0a,
+	0x50, 0x52, 0x4e, 0x52, 0x75, 0x6c, 0x65, 0x41, 0x72, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x2e, 0x50, 0x52, 0x4e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x09, 0x50, 0x52, 0x4e, 0x49, 0x6e, 0x74, 0x61, 0x6b, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x73, 0x55, 0x73,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x49, 0x44, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x49, 0x44, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
+	0x49, 0x44, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x49, 0x44, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x22, 0x71,
+	0x0a, 0x09, 0x50, 0x52, 0x4e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x41,
+	0x64, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x41, 0x64, 0x64, 0x65,
+	0x64, 0x12, 0x2c, 0x0a, 0x08, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x57, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x4e, 0x65, 0x78, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4e, 0x65, 0x78, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xa3, 0x21, 0x0a, 0x07, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22,
+	0x00, 0x12, 0x39, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a,
+	0x45, 0x64, 0x69, 0x74, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41,
+	0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x1a,
+	0x11, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x74, 0x61,
+	0x72, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x18, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x46, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x41, 0x64,
+	0x64, 0x54, 0x6f, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x0f, 0x2e, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x0e, 0x2e, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x31,
+	0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x0f,
+	0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a,
+	0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x35, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x46,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x4c, 0x65, 0x61, 0x76,
+	0x65, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0c, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x1a, 0x0e, 0x2e, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x32, 0x0a,
+	0x09, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x1a,
+	0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x3d, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0e,
+	0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x49, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x1a, 0x1a, 0x2e,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x12, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x46, 0x61, 0x6d, 0x69,
+	0x6c, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x41, 0x72, 0x72, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x09, 0x48, 0x61,
+	0x73, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x33, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12,
+	0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x45, 0x64, 0x69, 0x74, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e,
+	0x45, 0x64, 0x69, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0e,
+	0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x33, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x12,
+	0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x44, 0x61,
+	0x74, 0x61, 0x1a, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x78, 0x69,
+	0x73, 0x74, 0x73, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x64, 0x69,
+	0x63, 0x69, 0x6e, 0x65, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x41,
+	0x64, 0x64, 0x4d, 0x65, 0x64, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x1a, 0x0e, 0x2e,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x3e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x12, 0x17,
+	0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x41, 0x72, 0x72, 0x22, 0x00, 0x12,
+	0x4a, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e,
+	0x65, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4d, 0x65, 0x64, 0x69,
+	0x63, 0x69, 0x6e, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0f, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x17,
+	0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e,
+	0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00,
+	0x12, 0x39, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x70, 0x6f, 0x73, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63,
+	0x69, 0x6e, 0x65, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x44, 0x69,
+	0x73, 0x70, 0x6f, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x08, 0x47,
+	0x65, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x41, 0x72, 0x72, 0x22, 0x00,
+	0x12, 0x3d, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63,
+	0x69, 0x6e, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4d, 0x65,
+	0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x3a, 0x0a, 0x0c, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x12,
+	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64,
+	0x69, 0x63, 0x69, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0b, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x44, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e,
+	0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4d,
+	0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x2e, 0x4d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0e,
+	0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x2c, 0x0a, 0x06, 0x41, 0x64, 0x64, 0x4b, 0x69, 0x74, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4b, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0e, 0x2e, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x2d,
+	0x0a, 0x07, 0x47, 0x65, 0x74, 0x4b, 0x69, 0x74, 0x73, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4b, 0x69, 0x74, 0x41, 0x72, 0x72, 0x22, 0x00, 0x12, 0x2d, 0x0a,
+	0x07, 0x45, 0x64, 0x69, 0x74, 0x4b, 0x69, 0x74, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x2e, 0x4b, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x09,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x69, 0x74, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x2e, 0x4b, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
+	0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x3b, 0x0a, 0x0c, 0x4d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65,
+	0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x4d,
+	0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0e, 0x2e, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x36, 0x0a,
+	0x0b, 0x41, 0x64, 0x64, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x72, 0x72, 0x22, 0x00, 0x12, 0x3c,
+	0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x08,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x4b, 0x69, 0x74, 0x12, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x2e, 0x4b, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x1a, 0x12, 0x2e, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4b, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22,
+	0x00, 0x12, 0x3e, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x53,
+	0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x1a,
+	0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x3b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e,
+	0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x43,
+	0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x53, 0x68,
+	0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x6f,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0f, 0x41, 0x64, 0x64,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0e, 0x2e,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x4b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x72, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x12,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0c, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65,
+	0x67, 0x69, 0x6d, 0x65, 0x6e, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x72, 0x22,
+	0x00, 0x12, 0x40, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x1a, 0x0e, 0x2e, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x22, 0x00, 0x12, 0x48, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x41, 0x64, 0x68, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x41, 0x64, 0x68, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x18, 0x45, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a,
+	0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x32, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12,
+	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x41, 0x72, 0x72, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0d,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x17, 0x2e,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0f,
+	0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a,
+	0x15, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72,
+	0x61, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0c, 0x4c, 0x69, 0x6e, 0x6b,
+	0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x4c, 0x69, 0x6e, 0x6b, 0x1a,
+	0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x33, 0x0a, 0x0e, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x16, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x1a, 0x0e, 0x2e, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a,
+	0x13, 0x41, 0x64, 0x64, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x50,
+	0x75, 0x73, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x45, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x75, 0x73, 0x68, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x50,
+	0x52, 0x4e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x50, 0x52, 0x4e, 0x52, 0x75, 0x6c, 0x65, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x50, 0x52, 0x4e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x50, 0x52, 0x4e, 0x52, 0x75, 0x6c, 0x65, 0x41, 0x72, 0x72, 0x22,
+	0x00, 0x12, 0x33, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x52, 0x4e, 0x52, 0x75,
+	0x6c, 0x65, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x50, 0x52, 0x4e,
+	0x52, 0x75, 0x6c, 0x65, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x50, 0x52, 0x4e,
+	0x49, 0x6e, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x50, 0x52, 0x4e, 0x49, 0x6e, 0x74, 0x61, 0x6b, 0x65, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x50, 0x52, 0x4e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00,
+	0x42, 0x03, 0x5a, 0x01, 0x2f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_profile_proto_rawDescOnce sync.Once
+	file_profile_proto_rawDescData = file_profile_proto_rawDesc
+)
+
+func file_profile_proto_rawDescGZIP() []byte {
+	file_profile_proto_rawDescOnce.Do(func() {
+		file_profile_proto_rawDescData = protoimpl.X.CompressGZIP(file_profile_proto_rawDescData)
+	})
+	return file_profile_proto_rawDescData
+}
+
+var file_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 91)
+var file_profile_proto_goTypes = []interface{}{
+	(*ProfileData)(nil),            // 0: profile.ProfileData
+	(*EditProfileData)(nil),        // 1: profile.EditProfileData
+	(*EditAvatarData)(nil),         // 2: profile.EditAvatarData
+	(*UploadInputFile)(nil),        // 3: profile.UploadInputFile
+	(*FileName)(nil),               // 4: profile.FileName
+	(*UserID)(nil),                 // 5: profile.UserID
+	(*AddToFamily)(nil),            // 6: profile.AddToFamily
+	(*MemberData)(nil),             // 7: profile.MemberData
+	(*PromoteMemberData)(nil),      // 8: profile.PromoteMemberData
+	(*MemberInvite)(nil),           // 9: profile.MemberInvite
+	(*MemberInviteToken)(nil),      // 10: profile.MemberInviteToken
+	(*AcceptMemberInviteData)(nil), // 11: profile.AcceptMemberInviteData
+	(*ResponseMemberData)(nil),     // 12: profile.ResponseMemberData
+	(*ResponseMemberDataArr)(nil),  // 13: profile.ResponseMemberDataArr
+	(*Page)(nil),                   // 14: profile.Page
+	(*FamilyFilter)(nil),           // 15: profile.FamilyFilter
+	(*HasFamilyResp)(nil),          // 16: profile.HasFamilyResp
+	(*Delete)(nil),                 // 17: profile.Delete
+	(*Person)(nil),                 // 18: profile.Person
+	(*HealthData)(nil),             // 19: profile.HealthData
+	(*EditHealthData)(nil),         // 20: profile.EditHealthData
+	(*EmailData)(nil),              // 21: profile.EmailData
+	(*Exists)(nil),                 // 22: profile.Exists
+	(*Medicine)(nil),               // 23: profile.Medicine
+	(*ImportRow)(nil),              // 24: profile.ImportRow
+	(*MedicineImport)(nil),         // 25: profile.MedicineImport
+	(*ImportResult)(nil),           // 26: profile.ImportResult
+	(*ImportReport)(nil),           // 27: profile.ImportReport
+	(*DeleteMed)(nil),              // 28: profile.DeleteMed
+	(*DisposeData)(nil),            // 29: profile.DisposeData
+	(*AddMed)(nil),                 // 30: profile.AddMed
+	(*GetMedicineData)(nil),        // 31: profile.GetMedicineData
+	(*MedicineArr)(nil),            // 32: profile.MedicineArr
+	(*MedicineSearch)(nil),         // 33: profile.MedicineSearch
+	(*MedicineSearchResult)(nil),   // 34: profile.MedicineSearchResult
+	(*MedicineFilter)(nil),         // 35: profile.MedicineFilter
+	(*Kit)(nil),                    // 36: profile.Kit
+	(*KitData)(nil),                // 37: profile.KitData
+	(*KitRequest)(nil),             // 38: profile.KitRequest
+	(*KitArr)(nil),                 // 39: profile.KitArr
+	(*TemplateItem)(nil),           // 40: profile.TemplateItem
+	(*Template)(nil),               // 41: profile.Template
+	(*TemplateData)(nil),           // 42: profile.TemplateData
+	(*TemplateRequest)(nil),        // 43: profile.TemplateRequest
+	(*TemplateArr)(nil),            // 44: profile.TemplateArr
+	(*KitCheck)(nil),               // 45: profile.KitCheck
+	(*KitReportItem)(nil),          // 46: profile.KitReportItem
+	(*KitReport)(nil),              // 47: profile.KitReport
+	(*MoveMedicineData)(nil),       // 48: profile.MoveMedicineData
+	(*MedicineRequest)(nil),        // 49: profile.MedicineRequest
+	(*StockChange)(nil),            // 50: profile.StockChange
+	(*MinCountData)(nil),           // 51: profile.MinCountData
+	(*ShoppingItemData)(nil),       // 52: profile.ShoppingItemData
+	(*ShoppingItemRequest)(nil),    // 53: profile.ShoppingItemRequest
+	(*ShoppingItem)(nil),           // 54: profile.ShoppingItem
+	(*ShoppingList)(nil),           // 55: profile.ShoppingList
+	(*StockEntry)(nil),             // 56: profile.StockEntry
+	(*StockHistory)(nil),           // 57: profile.StockHistory
+	(*NotificationData)(nil),       // 58: profile.NotificationData
+	(*Warning)(nil),                // 59: profile.Warning
+	(*Interaction)(nil),            // 60: profile.Interaction
+	(*InteractionArr)(nil),         // 61: profile.InteractionArr
+	(*NotificationResult)(nil),     // 62: profile.NotificationResult
+	(*GetNotificationData)(nil),    // 63: profile.GetNotificationData
+	(*DeleteNotificationData)(nil), // 64: profile.DeleteNotificationData
+	(*NotificationArr)(nil),        // 65: profile.NotificationArr
+	(*NotificationFilter)(nil),     // 66: profile.NotificationFilter
+	(*Accept)(nil),                 // 67: profile.Accept
+	(*CalendarToken)(nil),          // 68: profile.CalendarToken
+	(*CalendarFeed)(nil),           // 69: profile.CalendarFeed
+	(*ReportRequest)(nil),          // 70: profile.ReportRequest
+	(*RegimenItem)(nil),            // 71: profile.RegimenItem
+	(*DoseEntry)(nil),              // 72: profile.DoseEntry
+	(*MedicineStockEntry)(nil),     // 73: profile.MedicineStockEntry
+	(*AdherenceReport)(nil),        // 74: profile.AdherenceReport
+	(*DoseChange)(nil),             // 75: profile.DoseChange
+	(*NotificationSettings)(nil),   // 76: profile.NotificationSettings
+	(*Webhook)(nil),                // 77: profile.Webhook
+	(*WebhookArr)(nil),             // 78: profile.WebhookArr
+	(*WebhookRequest)(nil),         // 79: profile.WebhookRequest
+	(*TelegramCode)(nil),           // 80: profile.TelegramCode
+	(*TelegramLink)(nil),           // 81: profile.TelegramLink
+	(*TelegramCallback)(nil),       // 82: profile.TelegramCallback
+	(*RescheduleData)(nil),         // 83: profile.RescheduleData
+	(*RescheduleResult)(nil),       // 84: profile.RescheduleResult
+	(*PushSubscription)(nil),       // 85: profile.PushSubscription
+	(*PRNRule)(nil),                // 86: profile.PRNRule
+	(*PRNRuleArr)(nil),             // 87: profile.PRNRuleArr
+	(*PRNIntake)(nil),              // 88: profile.PRNIntake
+	(*PRNResult)(nil),              // 89: profile.PRNResult
+	(*Empty)(nil),                  // 90: profile.Empty
+}
+var file_profile_proto_depIdxs = []int32{
+	12,  // 0: profile.ResponseMemberDataArr.ResponseMemberData:type_name -> profile.ResponseMemberData
+	14,  // 1: profile.FamilyFilter.Page:type_name -> profile.Page
+	5,   // 2: profile.Delete.UserID:type_name -> profile.UserID
+	5,   // 3: profile.Delete.UserToDelete:type_name -> profile.UserID
+	19,  // 4: profile.EditHealthData.HealthData:type_name -> profile.HealthData
+	23,  // 5: profile.ImportRow.Medicine:type_name -> profile.Medicine
+	24,  // 6: profile.MedicineImport.Rows:type_name -> profile.ImportRow
+	26,  // 7: profile.ImportReport.Results:type_name -> profile.ImportResult
+	23,  // 8: profile.AddMed.Medicine:type_name -> profile.Medicine
+	23,  // 9: profile.GetMedicineData.Medicine:type_name -> profile.Medicine
+	31,  // 10: profile.MedicineArr.MedicineArr:type_name -> profile.GetMedicineData
+	31,  // 11: profile.MedicineSearchResult.Medicines:type_name -> profile.GetMedicineData
+	14,  // 12: profile.MedicineFilter.Page:type_name -> profile.Page
+	36,  // 13: profile.KitData.Kit:type_name -> profile.Kit
+	36,  // 14: profile.KitArr.Kits:type_name -> profile.Kit
+	40,  // 15: profile.Template.Items:type_name -> profile.TemplateItem
+	41,  // 16: profile.TemplateData.Template:type_name -> profile.Template
+	41,  // 17: profile.TemplateArr.Templates:type_name -> profile.Template
+	46,  // 18: profile.KitReport.Items:type_name -> profile.KitReportItem
+	54,  // 19: profile.ShoppingList.Items:type_name -> profile.ShoppingItem
+	56,  // 20: profile.StockHistory.Entries:type_name -> profile.StockEntry
+	60,  // 21: profile.InteractionArr.Interactions:type_name -> profile.Interaction
+	59,  // 22: profile.NotificationResult.Warnings:type_name -> profile.Warning
+	58,  // 23: profile.GetNotificationData.NotificationData:type_name -> profile.NotificationData
+	63,  // 24: profile.NotificationArr.GetNotificationData:type_name -> profile.GetNotificationData
+	14,  // 25: profile.NotificationFilter.Page:type_name -> profile.Page
+	63,  // 26: profile.CalendarFeed.Events:type_name -> profile.GetNotificationData
+	56,  // 27: profile.MedicineStockEntry.Entry:type_name -> profile.StockEntry
+	19,  // 28: profile.AdherenceReport.Health:type_name -> profile.HealthData
+	71,  // 29: profile.AdherenceReport.Regimen:type_name -> profile.RegimenItem
+	72,  // 30: profile.AdherenceReport.Doses:type_name -> profile.DoseEntry
+	73,  // 31: profile.AdherenceReport.Stock:type_name -> profile.MedicineStockEntry
+	75,  // 32: profile.AdherenceReport.Changes:type_name -> profile.DoseChange
+	77,  // 33: profile.WebhookArr.Webhooks:type_name -> profile.Webhook
+	86,  // 34: profile.PRNRuleArr.Rules:type_name -> profile.PRNRule
+	59,  // 35: profile.PRNResult.Warnings:type_name -> profile.Warning
+	5,   // 36: profile.Profile.GetUserProfile:input_type -> profile.UserID
+	1,   // 37: profile.Profile.EditProfile:input_type -> profile.EditProfileData
+	2,   // 38: profile.Profile.EditAvatar:input_type -> profile.EditAvatarData
+	3,   // 39: profile.Profile.UploadAvatar:input_type -> profile.UploadInputFile
+	5,   // 40: profile.Profile.GetAvatar:input_type -> profile.UserID
+	6,   // 41: profile.Profile.AcceptInvitationToFamily:input_type -> profile.AddToFamily
+	5,   // 42: profile.Profile.CreateFamily:input_type -> profile.UserID
+	5,   // 43: profile.Profile.DeleteFamily:input_type -> profile.UserID
+	17,  // 44: profile.Profile.DeleteFromFamily:input_type -> profile.Delete
+	5,   // 45: profile.Profile.LeaveFamily:input_type -> profile.UserID
+	17,  // 46: profile.Profile.DeleteMember:input_type -> profile.Delete
+	7,   // 47: profile.Profile.AddMember:input_type -> profile.MemberData
+	8,   // 48: profile.Profile.PromoteMember:input_type -> profile.PromoteMemberData
+	9,   // 49: profile.Profile.CreateMemberInvite:input_type -> profile.MemberInvite
+	11,  // 50: profile.Profile.AcceptMemberInvite:input_type -> profile.AcceptMemberInviteData
+	15,  // 51: profile.Profile.GetFamily:input_type -> profile.FamilyFilter
+	5,   // 52: profile.Profile.HasFamily:input_type -> profile.UserID
+	18,  // 53: profile.Profile.GetHealth:input_type -> profile.Person
+	20,  // 54: profile.Profile.EditHealth:input_type -> profile.EditHealthData
+	21,  // 55: profile.Profile.UserExists:input_type -> profile.EmailData
+	30,  // 56: profile.Profile.AddMedicine:input_type -> profile.AddMed
+	28,  // 57: profile.Profile.DeleteMedicine:input_type -> profile.DeleteMed
+	35,  // 58: profile.Profile.GetMedicine:input_type -> profile.MedicineFilter
+	33,  // 59: profile.Profile.SearchMedicine:input_type -> profile.MedicineSearch
+	25,  // 60: profile.Profile.ImportMedicines:input_type -> profile.MedicineImport
+	29,  // 61: profile.Profile.DisposeMedicine:input_type -> profile.DisposeData
+	5,   // 62: profile.Profile.GetTrash:input_type -> profile.UserID
+	49,  // 63: profile.Profile.RestoreMedicine:input_type -> profile.MedicineRequest
+	31,  // 64: profile.Profile.EditMedicine:input_type -> profile.GetMedicineData
+	50,  // 65: profile.Profile.ChangeStock:input_type -> profile.StockChange
+	49,  // 66: profile.Profile.GetStockHistory:input_type -> profile.MedicineRequest
+	51,  // 67: profile.Profile.SetMinCount:input_type -> profile.MinCountData
+	37,  // 68: profile.Profile.AddKit:input_type -> profile.KitData
+	5,   // 69: profile.Profile.GetKits:input_type -> profile.UserID
+	37,  // 70: profile.Profile.EditKit:input_type -> profile.KitData
+	38,  // 71: profile.Profile.DeleteKit:input_type -> profile.KitRequest
+	48,  // 72: profile.Profile.MoveMedicine:input_type -> profile.MoveMedicineData
+	42,  // 73: profile.Profile.AddTemplate:input_type -> profile.TemplateData
+	5,   // 74: profile.Profile.GetTemplates:input_type -> profile.UserID
+	43,  // 75: profile.Profile.DeleteTemplate:input_type -> profile.TemplateRequest
+	45,  // 76: profile.Profile.CheckKit:input_type -> profile.KitCheck
+	52,  // 77: profile.Profile.AddShoppingItem:input_type -> profile.ShoppingItemData
+	5,   // 78: profile.Profile.GetShoppingList:input_type -> profile.UserID
+	53,  // 79: profile.Profile.CheckShoppingItem:input_type -> profile.ShoppingItemRequest
+	53,  // 80: profile.Profile.DeleteShoppingItem:input_type -> profile.ShoppingItemRequest
+	58,  // 81: profile.Profile.AddNotification:input_type -> profile.NotificationData
+	64,  // 82: profile.Profile.DeleteNotification:input_type -> profile.DeleteNotificationData
+	66,  // 83: profile.Profile.GetNotifications:input_type -> profile.NotificationFilter
+	67,  // 84: profile.Profile.AcceptNotification:input_type -> profile.Accept
+	83,  // 85: profile.Profile.RescheduleNotification:input_type -> profile.RescheduleData
+	18,  // 86: profile.Profile.AuditRegimen:input_type -> profile.Person
+	18,  // 87: profile.Profile.CreateCalendarToken:input_type -> profile.Person
+	18,  // 88: profile.Profile.RevokeCalendarToken:input_type -> profile.Person
+	68,  // 89: profile.Profile.GetCalendar:input_type -> profile.CalendarToken
+	70,  // 90: profile.Profile.GetAdherenceReport:input_type -> profile.ReportRequest
+	5,   // 91: profile.Profile.GetNotificationSettings:input_type -> profile.UserID
+	76,  // 92: profile.Profile.EditNotificationSettings:input_type -> profile.NotificationSettings
+	77,  // 93: profile.Profile.AddWebhook:input_type -> profile.Webhook
+	5,   // 94: profile.Profile.GetWebhooks:input_type -> profile.UserID
+	79,  // 95: profile.Profile.DeleteWebhook:input_type -> profile.WebhookRequest
+	5,   // 96: profile.Profile.CreateTelegramCode:input_type -> profile.UserID
+	81,  // 97: profile.Profile.LinkTelegram:input_type -> profile.TelegramLink
+	5,   // 98: profile.Profile.UnlinkTelegram:input_type -> profile.UserID
+	82,  // 99: profile.Profile.HandleTelegramCallback:input_type -> profile.TelegramCallback
+	85,  // 100: profile.Profile.AddPushSubscription:input_type -> profile.PushSubscription
+	85,  // 101: profile.Profile.DeletePushSubscription:input_type -> profile.PushSubscription
+	86,  // 102: profile.Profile.SetPRNRule:input_type -> profile.PRNRule
+	18,  // 103: profile.Profile.GetPRNRules:input_type -> profile.Person
+	86,  // 104: profile.Profile.DeletePRNRule:input_type -> profile.PRNRule
+	88,  // 105: profile.Profile.LogPRNIntake:input_type -> profile.PRNIntake
+	0,   // 106: profile.Profile.GetUserProfile:output_type -> profile.ProfileData
+	90,  // 107: profile.Profile.EditProfile:output_type -> profile.Empty
+	90,  // 108: profile.Profile.EditAvatar:output_type -> profile.Empty
+	4,   // 109: profile.Profile.UploadAvatar:output_type -> profile.FileName
+	4,   // 110: profile.Profile.GetAvatar:output_type -> profile.FileName
+	90,  // 111: profile.Profile.AcceptInvitationToFamily:output_type -> profile.Empty
+	90,  // 112: profile.Profile.CreateFamily:output_type -> profile.Empty
+	90,  // 113: profile.Profile.DeleteFamily:output_type -> profile.Empty
+	90,  // 114: profile.Profile.DeleteFromFamily:output_type -> profile.Empty
+	90,  // 115: profile.Profile.LeaveFamily:output_type -> profile.Empty
+	90,  // 116: profile.Profile.DeleteMember:output_type -> profile.Empty
+	90,  // 117: profile.Profile.AddMember:output_type -> profile.Empty
+	90,  // 118: profile.Profile.PromoteMember:output_type -> profile.Empty
+	10,  // 119: profile.Profile.CreateMemberInvite:output_type -> profile.MemberInviteToken
+	90,  // 120: profile.Profile.AcceptMemberInvite:output_type -> profile.Empty
+	13,  // 121: profile.Profile.GetFamily:output_type -> profile.ResponseMemberDataArr
+	16,  // 122: profile.Profile.HasFamily:output_type -> profile.HasFamilyResp
+	19,  // 123: profile.Profile.GetHealth:output_type -> profile.HealthData
+	90,  // 124: profile.Profile.EditHealth:output_type -> profile.Empty
+	22,  // 125: profile.Profile.UserExists:output_type -> profile.Exists
+	90,  // 126: profile.Profile.AddMedicine:output_type -> profile.Empty
+	90,  // 127: profile.Profile.DeleteMedicine:output_type -> profile.Empty
+	32,  // 128: profile.Profile.GetMedicine:output_type -> profile.MedicineArr
+	34,  // 129: profile.Profile.SearchMedicine:output_type -> profile.MedicineSearchResult
+	27,  // 130: profile.Profile.ImportMedicines:output_type -> profile.ImportReport
+	90,  // 131: profile.Profile.DisposeMedicine:output_type -> profile.Empty
+	32,  // 132: profile.Profile.GetTrash:output_type -> profile.MedicineArr
+	90,  // 133: profile.Profile.RestoreMedicine:output_type -> profile.Empty
+	90,  // 134: profile.Profile.EditMedicine:output_type -> profile.Empty
+	90,  // 135: profile.Profile.ChangeStock:output_type -> profile.Empty
+	57,  // 136: profile.Profile.GetStockHistory:output_type -> profile.StockHistory
+	90,  // 137: profile.Profile.SetMinCount:output_type -> profile.Empty
+	90,  // 138: profile.Profile.AddKit:output_type -> profile.Empty
+	39,  // 139: profile.Profile.GetKits:output_type -> profile.KitArr
+	90,  // 140: profile.Profile.EditKit:output_type -> profile.Empty
+	90,  // 141: profile.Profile.DeleteKit:output_type -> profile.Empty
+	90,  // 142: profile.Profile.MoveMedicine:output_type -> profile.Empty
+	90,  // 143: profile.Profile.AddTemplate:output_type -> profile.Empty
+	44,  // 144: profile.Profile.GetTemplates:output_type -> profile.TemplateArr
+	90,  // 145: profile.Profile.DeleteTemplate:output_type -> profile.Empty
+	47,  // 146: profile.Profile.CheckKit:output_type -> profile.KitReport
+	90,  // 147: profile.Profile.AddShoppingItem:output_type -> profile.Empty
+	55,  // 148: profile.Profile.GetShoppingList:output_type -> profile.ShoppingList
+	90,  // 149: profile.Profile.CheckShoppingItem:output_type -> profile.Empty
+	90,  // 150: profile.Profile.DeleteShoppingItem:output_type -> profile.Empty
+	62,  // 151: profile.Profile.AddNotification:output_type -> profile.NotificationResult
+	90,  // 152: profile.Profile.DeleteNotification:output_type -> profile.Empty
+	65,  // 153: profile.Profile.GetNotifications:output_type -> profile.NotificationArr
+	90,  // 154: profile.Profile.AcceptNotification:output_type -> profile.Empty
+	84,  // 155: profile.Profile.RescheduleNotification:output_type -> profile.RescheduleResult
+	61,  // 156: profile.Profile.AuditRegimen:output_type -> profile.InteractionArr
+	68,  // 157: profile.Profile.CreateCalendarToken:output_type -> profile.CalendarToken
+	90,  // 158: profile.Profile.RevokeCalendarToken:output_type -> profile.Empty
+	69,  // 159: profile.Profile.GetCalendar:output_type -> profile.CalendarFeed
+	74,  // 160: profile.Profile.GetAdherenceReport:output_type -> profile.AdherenceReport
+	76,  // 161: profile.Profile.GetNotificationSettings:output_type -> profile.NotificationSettings
+	90,  // 162: profile.Profile.EditNotificationSettings:output_type -> profile.Empty
+	77,  // 163: profile.Profile.AddWebhook:output_type -> profile.Webhook
+	78,  // 164: profile.Profile.GetWebhooks:output_type -> profile.WebhookArr
+	90,  // 165: profile.Profile.DeleteWebhook:output_type -> profile.Empty
+	80,  // 166: profile.Profile.CreateTelegramCode:output_type -> profile.TelegramCode
+	90,  // 167: profile.Profile.LinkTelegram:output_type -> profile.Empty
+	90,  // 168: profile.Profile.UnlinkTelegram:output_type -> profile.Empty
+	90,  // 169: profile.Profile.HandleTelegramCallback:output_type -> profile.Empty
+	90,  // 170: profile.Profile.AddPushSubscription:output_type -> profile.Empty
+	90,  // 171: profile.Profile.DeletePushSubscription:output_type -> profile.Empty
+	90,  // 172: profile.Profile.SetPRNRule:output_type -> profile.Empty
+	87,  // 173: profile.Profile.GetPRNRules:output_type -> profile.PRNRuleArr
+	90,  // 174: profile.Profile.DeletePRNRule:output_type -> profile.Empty
+	89,  // 175: profile.Profile.LogPRNIntake:output_type -> profile.PRNResult
+	106, // [106:176] is the sub-list for method output_type
+	36,  // [36:106] is the sub-list for method input_type
+	36,  // [36:36] is the sub-list for extension type_name
+	36,  // [36:36] is the sub-list for extension extendee
+	0,   // [0:36] is the sub-list for field type_name
+}
+
+func init() { file_profile_proto_init() }
+func file_profile_proto_init() {
+	if File_profile_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_profile_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditProfileData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditAvatarData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadInputFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileName); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddToFamily); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoteMemberData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberInvite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberInviteToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptMemberInviteData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseMemberData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseMemberDataArr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Page); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FamilyFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasFamilyResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Delete); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Person); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditHealthData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmailData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Exists); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Medicine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MedicineImport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteMed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisposeData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddMed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMedicineData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MedicineArr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MedicineSearch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MedicineSearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MedicineFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Kit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KitData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KitArr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Template); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateArr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KitCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KitReportItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KitReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveMedicineData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MedicineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StockChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MinCountData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShoppingItemData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShoppingItemRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShoppingItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1870,8 +8129,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditProfileData); i {
+		file_profile_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShoppingList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1882,8 +8141,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditAvatarData); i {
+		file_profile_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StockEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1894,8 +8153,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadInputFile); i {
+		file_profile_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StockHistory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1906,8 +8165,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileName); i {
+		file_profile_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1918,8 +8177,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserID); i {
+		file_profile_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Warning); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1930,8 +8189,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddToFamily); i {
+		file_profile_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1942,8 +8201,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MemberData); i {
+		file_profile_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InteractionArr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1954,8 +8213,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseMemberData); i {
+		file_profile_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1966,8 +8225,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseMemberDataArr); i {
+		file_profile_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNotificationData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1978,8 +8237,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HasFamilyResp); i {
+		file_profile_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteNotificationData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1990,8 +8249,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Delete); i {
+		file_profile_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationArr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2002,8 +8261,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmailData); i {
+		file_profile_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2014,8 +8273,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Exists); i {
+		file_profile_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Accept); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2026,8 +8285,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Medicine); i {
+		file_profile_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalendarToken); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2038,8 +8297,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteMed); i {
+		file_profile_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalendarFeed); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2050,8 +8309,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddMed); i {
+		file_profile_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2062,8 +8321,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMedicineData); i {
+		file_profile_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegimenItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2074,8 +8333,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MedicineArr); i {
+		file_profile_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoseEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2086,8 +8345,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotificationData); i {
+		file_profile_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MedicineStockEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2098,8 +8357,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNotificationData); i {
+		file_profile_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdherenceReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2110,8 +8369,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteNotificationData); i {
+		file_profile_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoseChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2122,8 +8381,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotificationArr); i {
+		file_profile_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationSettings); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2134,8 +8393,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Accept); i {
+		file_profile_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Webhook); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2146,7 +8405,151 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_profile_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookArr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TelegramCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TelegramLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TelegramCallback); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescheduleData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescheduleResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushSubscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PRNRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PRNRuleArr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PRNIntake); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PRNResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Empty); i {
 			case 0:
 				return &v.state
@@ -2165,7 +8568,7 @@ func file_profile_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_profile_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   91,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -31,19 +31,67 @@ type ProfileClient interface {
 	CreateFamily(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*Empty, error)
 	DeleteFamily(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*Empty, error)
 	DeleteFromFamily(ctx context.Context, in *Delete, opts ...grpc.CallOption) (*Empty, error)
+	LeaveFamily(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*Empty, error)
 	DeleteMember(ctx context.Context, in *Delete, opts ...grpc.CallOption) (*Empty, error)
 	AddMember(ctx context.Context, in *MemberData, opts ...grpc.CallOption) (*Empty, error)
-	GetFamily(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*ResponseMemberDataArr, error)
+	PromoteMember(ctx context.Context, in *PromoteMemberData, opts ...grpc.CallOption) (*Empty, error)
+	CreateMemberInvite(ctx context.Context, in *MemberInvite, opts ...grpc.CallOption) (*MemberInviteToken, error)
+	AcceptMemberInvite(ctx context.Context, in *AcceptMemberInviteData, opts ...grpc.CallOption) (*Empty, error)
+	GetFamily(ctx context.Context, in *FamilyFilter, opts ...grpc.CallOption) (*ResponseMemberDataArr, error)
 	HasFamily(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*HasFamilyResp, error)
+	GetHealth(ctx context.Context, in *Person, opts ...grpc.CallOption) (*HealthData, error)
+	EditHealth(ctx context.Context, in *EditHealthData, opts ...grpc.CallOption) (*Empty, error)
 	UserExists(ctx context.Context, in *EmailData, opts ...grpc.CallOption) (*Exists, error)
 	AddMedicine(ctx context.Context, in *AddMed, opts ...grpc.CallOption) (*Empty, error)
 	DeleteMedicine(ctx context.Context, in *DeleteMed, opts ...grpc.CallOption) (*Empty, error)
-	GetMedicine(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*MedicineArr, error)
+	GetMedicine(ctx context.Context, in *MedicineFilter, opts ...grpc.CallOption) (*MedicineArr, error)
+	SearchMedicine(ctx context.Context, in *MedicineSearch, opts ...grpc.CallOption) (*MedicineSearchResult, error)
+	ImportMedicines(ctx context.Context, in *MedicineImport, opts ...grpc.CallOption) (*ImportReport, error)
+	DisposeMedicine(ctx context.Context, in *DisposeData, opts ...grpc.CallOption) (*Empty, error)
+	GetTrash(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*MedicineArr, error)
+	RestoreMedicine(ctx context.Context, in *MedicineRequest, opts ...grpc.CallOption) (*Empty, error)
 	EditMedicine(ctx context.Context, in *GetMedicineData, opts ...grpc.CallOption) (*Empty, error)
-	AddNotification(ctx context.Context, in *NotificationData, opts ...grpc.CallOption) (*Empty, error)
+	ChangeStock(ctx context.Context, in *StockChange, opts ...grpc.CallOption) (*Empty, error)
+	GetStockHistory(ctx context.Context, in *MedicineRequest, opts ...grpc.CallOption) (*StockHistory, error)
+	SetMinCount(ctx context.Context, in *MinCountData, opts ...grpc.CallOption) (*Empty, error)
+	AddKit(ctx context.Context, in *KitData, opts ...grpc.CallOption) (*Empty, error)
+	GetKits(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*KitArr, error)
+	EditKit(ctx context.Context, in *KitData, opts ...grpc.CallOption) (*Empty, error)
+	DeleteKit(ctx context.Context, in *KitRequest, opts ...grpc.CallOption) (*Empty, error)
+	MoveMedicine(ctx context.Context, in *MoveMedicineData, opts ...grpc.CallOption) (*Empty, error)
+	AddTemplate(ctx context.Context, in *TemplateData, opts ...grpc.CallOption) (*Empty, error)
+	GetTemplates(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*TemplateArr, error)
+	DeleteTemplate(ctx context.Context, in *TemplateRequest, opts ...grpc.CallOption) (*Empty, error)
+	CheckKit(ctx context.Context, in *KitCheck, opts ...grpc.CallOption) (*KitReport, error)
+	AddShoppingItem(ctx context.Context, in *ShoppingItemData, opts ...grpc.CallOption) (*Empty, error)
+	GetShoppingList(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*ShoppingList, error)
+	CheckShoppingItem(ctx context.Context, in *ShoppingItemRequest, opts ...grpc.CallOption) (*Empty, error)
+	DeleteShoppingItem(ctx context.Context, in *ShoppingItemRequest, opts ...grpc.CallOption) (*Empty, error)
+	AddNotification(ctx context.Context, in *NotificationData, opts ...grpc.CallOption) (*NotificationResult, error)
 	DeleteNotification(ctx context.Context, in *DeleteNotificationData, opts ...grpc.CallOption) (*Empty, error)
-	GetNotifications(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*NotificationArr, error)
+	GetNotifications(ctx context.Context, in *NotificationFilter, opts ...grpc.CallOption) (*NotificationArr, error)
 	AcceptNotification(ctx context.Context, in *Accept, opts ...grpc.CallOption) (*Empty, error)
+	RescheduleNotification(ctx context.Context, in *RescheduleData, opts ...grpc.CallOption) (*RescheduleResult, error)
+	AuditRegimen(ctx context.Context, in *Person, opts ...grpc.CallOption) (*InteractionArr, error)
+	CreateCalendarToken(ctx context.Context, in *Person, opts ...grpc.CallOption) (*CalendarToken, error)
+	RevokeCalendarToken(ctx context.Context, in *Person, opts ...grpc.CallOption) (*Empty, error)
+	GetCalendar(ctx context.Context, in *CalendarToken, opts ...grpc.CallOption) (*CalendarFeed, error)
+	GetAdherenceReport(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*AdherenceReport, error)
+	GetNotificationSettings(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*NotificationSettings, error)
+	EditNotificationSettings(ctx context.Context, in *NotificationSettings, opts ...grpc.CallOption) (*Empty, error)
+	AddWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Webhook, error)
+	GetWebhooks(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*WebhookArr, error)
+	DeleteWebhook(ctx context.Context, in *WebhookRequest, opts ...grpc.CallOption) (*Empty, error)
+	CreateTelegramCode(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*TelegramCode, error)
+	LinkTelegram(ctx context.Context, in *TelegramLink, opts ...grpc.CallOption) (*Empty, error)
+	UnlinkTelegram(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*Empty, error)
+	HandleTelegramCallback(ctx context.Context, in *TelegramCallback, opts ...grpc.CallOption) (*Empty, error)
+	AddPushSubscription(ctx context.Context, in *PushSubscription, opts ...grpc.CallOption) (*Empty, error)
+	DeletePushSubscription(ctx context.Context, in *PushSubscription, opts ...grpc.CallOption) (*Empty, error)
+	SetPRNRule(ctx context.Context, in *PRNRule, opts ...grpc.CallOption) (*Empty, error)
+	GetPRNRules(ctx context.Context, in *Person, opts ...grpc.CallOption) (*PRNRuleArr, error)
+	DeletePRNRule(ctx context.Context, in *PRNRule, opts ...grpc.CallOption) (*Empty, error)
+	LogPRNIntake(ctx context.Context, in *PRNIntake, opts ...grpc.CallOption) (*PRNResult, error)
 }
 
 type profileClient struct {
@@ -135,6 +183,15 @@ func (c *profileClient) DeleteFromFamily(ctx context.Context, in *Delete, opts .
 	return out, nil
 }
 
+func (c *profileClient) LeaveFamily(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/LeaveFamily", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *profileClient) DeleteMember(ctx context.Context, in *Delete, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := c.cc.Invoke(ctx, "/profile.Profile/DeleteMember", in, out, opts...)
@@ -153,7 +210,34 @@ func (c *profileClient) AddMember(ctx context.Context, in *MemberData, opts ...g
 	return out, nil
 }
 
-func (c *profileClient) GetFamily(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*ResponseMemberDataArr, error) {
+func (c *profileClient) PromoteMember(ctx context.Context, in *PromoteMemberData, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/PromoteMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) CreateMemberInvite(ctx context.Context, in *MemberInvite, opts ...grpc.CallOption) (*MemberInviteToken, error) {
+	out := new(MemberInviteToken)
+	err := c.cc.Invoke(ctx, "/profile.Profile/CreateMemberInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) AcceptMemberInvite(ctx context.Context, in *AcceptMemberInviteData, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/AcceptMemberInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) GetFamily(ctx context.Context, in *FamilyFilter, opts ...grpc.CallOption) (*ResponseMemberDataArr, error) {
 	out := new(ResponseMemberDataArr)
 	err := c.cc.Invoke(ctx, "/profile.Profile/GetFamily", in, out, opts...)
 	if err != nil {
@@ -171,6 +255,24 @@ func (c *profileClient) HasFamily(ctx context.Context, in *UserID, opts ...grpc.
 	return out, nil
 }
 
+func (c *profileClient) GetHealth(ctx context.Context, in *Person, opts ...grpc.CallOption) (*HealthData, error) {
+	out := new(HealthData)
+	err := c.cc.Invoke(ctx, "/profile.Profile/GetHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) EditHealth(ctx context.Context, in *EditHealthData, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/EditHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *profileClient) UserExists(ctx context.Context, in *EmailData, opts ...grpc.CallOption) (*Exists, error) {
 	out := new(Exists)
 	err := c.cc.Invoke(ctx, "/profile.Profile/UserExists", in, out, opts...)
@@ -198,7 +300,7 @@ func (c *profileClient) DeleteMedicine(ctx context.Context, in *DeleteMed, opts
 	return out, nil
 }
 
-func (c *profileClient) GetMedicine(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*MedicineArr, error) {
+func (c *profileClient) GetMedicine(ctx context.Context, in *MedicineFilter, opts ...grpc.CallOption) (*MedicineArr, error) {
 	out := new(MedicineArr)
 	err := c.cc.Invoke(ctx, "/profile.Profile/GetMedicine", in, out, opts...)
 	if err != nil {
@@ -207,553 +309,1987 @@ func (c *profileClient) GetMedicine(ctx context.Context, in *UserID, opts ...grp
 	return out, nil
 }
 
-func (c *profileClient) EditMedicine(ctx context.Context, in *GetMedicineData, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/profile.Profile/EditMedicine", in, out, opts...)
+func (c *profileClient) SearchMedicine(ctx context.Context, in *MedicineSearch, opts ...grpc.CallOption) (*MedicineSearchResult, error) {
+	out := new(MedicineSearchResult)
+	err := c.cc.Invoke(ctx, "/profile.Profile/SearchMedicine", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *profileClient) AddNotification(ctx context.Context, in *NotificationData, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/profile.Profile/AddNotification", in, out, opts...)
+func (c *profileClient) ImportMedicines(ctx context.Context, in *MedicineImport, opts ...grpc.CallOption) (*ImportReport, error) {
+	out := new(ImportReport)
+	err := c.cc.Invoke(ctx, "/profile.Profile/ImportMedicines", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *profileClient) DeleteNotification(ctx context.Context, in *DeleteNotificationData, opts ...grpc.CallOption) (*Empty, error) {
+func (c *profileClient) DisposeMedicine(ctx context.Context, in *DisposeData, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/profile.Profile/DeleteNotification", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/profile.Profile/DisposeMedicine", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *profileClient) GetNotifications(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*NotificationArr, error) {
-	out := new(NotificationArr)
-	err := c.cc.Invoke(ctx, "/profile.Profile/GetNotifications", in, out, opts...)
+func (c *profileClient) GetTrash(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*MedicineArr, error) {
+	out := new(MedicineArr)
+	err := c.cc.Invoke(ctx, "/profile.Profile/GetTrash", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *profileClient) AcceptNotification(ctx context.Context, in *Accept, opts ...grpc.CallOption) (*Empty, error) {
+func (c *profileClient) RestoreMedicine(ctx context.Context, in *MedicineRequest, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/profile.Profile/AcceptNotification", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/profile.Profile/RestoreMedicine", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// ProfileServer is the server API for Profile service.
-// All implementations should embed UnimplementedProfileServer
-// for forward compatibility
-type ProfileServer interface {
-	GetUserProfile(context.Context, *UserID) (*ProfileData, error)
-	EditProfile(context.Context, *EditProfileData) (*Empty, error)
-	EditAvatar(context.Context, *EditAvatarData) (*Empty, error)
-	UploadAvatar(context.Context, *UploadInputFile) (*FileName, error)
-	GetAvatar(context.Context, *UserID) (*FileName, error)
-	AcceptInvitationToFamily(context.Context, *AddToFamily) (*Empty, error)
-	CreateFamily(context.Context, *UserID) (*Empty, error)
-	DeleteFamily(context.Context, *UserID) (*Empty, error)
-	DeleteFromFamily(context.Context, *Delete) (*Empty, error)
-	DeleteMember(context.Context, *Delete) (*Empty, error)
-	AddMember(context.Context, *MemberData) (*Empty, error)
-	GetFamily(context.Context, *UserID) (*ResponseMemberDataArr, error)
-	HasFamily(context.Context, *UserID) (*HasFamilyResp, error)
-	UserExists(context.Context, *EmailData) (*Exists, error)
-	AddMedicine(context.Context, *AddMed) (*Empty, error)
-	DeleteMedicine(context.Context, *DeleteMed) (*Empty, error)
-	GetMedicine(context.Context, *UserID) (*MedicineArr, error)
-	EditMedicine(context.Context, *GetMedicineData) (*Empty, error)
-	AddNotification(context.Context, *NotificationData) (*Empty, error)
-	DeleteNotification(context.Context, *DeleteNotificationData) (*Empty, error)
-	GetNotifications(context.Context, *UserID) (*NotificationArr, error)
-	AcceptNotification(context.Context, *Accept) (*Empty, error)
+func (c *profileClient) EditMedicine(ctx context.Context, in *GetMedicineData, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/EditMedicine", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedProfileServer should be embedded to have forward compatible implementations.
-type UnimplementedProfileServer struct {
+func (c *profileClient) ChangeStock(ctx context.Context, in *StockChange, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/ChangeStock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedProfileServer) GetUserProfile(context.Context, *UserID) (*ProfileData, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserProfile not implemented")
+func (c *profileClient) GetStockHistory(ctx context.Context, in *MedicineRequest, opts ...grpc.CallOption) (*StockHistory, error) {
+	out := new(StockHistory)
+	err := c.cc.Invoke(ctx, "/profile.Profile/GetStockHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProfileServer) EditProfile(context.Context, *EditProfileData) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditProfile not implemented")
+
+func (c *profileClient) SetMinCount(ctx context.Context, in *MinCountData, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/SetMinCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProfileServer) EditAvatar(context.Context, *EditAvatarData) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditAvatar not implemented")
+
+func (c *profileClient) AddKit(ctx context.Context, in *KitData, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/AddKit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProfileServer) UploadAvatar(context.Context, *UploadInputFile) (*FileName, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UploadAvatar not implemented")
+
+func (c *profileClient) GetKits(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*KitArr, error) {
+	out := new(KitArr)
+	err := c.cc.Invoke(ctx, "/profile.Profile/GetKits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProfileServer) GetAvatar(context.Context, *UserID) (*FileName, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAvatar not implemented")
+
+func (c *profileClient) EditKit(ctx context.Context, in *KitData, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/EditKit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProfileServer) AcceptInvitationToFamily(context.Context, *AddToFamily) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvitationToFamily not implemented")
+
+func (c *profileClient) DeleteKit(ctx context.Context, in *KitRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/DeleteKit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProfileServer) CreateFamily(context.Context, *UserID) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateFamily not implemented")
+
+func (c *profileClient) MoveMedicine(ctx context.Context, in *MoveMedicineData, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/MoveMedicine", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProfileServer) DeleteFamily(context.Context, *UserID) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteFamily not implemented")
+
+func (c *profileClient) AddTemplate(ctx context.Context, in *TemplateData, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/AddTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProfileServer) DeleteFromFamily(context.Context, *Delete) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteFromFamily not implemented")
+
+func (c *profileClient) GetTemplates(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*TemplateArr, error) {
+	out := new(TemplateArr)
+	err := c.cc.Invoke(ctx, "/profile.Profile/GetTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProfileServer) DeleteMember(context.Context, *Delete) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteMember not implemented")
+
+func (c *profileClient) DeleteTemplate(ctx context.Context, in *TemplateRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/DeleteTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProfileServer) AddMember(context.Context, *MemberData) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMember not implemented")
+
+func (c *profileClient) CheckKit(ctx context.Context, in *KitCheck, opts ...grpc.CallOption) (*KitReport, error) {
+	out := new(KitReport)
+	err := c.cc.Invoke(ctx, "/profile.Profile/CheckKit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProfileServer) GetFamily(context.Context, *UserID) (*ResponseMemberDataArr, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFamily not implemented")
+
+func (c *profileClient) AddShoppingItem(ctx context.Context, in *ShoppingItemData, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/AddShoppingItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProfileServer) HasFamily(context.Context, *UserID) (*HasFamilyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HasFamily not implemented")
+
+func (c *profileClient) GetShoppingList(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*ShoppingList, error) {
+	out := new(ShoppingList)
+	err := c.cc.Invoke(ctx, "/profile.Profile/GetShoppingList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProfileServer) UserExists(context.Context, *EmailData) (*Exists, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserExists not implemented")
+
+func (c *profileClient) CheckShoppingItem(ctx context.Context, in *ShoppingItemRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/CheckShoppingItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProfileServer) AddMedicine(context.Context, *AddMed) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMedicine not implemented")
+
+func (c *profileClient) DeleteShoppingItem(ctx context.Context, in *ShoppingItemRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/DeleteShoppingItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProfileServer) DeleteMedicine(context.Context, *DeleteMed) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteMedicine not implemented")
+
+func (c *profileClient) AddNotification(ctx context.Context, in *NotificationData, opts ...grpc.CallOption) (*NotificationResult, error) {
+	out := new(NotificationResult)
+	err := c.cc.Invoke(ctx, "/profile.Profile/AddNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProfileServer) GetMedicine(context.Context, *UserID) (*MedicineArr, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMedicine not implemented")
+
+func (c *profileClient) DeleteNotification(ctx context.Context, in *DeleteNotificationData, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/DeleteNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProfileServer) EditMedicine(context.Context, *GetMedicineData) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditMedicine not implemented")
+
+func (c *profileClient) GetNotifications(ctx context.Context, in *NotificationFilter, opts ...grpc.CallOption) (*NotificationArr, error) {
+	out := new(NotificationArr)
+	err := c.cc.Invoke(ctx, "/profile.Profile/GetNotifications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProfileServer) AddNotification(context.Context, *NotificationData) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddNotification not implemented")
+
+func (c *profileClient) AcceptNotification(ctx context.Context, in *Accept, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/AcceptNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProfileServer) DeleteNotification(context.Context, *DeleteNotificationData) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteNotification not implemented")
+
+func (c *profileClient) RescheduleNotification(ctx context.Context, in *RescheduleData, opts ...grpc.CallOption) (*RescheduleResult, error) {
+	out := new(RescheduleResult)
+	err := c.cc.Invoke(ctx, "/profile.Profile/RescheduleNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProfileServer) GetNotifications(context.Context, *UserID) (*NotificationArr, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNotifications not implemented")
+
+func (c *profileClient) AuditRegimen(ctx context.Context, in *Person, opts ...grpc.CallOption) (*InteractionArr, error) {
+	out := new(InteractionArr)
+	err := c.cc.Invoke(ctx, "/profile.Profile/AuditRegimen", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProfileServer) AcceptNotification(context.Context, *Accept) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AcceptNotification not implemented")
+
+func (c *profileClient) CreateCalendarToken(ctx context.Context, in *Person, opts ...grpc.CallOption) (*CalendarToken, error) {
+	out := new(CalendarToken)
+	err := c.cc.Invoke(ctx, "/profile.Profile/CreateCalendarToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) RevokeCalendarToken(ctx context.Context, in *Person, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/RevokeCalendarToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) GetCalendar(ctx context.Context, in *CalendarToken, opts ...grpc.CallOption) (*CalendarFeed, error) {
+	out := new(CalendarFeed)
+	err := c.cc.Invoke(ctx, "/profile.Profile/GetCalendar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) GetAdherenceReport(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*AdherenceReport, error) {
+	out := new(AdherenceReport)
+	err := c.cc.Invoke(ctx, "/profile.Profile/GetAdherenceReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) GetNotificationSettings(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*NotificationSettings, error) {
+	out := new(NotificationSettings)
+	err := c.cc.Invoke(ctx, "/profile.Profile/GetNotificationSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) EditNotificationSettings(ctx context.Context, in *NotificationSettings, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/EditNotificationSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) AddWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, "/profile.Profile/AddWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) GetWebhooks(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*WebhookArr, error) {
+	out := new(WebhookArr)
+	err := c.cc.Invoke(ctx, "/profile.Profile/GetWebhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) DeleteWebhook(ctx context.Context, in *WebhookRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/DeleteWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) CreateTelegramCode(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*TelegramCode, error) {
+	out := new(TelegramCode)
+	err := c.cc.Invoke(ctx, "/profile.Profile/CreateTelegramCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) LinkTelegram(ctx context.Context, in *TelegramLink, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/LinkTelegram", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) UnlinkTelegram(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/UnlinkTelegram", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) HandleTelegramCallback(ctx context.Context, in *TelegramCallback, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/HandleTelegramCallback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) AddPushSubscription(ctx context.Context, in *PushSubscription, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/AddPushSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) DeletePushSubscription(ctx context.Context, in *PushSubscription, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/DeletePushSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) SetPRNRule(ctx context.Context, in *PRNRule, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/SetPRNRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) GetPRNRules(ctx context.Context, in *Person, opts ...grpc.CallOption) (*PRNRuleArr, error) {
+	out := new(PRNRuleArr)
+	err := c.cc.Invoke(ctx, "/profile.Profile/GetPRNRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) DeletePRNRule(ctx context.Context, in *PRNRule, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/profile.Profile/DeletePRNRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) LogPRNIntake(ctx context.Context, in *PRNIntake, opts ...grpc.CallOption) (*PRNResult, error) {
+	out := new(PRNResult)
+	err := c.cc.Invoke(ctx, "/profile.Profile/LogPRNIntake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProfileServer is the server API for Profile service.
+// All implementations should embed UnimplementedProfileServer
+// for forward compatibility
+type ProfileServer interface {
+	GetUserProfile(context.Context, *UserID) (*ProfileData, error)
+	EditProfile(context.Context, *EditProfileData) (*Empty, error)
+	EditAvatar(context.Context, *EditAvatarData) (*Empty, error)
+	UploadAvatar(context.Context, *UploadInputFile) (*FileName, error)
+	GetAvatar(context.Context, *UserID) (*FileName, error)
+	AcceptInvitationToFamily(context.Context, *AddToFamily) (*Empty, error)
+	CreateFamily(context.Context, *UserID) (*Empty, error)
+	DeleteFamily(context.Context, *UserID) (*Empty, error)
+	DeleteFromFamily(context.Context, *Delete) (*Empty, error)
+	LeaveFamily(context.Context, *UserID) (*Empty, error)
+	DeleteMember(context.Context, *Delete) (*Empty, error)
+	AddMember(context.Context, *MemberData) (*Empty, error)
+	PromoteMember(context.Context, *PromoteMemberData) (*Empty, error)
+	CreateMemberInvite(context.Context, *MemberInvite) (*MemberInviteToken, error)
+	AcceptMemberInvite(context.Context, *AcceptMemberInviteData) (*Empty, error)
+	GetFamily(context.Context, *FamilyFilter) (*ResponseMemberDataArr, error)
+	HasFamily(context.Context, *UserID) (*HasFamilyResp, error)
+	GetHealth(context.Context, *Person) (*HealthData, error)
+	EditHealth(context.Context, *EditHealthData) (*Empty, error)
+	UserExists(context.Context, *EmailData) (*Exists, error)
+	AddMedicine(context.Context, *AddMed) (*Empty, error)
+	DeleteMedicine(context.Context, *DeleteMed) (*Empty, error)
+	GetMedicine(context.Context, *MedicineFilter) (*MedicineArr, error)
+	SearchMedicine(context.Context, *MedicineSearch) (*MedicineSearchResult, error)
+	ImportMedicines(context.Context, *MedicineImport) (*ImportReport, error)
+	DisposeMedicine(context.Context, *DisposeData) (*Empty, error)
+	GetTrash(context.Context, *UserID) (*MedicineArr, error)
+	RestoreMedicine(context.Context, *MedicineRequest) (*Empty, error)
+	EditMedicine(context.Context, *GetMedicineData) (*Empty, error)
+	ChangeStock(context.Context, *StockChange) (*Empty, error)
+	GetStockHistory(context.Context, *MedicineRequest) (*StockHistory, error)
+	SetMinCount(context.Context, *MinCountData) (*Empty, error)
+	AddKit(context.Context, *KitData) (*Empty, error)
+	GetKits(context.Context, *UserID) (*KitArr, error)
+	EditKit(context.Context, *KitData) (*Empty, error)
+	DeleteKit(context.Context, *KitRequest) (*Empty, error)
+	MoveMedicine(context.Context, *MoveMedicineData) (*Empty, error)
+	AddTemplate(context.Context, *TemplateData) (*Empty, error)
+	GetTemplates(context.Context, *UserID) (*TemplateArr, error)
+	DeleteTemplate(context.Context, *TemplateRequest) (*Empty, error)
+	CheckKit(context.Context, *KitCheck) (*KitReport, error)
+	AddShoppingItem(context.Context, *ShoppingItemData) (*Empty, error)
+	GetShoppingList(context.Context, *UserID) (*ShoppingList, error)
+	CheckShoppingItem(context.Context, *ShoppingItemRequest) (*Empty, error)
+	DeleteShoppingItem(context.Context, *ShoppingItemRequest) (*Empty, error)
+	AddNotification(context.Context, *NotificationData) (*NotificationResult, error)
+	DeleteNotification(context.Context, *DeleteNotificationData) (*Empty, error)
+	GetNotifications(context.Context, *NotificationFilter) (*NotificationArr, error)
+	AcceptNotification(context.Context, *Accept) (*Empty, error)
+	RescheduleNotification(context.Context, *RescheduleData) (*RescheduleResult, error)
+	AuditRegimen(context.Context, *Person) (*InteractionArr, error)
+	CreateCalendarToken(context.Context, *Person) (*CalendarToken, error)
+	RevokeCalendarToken(context.Context, *Person) (*Empty, error)
+	GetCalendar(context.Context, *CalendarToken) (*CalendarFeed, error)
+	GetAdherenceReport(context.Context, *ReportRequest) (*AdherenceReport, error)
+	GetNotificationSettings(context.Context, *UserID) (*NotificationSettings, error)
+	EditNotificationSettings(context.Context, *NotificationSettings) (*Empty, error)
+	AddWebhook(context.Context, *Webhook) (*Webhook, error)
+	GetWebhooks(context.Context, *UserID) (*WebhookArr, error)
+	DeleteWebhook(context.Context, *WebhookRequest) (*Empty, error)
+	CreateTelegramCode(context.Context, *UserID) (*TelegramCode, error)
+	LinkTelegram(context.Context, *TelegramLink) (*Empty, error)
+	UnlinkTelegram(context.Context, *UserID) (*Empty, error)
+	HandleTelegramCallback(context.Context, *TelegramCallback) (*Empty, error)
+	AddPushSubscription(context.Context, *PushSubscription) (*Empty, error)
+	DeletePushSubscription(context.Context, *PushSubscription) (*Empty, error)
+	SetPRNRule(context.Context, *PRNRule) (*Empty, error)
+	GetPRNRules(context.Context, *Person) (*PRNRuleArr, error)
+	DeletePRNRule(context.Context, *PRNRule) (*Empty, error)
+	LogPRNIntake(context.Context, *PRNIntake) (*PRNResult, error)
+}
+
+// UnimplementedProfileServer should be embedded to have forward compatible implementations.
+type UnimplementedProfileServer struct {
+}
+
+func (UnimplementedProfileServer) GetUserProfile(context.Context, *UserID) (*ProfileData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserProfile not implemented")
+}
+func (UnimplementedProfileServer) EditProfile(context.Context, *EditProfileData) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditProfile not implemented")
+}
+func (UnimplementedProfileServer) EditAvatar(context.Context, *EditAvatarData) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditAvatar not implemented")
+}
+func (UnimplementedProfileServer) UploadAvatar(context.Context, *UploadInputFile) (*FileName, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadAvatar not implemented")
+}
+func (UnimplementedProfileServer) GetAvatar(context.Context, *UserID) (*FileName, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvatar not implemented")
+}
+func (UnimplementedProfileServer) AcceptInvitationToFamily(context.Context, *AddToFamily) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvitationToFamily not implemented")
+}
+func (UnimplementedProfileServer) CreateFamily(context.Context, *UserID) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFamily not implemented")
+}
+func (UnimplementedProfileServer) DeleteFamily(context.Context, *UserID) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFamily not implemented")
+}
+func (UnimplementedProfileServer) DeleteFromFamily(context.Context, *Delete) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFromFamily not implemented")
+}
+func (UnimplementedProfileServer) LeaveFamily(context.Context, *UserID) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveFamily not implemented")
+}
+func (UnimplementedProfileServer) DeleteMember(context.Context, *Delete) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMember not implemented")
+}
+func (UnimplementedProfileServer) AddMember(context.Context, *MemberData) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMember not implemented")
+}
+func (UnimplementedProfileServer) PromoteMember(context.Context, *PromoteMemberData) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteMember not implemented")
+}
+func (UnimplementedProfileServer) CreateMemberInvite(context.Context, *MemberInvite) (*MemberInviteToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMemberInvite not implemented")
+}
+func (UnimplementedProfileServer) AcceptMemberInvite(context.Context, *AcceptMemberInviteData) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptMemberInvite not implemented")
+}
+func (UnimplementedProfileServer) GetFamily(context.Context, *FamilyFilter) (*ResponseMemberDataArr, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFamily not implemented")
+}
+func (UnimplementedProfileServer) HasFamily(context.Context, *UserID) (*HasFamilyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasFamily not implemented")
+}
+func (UnimplementedProfileServer) GetHealth(context.Context, *Person) (*HealthData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHealth not implemented")
+}
+func (UnimplementedProfileServer) EditHealth(context.Context, *EditHealthData) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditHealth not implemented")
+}
+func (UnimplementedProfileServer) UserExists(context.Context, *EmailData) (*Exists, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserExists not implemented")
+}
+func (UnimplementedProfileServer) AddMedicine(context.Context, *AddMed) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMedicine not implemented")
+}
+func (UnimplementedProfileServer) DeleteMedicine(context.Context, *DeleteMed) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMedicine not implemented")
+}
+func (UnimplementedProfileServer) GetMedicine(context.Context, *MedicineFilter) (*MedicineArr, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMedicine not implemented")
+}
+func (UnimplementedProfileServer) SearchMedicine(context.Context, *MedicineSearch) (*MedicineSearchResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMedicine not implemented")
+}
+func (UnimplementedProfileServer) ImportMedicines(context.Context, *MedicineImport) (*ImportReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportMedicines not implemented")
+}
+func (UnimplementedProfileServer) DisposeMedicine(context.Context, *DisposeData) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisposeMedicine not implemented")
+}
+func (UnimplementedProfileServer) GetTrash(context.Context, *UserID) (*MedicineArr, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrash not implemented")
+}
+func (UnimplementedProfileServer) RestoreMedicine(context.Context, *MedicineRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreMedicine not implemented")
+}
+func (UnimplementedProfileServer) EditMedicine(context.Context, *GetMedicineData) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditMedicine not implemented")
+}
+func (UnimplementedProfileServer) ChangeStock(context.Context, *StockChange) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeStock not implemented")
+}
+func (UnimplementedProfileServer) GetStockHistory(context.Context, *MedicineRequest) (*StockHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockHistory not implemented")
+}
+func (UnimplementedProfileServer) SetMinCount(context.Context, *MinCountData) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMinCount not implemented")
+}
+func (UnimplementedProfileServer) AddKit(context.Context, *KitData) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddKit not implemented")
+}
+func (UnimplementedProfileServer) GetKits(context.Context, *UserID) (*KitArr, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKits not implemented")
+}
+func (UnimplementedProfileServer) EditKit(context.Context, *KitData) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditKit not implemented")
+}
+func (UnimplementedProfileServer) DeleteKit(context.Context, *KitRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteKit not implemented")
+}
+func (UnimplementedProfileServer) MoveMedicine(context.Context, *MoveMedicineData) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveMedicine not implemented")
+}
+func (UnimplementedProfileServer) AddTemplate(context.Context, *TemplateData) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTemplate not implemented")
+}
+func (UnimplementedProfileServer) GetTemplates(context.Context, *UserID) (*TemplateArr, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplates not implemented")
+}
+func (UnimplementedProfileServer) DeleteTemplate(context.Context, *TemplateRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplate not implemented")
+}
+func (UnimplementedProfileServer) CheckKit(context.Context, *KitCheck) (*KitReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckKit not implemented")
+}
+func (UnimplementedProfileServer) AddShoppingItem(context.Context, *ShoppingItemData) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddShoppingItem not implemented")
+}
+func (UnimplementedProfileServer) GetShoppingList(context.Context, *UserID) (*ShoppingList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShoppingList not implemented")
+}
+func (UnimplementedProfileServer) CheckShoppingItem(context.Context, *ShoppingItemRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckShoppingItem not implemented")
+}
+func (UnimplementedProfileServer) DeleteShoppingItem(context.Context, *ShoppingItemRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteShoppingItem not implemented")
+}
+func (UnimplementedProfileServer) AddNotification(context.Context, *NotificationData) (*NotificationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNotification not implemented")
+}
+func (UnimplementedProfileServer) DeleteNotification(context.Context, *DeleteNotificationData) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNotification not implemented")
+}
+func (UnimplementedProfileServer) GetNotifications(context.Context, *NotificationFilter) (*NotificationArr, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotifications not implemented")
+}
+func (UnimplementedProfileServer) AcceptNotification(context.Context, *Accept) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptNotification not implemented")
+}
+func (UnimplementedProfileServer) RescheduleNotification(context.Context, *RescheduleData) (*RescheduleResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleNotification not implemented")
+}
+func (UnimplementedProfileServer) AuditRegimen(context.Context, *Person) (*InteractionArr, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditRegimen not implemented")
+}
+func (UnimplementedProfileServer) CreateCalendarToken(context.Context, *Person) (*CalendarToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCalendarToken not implemented")
+}
+func (UnimplementedProfileServer) RevokeCalendarToken(context.Context, *Person) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCalendarToken not implemented")
+}
+func (UnimplementedProfileServer) GetCalendar(context.Context, *CalendarToken) (*CalendarFeed, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendar not implemented")
+}
+func (UnimplementedProfileServer) GetAdherenceReport(context.Context, *ReportRequest) (*AdherenceReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdherenceReport not implemented")
+}
+func (UnimplementedProfileServer) GetNotificationSettings(context.Context, *UserID) (*NotificationSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationSettings not implemented")
+}
+func (UnimplementedProfileServer) EditNotificationSettings(context.Context, *NotificationSettings) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditNotificationSettings not implemented")
+}
+func (UnimplementedProfileServer) AddWebhook(context.Context, *Webhook) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWebhook not implemented")
+}
+func (UnimplementedProfileServer) GetWebhooks(context.Context, *UserID) (*WebhookArr, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhooks not implemented")
+}
+func (UnimplementedProfileServer) DeleteWebhook(context.Context, *WebhookRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedProfileServer) CreateTelegramCode(context.Context, *UserID) (*TelegramCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTelegramCode not implemented")
+}
+func (UnimplementedProfileServer) LinkTelegram(context.Context, *TelegramLink) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkTelegram not implemented")
+}
+func (UnimplementedProfileServer) UnlinkTelegram(context.Context, *UserID) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkTelegram not implemented")
+}
+func (UnimplementedProfileServer) HandleTelegramCallback(context.Context, *TelegramCallback) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleTelegramCallback not implemented")
+}
+func (UnimplementedProfileServer) AddPushSubscription(context.Context, *PushSubscription) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPushSubscription not implemented")
+}
+func (UnimplementedProfileServer) DeletePushSubscription(context.Context, *PushSubscription) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePushSubscription not implemented")
+}
+func (UnimplementedProfileServer) SetPRNRule(context.Context, *PRNRule) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPRNRule not implemented")
+}
+func (UnimplementedProfileServer) GetPRNRules(context.Context, *Person) (*PRNRuleArr, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPRNRules not implemented")
+}
+func (UnimplementedProfileServer) DeletePRNRule(context.Context, *PRNRule) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePRNRule not implemented")
+}
+func (UnimplementedProfileServer) LogPRNIntake(context.Context, *PRNIntake) (*PRNResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogPRNIntake not implemented")
+}
+
+// UnsafeProfileServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProfileServer will
+// result in compilation errors.
+type UnsafeProfileServer interface {
+	mustEmbedUnimplementedProfileServer()
+}
+
+func RegisterProfileServer(s grpc.ServiceRegistrar, srv ProfileServer) {
+	s.RegisterService(&Profile_ServiceDesc, srv)
+}
+
+func _Profile_GetUserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).GetUserProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/GetUserProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).GetUserProfile(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_EditProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditProfileData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).EditProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/EditProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).EditProfile(ctx, req.(*EditProfileData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_EditAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditAvatarData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).EditAvatar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/EditAvatar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).EditAvatar(ctx, req.(*EditAvatarData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_UploadAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadInputFile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).UploadAvatar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/UploadAvatar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).UploadAvatar(ctx, req.(*UploadInputFile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_GetAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).GetAvatar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/GetAvatar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).GetAvatar(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_AcceptInvitationToFamily_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToFamily)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).AcceptInvitationToFamily(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/AcceptInvitationToFamily",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).AcceptInvitationToFamily(ctx, req.(*AddToFamily))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_CreateFamily_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).CreateFamily(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/CreateFamily",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).CreateFamily(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_DeleteFamily_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).DeleteFamily(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/DeleteFamily",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).DeleteFamily(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_DeleteFromFamily_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Delete)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).DeleteFromFamily(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/DeleteFromFamily",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).DeleteFromFamily(ctx, req.(*Delete))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_LeaveFamily_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).LeaveFamily(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/LeaveFamily",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).LeaveFamily(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_DeleteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Delete)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).DeleteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/DeleteMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).DeleteMember(ctx, req.(*Delete))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_AddMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MemberData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).AddMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/AddMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).AddMember(ctx, req.(*MemberData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_PromoteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteMemberData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).PromoteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/PromoteMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).PromoteMember(ctx, req.(*PromoteMemberData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_CreateMemberInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MemberInvite)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).CreateMemberInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/CreateMemberInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).CreateMemberInvite(ctx, req.(*MemberInvite))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_AcceptMemberInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptMemberInviteData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).AcceptMemberInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/AcceptMemberInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).AcceptMemberInvite(ctx, req.(*AcceptMemberInviteData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_GetFamily_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FamilyFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).GetFamily(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/GetFamily",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).GetFamily(ctx, req.(*FamilyFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_HasFamily_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).HasFamily(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/HasFamily",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).HasFamily(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_GetHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Person)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).GetHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/GetHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).GetHealth(ctx, req.(*Person))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_EditHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditHealthData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).EditHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/EditHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).EditHealth(ctx, req.(*EditHealthData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_UserExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmailData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).UserExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/UserExists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).UserExists(ctx, req.(*EmailData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_AddMedicine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMed)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).AddMedicine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/AddMedicine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).AddMedicine(ctx, req.(*AddMed))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_DeleteMedicine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMed)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).DeleteMedicine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/DeleteMedicine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).DeleteMedicine(ctx, req.(*DeleteMed))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_GetMedicine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MedicineFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).GetMedicine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/GetMedicine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).GetMedicine(ctx, req.(*MedicineFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_SearchMedicine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MedicineSearch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).SearchMedicine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/SearchMedicine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).SearchMedicine(ctx, req.(*MedicineSearch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_ImportMedicines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MedicineImport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).ImportMedicines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/ImportMedicines",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).ImportMedicines(ctx, req.(*MedicineImport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_DisposeMedicine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisposeData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).DisposeMedicine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/DisposeMedicine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).DisposeMedicine(ctx, req.(*DisposeData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_GetTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).GetTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/GetTrash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).GetTrash(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_RestoreMedicine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MedicineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).RestoreMedicine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/RestoreMedicine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).RestoreMedicine(ctx, req.(*MedicineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_EditMedicine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMedicineData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).EditMedicine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/EditMedicine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).EditMedicine(ctx, req.(*GetMedicineData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_ChangeStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).ChangeStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/ChangeStock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).ChangeStock(ctx, req.(*StockChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_GetStockHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MedicineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).GetStockHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/GetStockHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).GetStockHistory(ctx, req.(*MedicineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_SetMinCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MinCountData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).SetMinCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/SetMinCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).SetMinCount(ctx, req.(*MinCountData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_AddKit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KitData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).AddKit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/AddKit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).AddKit(ctx, req.(*KitData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_GetKits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).GetKits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/GetKits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).GetKits(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_EditKit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KitData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).EditKit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/EditKit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).EditKit(ctx, req.(*KitData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_DeleteKit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).DeleteKit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/DeleteKit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).DeleteKit(ctx, req.(*KitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_MoveMedicine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveMedicineData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).MoveMedicine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/MoveMedicine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).MoveMedicine(ctx, req.(*MoveMedicineData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_AddTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).AddTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/AddTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).AddTemplate(ctx, req.(*TemplateData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_GetTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).GetTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/GetTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).GetTemplates(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).DeleteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/DeleteTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).DeleteTemplate(ctx, req.(*TemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_CheckKit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KitCheck)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).CheckKit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/CheckKit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).CheckKit(ctx, req.(*KitCheck))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_AddShoppingItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShoppingItemData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).AddShoppingItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/AddShoppingItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).AddShoppingItem(ctx, req.(*ShoppingItemData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_GetShoppingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).GetShoppingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/GetShoppingList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).GetShoppingList(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_CheckShoppingItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShoppingItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).CheckShoppingItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/CheckShoppingItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).CheckShoppingItem(ctx, req.(*ShoppingItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_DeleteShoppingItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShoppingItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).DeleteShoppingItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/DeleteShoppingItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).DeleteShoppingItem(ctx, req.(*ShoppingItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_AddNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).AddNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/AddNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).AddNotification(ctx, req.(*NotificationData))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnsafeProfileServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ProfileServer will
-// result in compilation errors.
-type UnsafeProfileServer interface {
-	mustEmbedUnimplementedProfileServer()
+func _Profile_DeleteNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNotificationData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).DeleteNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/DeleteNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).DeleteNotification(ctx, req.(*DeleteNotificationData))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterProfileServer(s grpc.ServiceRegistrar, srv ProfileServer) {
-	s.RegisterService(&Profile_ServiceDesc, srv)
+func _Profile_GetNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).GetNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/GetNotifications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).GetNotifications(ctx, req.(*NotificationFilter))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_GetUserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserID)
+func _Profile_AcceptNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Accept)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).GetUserProfile(ctx, in)
+		return srv.(ProfileServer).AcceptNotification(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/profile.Profile/GetUserProfile",
+		FullMethod: "/profile.Profile/AcceptNotification",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).GetUserProfile(ctx, req.(*UserID))
+		return srv.(ProfileServer).AcceptNotification(ctx, req.(*Accept))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_EditProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EditProfileData)
+func _Profile_RescheduleNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleData)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).EditProfile(ctx, in)
+		return srv.(ProfileServer).RescheduleNotification(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/profile.Profile/EditProfile",
+		FullMethod: "/profile.Profile/RescheduleNotification",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).EditProfile(ctx, req.(*EditProfileData))
+		return srv.(ProfileServer).RescheduleNotification(ctx, req.(*RescheduleData))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_EditAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EditAvatarData)
+func _Profile_AuditRegimen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Person)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).EditAvatar(ctx, in)
+		return srv.(ProfileServer).AuditRegimen(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/profile.Profile/EditAvatar",
+		FullMethod: "/profile.Profile/AuditRegimen",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).EditAvatar(ctx, req.(*EditAvatarData))
+		return srv.(ProfileServer).AuditRegimen(ctx, req.(*Person))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_UploadAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UploadInputFile)
+func _Profile_CreateCalendarToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Person)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).UploadAvatar(ctx, in)
+		return srv.(ProfileServer).CreateCalendarToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/profile.Profile/UploadAvatar",
+		FullMethod: "/profile.Profile/CreateCalendarToken",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).UploadAvatar(ctx, req.(*UploadInputFile))
+		return srv.(ProfileServer).CreateCalendarToken(ctx, req.(*Person))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_GetAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserID)
+func _Profile_RevokeCalendarToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Person)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).GetAvatar(ctx, in)
+		return srv.(ProfileServer).RevokeCalendarToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/profile.Profile/GetAvatar",
+		FullMethod: "/profile.Profile/RevokeCalendarToken",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).GetAvatar(ctx, req.(*UserID))
+		return srv.(ProfileServer).RevokeCalendarToken(ctx, req.(*Person))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_AcceptInvitationToFamily_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddToFamily)
+func _Profile_GetCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarToken)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).AcceptInvitationToFamily(ctx, in)
+		return srv.(ProfileServer).GetCalendar(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/profile.Profile/AcceptInvitationToFamily",
+		FullMethod: "/profile.Profile/GetCalendar",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).AcceptInvitationToFamily(ctx, req.(*AddToFamily))
+		return srv.(ProfileServer).GetCalendar(ctx, req.(*CalendarToken))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_CreateFamily_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserID)
+func _Profile_GetAdherenceReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).CreateFamily(ctx, in)
+		return srv.(ProfileServer).GetAdherenceReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/profile.Profile/CreateFamily",
+		FullMethod: "/profile.Profile/GetAdherenceReport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).CreateFamily(ctx, req.(*UserID))
+		return srv.(ProfileServer).GetAdherenceReport(ctx, req.(*ReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_DeleteFamily_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Profile_GetNotificationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).DeleteFamily(ctx, in)
+		return srv.(ProfileServer).GetNotificationSettings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/profile.Profile/DeleteFamily",
+		FullMethod: "/profile.Profile/GetNotificationSettings",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).DeleteFamily(ctx, req.(*UserID))
+		return srv.(ProfileServer).GetNotificationSettings(ctx, req.(*UserID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_DeleteFromFamily_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Delete)
+func _Profile_EditNotificationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationSettings)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).DeleteFromFamily(ctx, in)
+		return srv.(ProfileServer).EditNotificationSettings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/profile.Profile/DeleteFromFamily",
+		FullMethod: "/profile.Profile/EditNotificationSettings",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).DeleteFromFamily(ctx, req.(*Delete))
+		return srv.(ProfileServer).EditNotificationSettings(ctx, req.(*NotificationSettings))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_DeleteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Delete)
+func _Profile_AddWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Webhook)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).DeleteMember(ctx, in)
+		return srv.(ProfileServer).AddWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/profile.Profile/DeleteMember",
+		FullMethod: "/profile.Profile/AddWebhook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).DeleteMember(ctx, req.(*Delete))
+		return srv.(ProfileServer).AddWebhook(ctx, req.(*Webhook))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_AddMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MemberData)
+func _Profile_GetWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).AddMember(ctx, in)
+		return srv.(ProfileServer).GetWebhooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/profile.Profile/AddMember",
+		FullMethod: "/profile.Profile/GetWebhooks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).AddMember(ctx, req.(*MemberData))
+		return srv.(ProfileServer).GetWebhooks(ctx, req.(*UserID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_GetFamily_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserID)
+func _Profile_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).GetFamily(ctx, in)
+		return srv.(ProfileServer).DeleteWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/profile.Profile/GetFamily",
+		FullMethod: "/profile.Profile/DeleteWebhook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).GetFamily(ctx, req.(*UserID))
+		return srv.(ProfileServer).DeleteWebhook(ctx, req.(*WebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_HasFamily_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Profile_CreateTelegramCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).HasFamily(ctx, in)
+		return srv.(ProfileServer).CreateTelegramCode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/profile.Profile/HasFamily",
+		FullMethod: "/profile.Profile/CreateTelegramCode",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).HasFamily(ctx, req.(*UserID))
+		return srv.(ProfileServer).CreateTelegramCode(ctx, req.(*UserID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_UserExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmailData)
+func _Profile_LinkTelegram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TelegramLink)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).UserExists(ctx, in)
+		return srv.(ProfileServer).LinkTelegram(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/profile.Profile/UserExists",
+		FullMethod: "/profile.Profile/LinkTelegram",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).UserExists(ctx, req.(*EmailData))
+		return srv.(ProfileServer).LinkTelegram(ctx, req.(*TelegramLink))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_AddMedicine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddMed)
+func _Profile_UnlinkTelegram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).AddMedicine(ctx, in)
+		return srv.(ProfileServer).UnlinkTelegram(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/profile.Profile/AddMedicine",
+		FullMethod: "/profile.Profile/UnlinkTelegram",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).AddMedicine(ctx, req.(*AddMed))
+		return srv.(ProfileServer).UnlinkTelegram(ctx, req.(*UserID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_DeleteMedicine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteMed)
+func _Profile_HandleTelegramCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TelegramCallback)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).DeleteMedicine(ctx, in)
+		return srv.(ProfileServer).HandleTelegramCallback(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/profile.Profile/DeleteMedicine",
+		FullMethod: "/profile.Profile/HandleTelegramCallback",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).DeleteMedicine(ctx, req.(*DeleteMed))
+		return srv.(ProfileServer).HandleTelegramCallback(ctx, req.(*TelegramCallback))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_GetMedicine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserID)
+func _Profile_AddPushSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushSubscription)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).GetMedicine(ctx, in)
+		return srv.(ProfileServer).AddPushSubscription(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/profile.Profile/GetMedicine",
+		FullMethod: "/profile.Profile/AddPushSubscription",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).GetMedicine(ctx, req.(*UserID))
+		return srv.(ProfileServer).AddPushSubscription(ctx, req.(*PushSubscription))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_EditMedicine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMedicineData)
+func _Profile_DeletePushSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushSubscription)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).EditMedicine(ctx, in)
+		return srv.(ProfileServer).DeletePushSubscription(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/profile.Profile/EditMedicine",
+		FullMethod: "/profile.Profile/DeletePushSubscription",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).EditMedicine(ctx, req.(*GetMedicineData))
+		return srv.(ProfileServer).DeletePushSubscription(ctx, req.(*PushSubscription))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_AddNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NotificationData)
+func _Profile_SetPRNRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PRNRule)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).AddNotification(ctx, in)
+		return srv.(ProfileServer).SetPRNRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/profile.Profile/AddNotification",
+		FullMethod: "/profile.Profile/SetPRNRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).AddNotification(ctx, req.(*NotificationData))
+		return srv.(ProfileServer).SetPRNRule(ctx, req.(*PRNRule))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_DeleteNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteNotificationData)
+func _Profile_GetPRNRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Person)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).DeleteNotification(ctx, in)
+		return srv.(ProfileServer).GetPRNRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/profile.Profile/DeleteNotification",
+		FullMethod: "/profile.Profile/GetPRNRules",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).DeleteNotification(ctx, req.(*DeleteNotificationData))
+		return srv.(ProfileServer).GetPRNRules(ctx, req.(*Person))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_GetNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserID)
+func _Profile_DeletePRNRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PRNRule)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).GetNotifications(ctx, in)
+		return srv.(ProfileServer).DeletePRNRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/profile.Profile/GetNotifications",
+		FullMethod: "/profile.Profile/DeletePRNRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).GetNotifications(ctx, req.(*UserID))
+		return srv.(ProfileServer).DeletePRNRule(ctx, req.(*PRNRule))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_AcceptNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Accept)
+func _Profile_LogPRNIntake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PRNIntake)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).AcceptNotification(ctx, in)
+		return srv.(ProfileServer).LogPRNIntake(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/profile.Profile/AcceptNotification",
+		FullMethod: "/profile.Profile/LogPRNIntake",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).AcceptNotification(ctx, req.(*Accept))
+		return srv.(ProfileServer).LogPRNIntake(ctx, req.(*PRNIntake))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -801,6 +2337,10 @@ var Profile_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteFromFamily",
 			Handler:    _Profile_DeleteFromFamily_Handler,
 		},
+		{
+			MethodName: "LeaveFamily",
+			Handler:    _Profile_LeaveFamily_Handler,
+		},
 		{
 			MethodName: "DeleteMember",
 			Handler:    _Profile_DeleteMember_Handler,
@@ -809,6 +2349,18 @@ var Profile_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddMember",
 			Handler:    _Profile_AddMember_Handler,
 		},
+		{
+			MethodName: "PromoteMember",
+			Handler:    _Profile_PromoteMember_Handler,
+		},
+		{
+			MethodName: "CreateMemberInvite",
+			Handler:    _Profile_CreateMemberInvite_Handler,
+		},
+		{
+			MethodName: "AcceptMemberInvite",
+			Handler:    _Profile_AcceptMemberInvite_Handler,
+		},
 		{
 			MethodName: "GetFamily",
 			Handler:    _Profile_GetFamily_Handler,
@@ -817,6 +2369,14 @@ var Profile_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HasFamily",
 			Handler:    _Profile_HasFamily_Handler,
 		},
+		{
+			MethodName: "GetHealth",
+			Handler:    _Profile_GetHealth_Handler,
+		},
+		{
+			MethodName: "EditHealth",
+			Handler:    _Profile_EditHealth_Handler,
+		},
 		{
 			MethodName: "UserExists",
 			Handler:    _Profile_UserExists_Handler,
@@ -833,10 +2393,94 @@ var Profile_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMedicine",
 			Handler:    _Profile_GetMedicine_Handler,
 		},
+		{
+			MethodName: "SearchMedicine",
+			Handler:    _Profile_SearchMedicine_Handler,
+		},
+		{
+			MethodName: "ImportMedicines",
+			Handler:    _Profile_ImportMedicines_Handler,
+		},
+		{
+			MethodName: "DisposeMedicine",
+			Handler:    _Profile_DisposeMedicine_Handler,
+		},
+		{
+			MethodName: "GetTrash",
+			Handler:    _Profile_GetTrash_Handler,
+		},
+		{
+			MethodName: "RestoreMedicine",
+			Handler:    _Profile_RestoreMedicine_Handler,
+		},
 		{
 			MethodName: "EditMedicine",
 			Handler:    _Profile_EditMedicine_Handler,
 		},
+		{
+			MethodName: "ChangeStock",
+			Handler:    _Profile_ChangeStock_Handler,
+		},
+		{
+			MethodName: "GetStockHistory",
+			Handler:    _Profile_GetStockHistory_Handler,
+		},
+		{
+			MethodName: "SetMinCount",
+			Handler:    _Profile_SetMinCount_Handler,
+		},
+		{
+			MethodName: "AddKit",
+			Handler:    _Profile_AddKit_Handler,
+		},
+		{
+			MethodName: "GetKits",
+			Handler:    _Profile_GetKits_Handler,
+		},
+		{
+			MethodName: "EditKit",
+			Handler:    _Profile_EditKit_Handler,
+		},
+		{
+			MethodName: "DeleteKit",
+			Handler:    _Profile_DeleteKit_Handler,
+		},
+		{
+			MethodName: "MoveMedicine",
+			Handler:    _Profile_MoveMedicine_Handler,
+		},
+		{
+			MethodName: "AddTemplate",
+			Handler:    _Profile_AddTemplate_Handler,
+		},
+		{
+			MethodName: "GetTemplates",
+			Handler:    _Profile_GetTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteTemplate",
+			Handler:    _Profile_DeleteTemplate_Handler,
+		},
+		{
+			MethodName: "CheckKit",
+			Handler:    _Profile_CheckKit_Handler,
+		},
+		{
+			MethodName: "AddShoppingItem",
+			Handler:    _Profile_AddShoppingItem_Handler,
+		},
+		{
+			MethodName: "GetShoppingList",
+			Handler:    _Profile_GetShoppingList_Handler,
+		},
+		{
+			MethodName: "CheckShoppingItem",
+			Handler:    _Profile_CheckShoppingItem_Handler,
+		},
+		{
+			MethodName: "DeleteShoppingItem",
+			Handler:    _Profile_DeleteShoppingItem_Handler,
+		},
 		{
 			MethodName: "AddNotification",
 			Handler:    _Profile_AddNotification_Handler,
@@ -853,6 +2497,90 @@ var Profile_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AcceptNotification",
 			Handler:    _Profile_AcceptNotification_Handler,
 		},
+		{
+			MethodName: "RescheduleNotification",
+			Handler:    _Profile_RescheduleNotification_Handler,
+		},
+		{
+			MethodName: "AuditRegimen",
+			Handler:    _Profile_AuditRegimen_Handler,
+		},
+		{
+			MethodName: "CreateCalendarToken",
+			Handler:    _Profile_CreateCalendarToken_Handler,
+		},
+		{
+			MethodName: "RevokeCalendarToken",
+			Handler:    _Profile_RevokeCalendarToken_Handler,
+		},
+		{
+			MethodName: "GetCalendar",
+			Handler:    _Profile_GetCalendar_Handler,
+		},
+		{
+			MethodName: "GetAdherenceReport",
+			Handler:    _Profile_GetAdherenceReport_Handler,
+		},
+		{
+			MethodName: "GetNotificationSettings",
+			Handler:    _Profile_GetNotificationSettings_Handler,
+		},
+		{
+			MethodName: "EditNotificationSettings",
+			Handler:    _Profile_EditNotificationSettings_Handler,
+		},
+		{
+			MethodName: "AddWebhook",
+			Handler:    _Profile_AddWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhooks",
+			Handler:    _Profile_GetWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _Profile_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "CreateTelegramCode",
+			Handler:    _Profile_CreateTelegramCode_Handler,
+		},
+		{
+			MethodName: "LinkTelegram",
+			Handler:    _Profile_LinkTelegram_Handler,
+		},
+		{
+			MethodName: "UnlinkTelegram",
+			Handler:    _Profile_UnlinkTelegram_Handler,
+		},
+		{
+			MethodName: "HandleTelegramCallback",
+			Handler:    _Profile_HandleTelegramCallback_Handler,
+		},
+		{
+			MethodName: "AddPushSubscription",
+			Handler:    _Profile_AddPushSubscription_Handler,
+		},
+		{
+			MethodName: "DeletePushSubscription",
+			Handler:    _Profile_DeletePushSubscription_Handler,
+		},
+		{
+			MethodName: "SetPRNRule",
+			Handler:    _Profile_SetPRNRule_Handler,
+		},
+		{
+			MethodName: "GetPRNRules",
+			Handler:    _Profile_GetPRNRules_Handler,
+		},
+		{
+			MethodName: "DeletePRNRule",
+			Handler:    _Profile_DeletePRNRule_Handler,
+		},
+		{
+			MethodName: "LogPRNIntake",
+			Handler:    _Profile_LogPRNIntake_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "profile.proto",
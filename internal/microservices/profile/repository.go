@@ -2,6 +2,10 @@ package profile
 
 import (
 	proto "main/internal/microservices/profile/proto"
+	"main/internal/microservices/profile/utils/asneeded"
+	"main/internal/microservices/profile/utils/interactions"
+	"main/internal/microservices/profile/utils/templates"
+	"time"
 )
 
 type Storage interface {
@@ -11,6 +15,7 @@ type Storage interface {
 	GetAvatar(userID int64) (string, error)
 	UploadAvatar(data *proto.UploadInputFile) (string, error)
 	DeleteFile(string, string) error
+	CopyFile(name string, bucket string, userID int64) (string, error)
 
 	HasFamily(userID int64) (bool, int64, int64, bool, error)
 	CreateFamily(userID int64) error
@@ -18,22 +23,109 @@ type Storage interface {
 
 	AcceptInvitationToFamily(data *proto.AddToFamily) error
 	DeleteFromFamily(userID int64) error
+	LeaveFamily(userID, idMainUser int64) error
 	DeleteMember(userID int64) (string, error)
 	AddMember(data *proto.MemberData) error
-	GetFamily(userID int64) ([]*proto.ResponseMemberData, error)
+	GetMemberFamily(memberID int64) (int64, error)
+	PromoteMember(memberID, userID int64) (string, error)
+	AddMemberInvite(idFamily, idMember int64, email string, isAdult bool, expires time.Time) (string, error)
+	UseMemberInvite(token, email string) (*proto.AddToFamily, int64, error)
+	GetFamily(userID int64, filter *proto.FamilyFilter) ([]*proto.ResponseMemberData, string, error)
+
+	GetHealth(isUser bool, idPerson int64) (*proto.HealthData, error)
+	EditHealth(data *proto.HealthData) error
 
 	IsUserExists(data *proto.EmailData) (bool, error)
+	GetUserByEmail(email string) (int64, int64, error)
 
-	AddMedicine(data *proto.AddMed) error
+	AddMedicine(data *proto.AddMed) (int64, error)
+	FindMedicineByLot(userID, idFamily int64, name, lot string) (int64, error)
+	UpdateImportedMedicine(data *proto.GetMedicineData) error
 	DeleteMedicine(data *proto.DeleteMed) (string, error)
-	GetMedicine(userID int64) ([]*proto.GetMedicineData, error)
-	GetMedicineFamily(familyID int64) ([]*proto.GetMedicineData, error)
+	GetMedicine(userID int64, filter *proto.MedicineFilter) ([]*proto.GetMedicineData, string, error)
+	GetMedicineFamily(familyID int64, filter *proto.MedicineFilter) ([]*proto.GetMedicineData, string, error)
+	GetKitMedicines(idKit int64) ([]*proto.GetMedicineData, error)
+	GetTrash(userID, familyID int64, days int) ([]*proto.GetMedicineData, error)
+	SearchMedicine(userID, familyID int64, text, sort string, limit, offset int64) ([]*proto.GetMedicineData, error)
+	DisposeMedicine(idMedicine int64, reason, date string) error
+	RestoreMedicine(idMedicine int64, days int) (bool, error)
+	SetMedicineKit(idMedicine, idKit int64) error
+	SplitMedicine(idMedicine, idKit int64) (int64, error)
+	TransferStock(from, to, userID, count int64) error
+	AddBatch(idMedicine, userID, count int64) (int64, error)
+	GetMedicineByID(medicineID int64) (*proto.GetMedicineData, error)
 	EditMedicine(data *proto.GetMedicineData) (string, error)
 
 	AddNotification(data *proto.NotificationData) error
 	DeleteNotification(data *proto.DeleteNotificationData) error
-	GetNotifications(userID int64) ([]*proto.GetNotificationData, error)
-	GetNotificationsFamily(familyID int64) ([]*proto.GetNotificationData, error)
+	GetNotifications(userID int64, filter *proto.NotificationFilter) ([]*proto.GetNotificationData, string, error)
+	GetNotificationsFamily(familyID int64, filter *proto.NotificationFilter) ([]*proto.GetNotificationData, string, error)
 	AcceptNotification(data *proto.Accept) (int64, error)
-	Substruct(idMedicine, count int64) error
+	Substruct(idMedicine, userID, count int64) error
+	ChangeStock(idMedicine, userID, delta int64, reason string) error
+	GetStockHistory(idMedicine int64) ([]*proto.StockEntry, error)
+	GetMedicineOwner(idMedicine int64) (int64, error)
+	SetMinCount(idMedicine, minCount int64) error
+	GetSchedules(medicineIDs []int64) (map[int64][]time.Time, error)
+	GetAverageDoses(medicineIDs []int64) (map[int64]float64, error)
+	GetPersonSchedules(isUser bool, idPerson int64) (map[int64][]time.Time, error)
+	GetPersonAverageDoses(isUser bool, idPerson int64) (map[int64]float64, error)
+
+	AddShoppingItem(data *proto.ShoppingItemData, reason string) error
+	AddLowStockItem(idMedicine int64) error
+	GetShoppingList(userID int64) ([]*proto.ShoppingItem, error)
+	GetShoppingListFamily(familyID int64) ([]*proto.ShoppingItem, error)
+	GetShoppingItem(itemID int64) (*proto.ShoppingItem, error)
+	CheckShoppingItem(itemID, userID int64) (bool, error)
+	DeleteShoppingItem(itemID int64) error
+	AddMissingItem(data *proto.ShoppingItemData, reason string) error
+
+	AddKit(userID int64, kit *proto.Kit) (int64, error)
+	GetKits(userID int64) ([]*proto.Kit, error)
+	GetKitsFamily(familyID int64) ([]*proto.Kit, error)
+	GetKitOwner(idKit int64) (int64, error)
+	EditKit(kit *proto.Kit) error
+	DeleteKit(idKit int64) error
+
+	ImportTemplates(data []templates.Template) error
+	AddTemplate(userID int64, template *proto.Template) error
+	GetTemplates(userID, familyID int64) ([]*proto.Template, error)
+	GetTemplate(templateID int64) (*proto.Template, error)
+	DeleteTemplate(templateID int64) error
+
+	GetActiveMedicines(isUser bool, idPerson int64) ([]*proto.GetMedicineData, error)
+	ImportInteractions(data []interactions.Interaction) error
+	GetInteractions(ingredients []string) ([]interactions.Interaction, error)
+
+	SetCalendarToken(userID int64, isUser bool, idPerson int64) (string, error)
+	DeleteCalendarToken(userID int64, isUser bool, idPerson int64) (bool, error)
+	GetCalendarPerson(token string) (*proto.Person, error)
+	GetPersonName(isUser bool, idPerson int64) (string, error)
+	GetTimeZone(isUser bool, idPerson int64) (string, error)
+
+	GetNotificationSettings(userID int64) (*proto.NotificationSettings, error)
+	EditNotificationSettings(settings *proto.NotificationSettings) error
+	AddWebhook(webhook *proto.Webhook) (*proto.Webhook, error)
+	GetWebhooks(userID int64) ([]*proto.Webhook, error)
+	DeleteWebhook(userID, webhookID int64) (bool, error)
+	SetTelegramCode(userID int64, code string, expires time.Time) error
+	LinkTelegram(code string, chatID int64) error
+	UnlinkTelegram(userID int64) (bool, error)
+	GetTelegramUser(chatID int64) (int64, error)
+	GetNotificationPerson(idNotification int64) (*proto.Person, int64, bool, error)
+	GetCourseEnd(idNotification int64) (time.Time, error)
+	SkipNotification(idNotification, userID int64) (bool, error)
+	RescheduleNotification(idNotification, userID int64, at time.Time, action string) (bool, error)
+	GetDoseChanges(isUser bool, idPerson int64, from, to time.Time) ([]*proto.DoseChange, error)
+	AddPushSubscription(subscription *proto.PushSubscription) (bool, error)
+	DeletePushSubscription(userID int64, endpoint string) (bool, error)
+	SetPRNRule(rule *proto.PRNRule) error
+	GetPRNRules(isUser bool, idPerson int64) ([]*proto.PRNRule, error)
+	DeletePRNRule(isUser bool, idPerson, idMedicine int64) (bool, error)
+	GetPRNIntakes(isUser bool, idPerson, idMedicine int64, since time.Time) ([]asneeded.Intake, error)
+	AddPRNIntake(intake *proto.PRNIntake, at time.Time) (asneeded.Rule, []asneeded.Intake, []asneeded.Warning, error)
+	GetCalendarEvents(isUser bool, idPerson int64, days int) ([]*proto.GetNotificationData, error)
+
+	GetDoses(isUser bool, idPerson int64, from, to time.Time) ([]*proto.DoseEntry, error)
+	GetPersonStock(isUser bool, idPerson int64, from, to time.Time) ([]*proto.MedicineStockEntry, error)
 }
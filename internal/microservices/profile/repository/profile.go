@@ -10,8 +10,18 @@ import (
 	"main/internal/microservices/auth/utils/hash"
 	"main/internal/microservices/profile"
 	proto "main/internal/microservices/profile/proto"
+	"main/internal/microservices/profile/utils/asneeded"
+	"main/internal/microservices/profile/utils/dosage"
 	"main/internal/microservices/profile/utils/images"
+	"main/internal/microservices/profile/utils/interactions"
+	"main/internal/microservices/profile/utils/pagination"
+	"main/internal/microservices/profile/utils/preferences"
+	"main/internal/microservices/profile/utils/schedule"
+	"main/internal/microservices/profile/utils/stock"
+	"main/internal/microservices/profile/utils/templates"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/gomodule/redigo/redis"
@@ -29,11 +39,11 @@ func NewStorage(db *sql.DB, minio *minio.Client, redis *redis.Pool) profile.Stor
 }
 
 func (s Storage) GetUserProfile(userID int64) (*proto.ProfileData, error) {
-	sqlScript := "SELECT name, surname, email, avatar, birthday, is_adult FROM users WHERE id=$1"
+	sqlScript := "SELECT name, surname, email, avatar, birthday, is_adult, time_zone FROM users WHERE id=$1"
 
-	var name, surname, email, avatar, birthday string
+	var name, surname, email, avatar, birthday, timeZone string
 	var isAdult bool
-	err := s.db.QueryRow(sqlScript, userID).Scan(&name, &surname, &email, &avatar, &birthday, &isAdult)
+	err := s.db.QueryRow(sqlScript, userID).Scan(&name, &surname, &email, &avatar, &birthday, &isAdult, &timeZone)
 
 	if err != nil {
 		return nil, err
@@ -58,21 +68,22 @@ func (s Storage) GetUserProfile(userID int64) (*proto.ProfileData, error) {
 	}
 
 	return &proto.ProfileData{
-		Name:    name,
-		Surname: surname,
-		Email:   email,
-		Avatar:  avatarUrl,
-		Date:    birthday[:10],
-		Main:    main,
-		Adult:   isAdult,
+		Name:     name,
+		Surname:  surname,
+		Email:    email,
+		Avatar:   avatarUrl,
+		Date:     birthday[:10],
+		Main:     main,
+		Adult:    isAdult,
+		TimeZone: timeZone,
 	}, nil
 }
 
 func (s Storage) EditProfile(data *proto.EditProfileData) error {
-	sqlScript := "SELECT name, surname, password, salt, birthday FROM users WHERE id=$1"
+	sqlScript := "SELECT name, surname, password, salt, birthday, time_zone FROM users WHERE id=$1"
 
-	var oldName, oldSurname, oldPassword, oldSalt, oldBirthday string
-	err := s.db.QueryRow(sqlScript, data.ID).Scan(&oldName, &oldSurname, &oldPassword, &oldSalt, &oldBirthday)
+	var oldName, oldSurname, oldPassword, oldSalt, oldBirthday, oldTimeZone string
+	err := s.db.QueryRow(sqlScript, data.ID).Scan(&oldName, &oldSurname, &oldPassword, &oldSalt, &oldBirthday, &oldTimeZone)
 	if err != nil {
 		return err
 	}
@@ -106,9 +117,13 @@ func (s Storage) EditProfile(data *proto.EditProfileData) error {
 		oldBirthday = data.Date
 	}
 
-	sqlScript = "UPDATE users SET name = $2, surname = $3, password = $4, salt = $5, birthday = TO_TIMESTAMP($6, 'YYYY-MM-DD') WHERE id = $1"
+	if data.TimeZone != "" {
+		oldTimeZone = data.TimeZone
+	}
+
+	sqlScript = "UPDATE users SET name = $2, surname = $3, password = $4, salt = $5, birthday = TO_TIMESTAMP($6, 'YYYY-MM-DD'), time_zone = $7 WHERE id = $1"
 
-	_, err = s.db.Exec(sqlScript, data.ID, oldName, oldSurname, oldPassword, oldSalt, oldBirthday)
+	_, err = s.db.Exec(sqlScript, data.ID, oldName, oldSurname, oldPassword, oldSalt, oldBirthday, oldTimeZone)
 	if err != nil {
 		return err
 	}
@@ -191,6 +206,21 @@ func (s Storage) DeleteFile(name string, bucket string) error {
 	return nil
 }
 
+func (s Storage) CopyFile(name string, bucket string, userID int64) (string, error) {
+	imageName := images.GenerateObjectName(userID)
+
+	_, err := s.minio.CopyObject(
+		context.Background(),
+		minio.CopyDestOptions{Bucket: bucket, Object: imageName},
+		minio.CopySrcOptions{Bucket: bucket, Object: name},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return imageName, nil
+}
+
 func (s Storage) AcceptInvitationToFamily(data *proto.AddToFamily) error {
 	sqlScript := "UPDATE users SET id_family = $2, is_adult = $3 WHERE email = $1"
 
@@ -246,41 +276,63 @@ func (s Storage) HasFamily(userID int64) (bool, int64, int64, bool, error) {
 	return true, idMainUser, idFamily, isAdult, nil
 }
 
+// personTables — таблицы с данными человека: признак пользователя и id человека
+var personTables = []struct{ name, isUser, idPerson string }{
+	{"notification_user", "to_is_user", "id_to_user"},
+	{"health", "is_user", "id_person"},
+	{"allergies", "is_user", "id_person"},
+	{"chronic_conditions", "is_user", "id_person"},
+	{"dose_log", "to_is_user", "id_person"},
+	{"dose_changes", "to_is_user", "id_person"},
+	{"prn_rules", "to_is_user", "id_person"},
+	{"prn_intakes", "to_is_user", "id_person"},
+	{"calendar_tokens", "is_user", "id_person"},
+}
+
 func (s Storage) DeleteFamily(userID int64) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
 	sqlScript := "SELECT id_family FROM users WHERE id=$1"
 
 	var idFamily int64
-	err := s.db.QueryRow(sqlScript, userID).Scan(&idFamily)
+	err = tx.QueryRow(sqlScript, userID).Scan(&idFamily)
 
 	if err != nil {
 		return err
 	}
 
 	sqlScript = "UPDATE users SET id_family = 0 WHERE id_family = $1"
-	_, err = s.db.Exec(sqlScript, idFamily)
+	_, err = tx.Exec(sqlScript, idFamily)
 	if err != nil {
 		return err
 	}
 
 	sqlScript = "DELETE FROM family WHERE id_main_user = $1"
-	_, err = s.db.Exec(sqlScript, userID)
+	_, err = tx.Exec(sqlScript, userID)
 	if err != nil {
 		return err
 	}
 
-	sqlScript = "DELETE FROM notification_user WHERE to_is_user = false and id_to_user IN (SELECT id FROM members WHERE id_family = $1)"
-	_, err = s.db.Exec(sqlScript, idFamily)
-	if err != nil {
-		return err
+	for _, table := range personTables {
+		sqlScript = "DELETE FROM " + table.name + " WHERE " + table.isUser + " = false AND " + table.idPerson +
+			" IN (SELECT id FROM members WHERE id_family = $1)"
+		_, err = tx.Exec(sqlScript, idFamily)
+		if err != nil {
+			return err
+		}
 	}
 
 	sqlScript = "DELETE FROM members WHERE id_family = $1"
-	_, err = s.db.Exec(sqlScript, idFamily)
+	_, err = tx.Exec(sqlScript, idFamily)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return tx.Commit()
 }
 
 func (s Storage) DeleteFromFamily(userID int64) error {
@@ -293,6 +345,45 @@ func (s Storage) DeleteFromFamily(userID int64) error {
 	return nil
 }
 
+func (s Storage) LeaveFamily(userID, idMainUser int64) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// лекарства пользователя уходят вместе с ним, поэтому напоминания другим членам семьи по ним отменяются
+	sqlScript := "DELETE FROM notification_user WHERE is_accepted = false AND NOT (to_is_user = true AND id_to_user = $1) " +
+		"AND id_medicine IN (SELECT id FROM medicine WHERE id_user = $1)"
+	_, err = tx.Exec(sqlScript, userID)
+	if err != nil {
+		return err
+	}
+
+	// напоминания самому пользователю по общим лекарствам семьи больше не действуют
+	sqlScript = "DELETE FROM notification_user WHERE is_accepted = false AND to_is_user = true AND id_to_user = $1 " +
+		"AND id_medicine NOT IN (SELECT id FROM medicine WHERE id_user = $1)"
+	_, err = tx.Exec(sqlScript, userID)
+	if err != nil {
+		return err
+	}
+
+	// оставшиеся напоминания, созданные пользователем для семьи, переходят главному пользователю
+	sqlScript = "UPDATE notification_user SET id_from = $2 WHERE id_from = $1 AND NOT (to_is_user = true AND id_to_user = $1)"
+	_, err = tx.Exec(sqlScript, userID, idMainUser)
+	if err != nil {
+		return err
+	}
+
+	sqlScript = "UPDATE users SET id_family = 0 WHERE id = $1"
+	_, err = tx.Exec(sqlScript, userID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
 func (s Storage) AddMember(data *proto.MemberData) error {
 	sqlScript := "INSERT INTO members(id_main_user, id_family, name, avatar) VALUES($1, $2, $3, $4)"
 
@@ -303,345 +394,2567 @@ func (s Storage) AddMember(data *proto.MemberData) error {
 	return nil
 }
 
-func (s Storage) GetFamily(userID int64) ([]*proto.ResponseMemberData, error) {
-	sqlScript := "SELECT id_family FROM users WHERE id=$1"
+func (s Storage) GetMemberFamily(memberID int64) (int64, error) {
+	sqlScript := "SELECT id_family FROM members WHERE id=$1"
 
 	var idFamily int64
-	err := s.db.QueryRow(sqlScript, userID).Scan(&idFamily)
+	err := s.db.QueryRow(sqlScript, memberID).Scan(&idFamily)
 	if err != nil {
-		return nil, err
-	}
-
-	if idFamily == 0 {
-		return nil, nil
+		return 0, err
 	}
 
-	members := make([]*proto.ResponseMemberData, 0)
-	sqlScript = "SELECT id, name, avatar, is_adult FROM users WHERE id_family = $1"
+	return idFamily, nil
+}
 
-	rows, err := s.db.Query(sqlScript, idFamily)
+// AddMemberInvite сохраняет приглашение пользователя на место члена семьи и возвращает его секретный токен
+func (s Storage) AddMemberInvite(idFamily, idMember int64, email string, isAdult bool, expires time.Time) (string, error) {
+	token, err := uuid.NewV4()
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	defer rows.Close()
 
-	for rows.Next() {
-		var member proto.ResponseMemberData
-		member.IsUser = true
-		if err = rows.Scan(&member.ID, &member.Name, &member.Avatar, &member.IsAdult); err != nil {
-			return nil, err
-		}
-		member.Avatar, err = images.GenerateFileURL(member.Avatar, constants.UserObjectsBucketName)
-		if err != nil {
-			return nil, err
-		}
-		members = append(members, &member)
+	sqlScript := "INSERT INTO member_invites(token, id_family, id_member, email, is_adult, expires) VALUES($1, $2, $3, $4, $5, $6)"
+
+	if _, err = s.db.Exec(sqlScript, token.String(), idFamily, idMember, email, isAdult, expires); err != nil {
+		return "", err
 	}
 
-	sqlScript = "SELECT id, name, avatar FROM members WHERE id_family = $1"
+	return token.String(), nil
+}
 
-	rows, err = s.db.Query(sqlScript, idFamily)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
+// UseMemberInvite погашает приглашение, выданное на email, и возвращает семью и члена семьи из него
+func (s Storage) UseMemberInvite(token, email string) (*proto.AddToFamily, int64, error) {
+	sqlScript := "DELETE FROM member_invites WHERE token = $1 AND email = $2 AND expires > now() " +
+		"RETURNING id_family, id_member, is_adult"
 
-	for rows.Next() {
-		var member proto.ResponseMemberData
-		member.IsAdult = false
-		member.IsUser = false
-		if err = rows.Scan(&member.ID, &member.Name, &member.Avatar); err != nil {
-			return nil, err
-		}
-		member.Avatar, err = images.GenerateFileURL(member.Avatar, constants.UserObjectsBucketName)
-		if err != nil {
-			return nil, err
-		}
-		members = append(members, &member)
+	invite := &proto.AddToFamily{Email: email}
+	var idMember int64
+	if err := s.db.QueryRow(sqlScript, token, email).Scan(&invite.ID, &idMember, &invite.IsAdult); err != nil {
+		return nil, 0, err
 	}
 
-	return members, nil
+	return invite, idMember, nil
 }
 
-func (s Storage) DeleteMember(userID int64) (string, error) {
-	sqlScript := "SELECT avatar FROM members WHERE id=$1"
-
-	var avatar string
-	err := s.db.QueryRow(sqlScript, userID).Scan(&avatar)
+func (s Storage) PromoteMember(memberID, userID int64) (string, error) {
+	tx, err := s.db.Begin()
 	if err != nil {
 		return "", err
 	}
+	defer tx.Rollback()
 
-	sqlScript = "DELETE FROM notification_user WHERE to_is_user = false AND id_to_user=$1"
-	_, err = s.db.Exec(sqlScript, userID)
-	if err != nil {
-		return "", err
-	}
+	sqlScript := "SELECT avatar FROM members WHERE id=$1 FOR UPDATE"
 
-	sqlScript = "DELETE FROM members WHERE id = $1"
-	_, err = s.db.Exec(sqlScript, userID)
+	var memberAvatar string
+	err = tx.QueryRow(sqlScript, memberID).Scan(&memberAvatar)
 	if err != nil {
 		return "", err
 	}
 
-	return avatar, nil
-}
+	sqlScript = "SELECT avatar FROM users WHERE id=$1"
 
-func (s Storage) IsUserExists(data *proto.EmailData) (bool, error) {
-	sqlScript := "SELECT id FROM users WHERE email=$1"
-	rows, err := s.db.Query(sqlScript, data.Email)
+	var userAvatar string
+	err = tx.QueryRow(sqlScript, userID).Scan(&userAvatar)
 	if err != nil {
-		return false, err
+		return "", err
 	}
-	err = rows.Err()
+
+	// напоминания и история приёма члена семьи переходят пользователю
+	sqlScript = "UPDATE notification_user SET to_is_user = true, id_to_user = $2, name_to = (SELECT name FROM users WHERE id = $2) " +
+		"WHERE to_is_user = false AND id_to_user = $1"
+	_, err = tx.Exec(sqlScript, memberID, userID)
 	if err != nil {
-		return false, err
+		return "", err
 	}
-	// убедимся, что всё закроется при выходе из программы
-	defer func() {
-		rows.Close()
-	}()
 
-	// Из базы пришел пустой запрос, значит пользователя в базе данных нет
-	if !rows.Next() {
-		return false, constants.ErrWrongData
+	for _, table := range []string{"dose_log", "dose_changes", "prn_intakes"} {
+		sqlScript = "UPDATE " + table + " SET to_is_user = true, id_person = $2 WHERE to_is_user = false AND id_person = $1"
+		_, err = tx.Exec(sqlScript, memberID, userID)
+		if err != nil {
+			return "", err
+		}
 	}
 
-	return true, nil
-}
-
-func (s Storage) AddMedicine(data *proto.AddMed) error {
-	sqlScript := "INSERT INTO medicine(id_user, name, count, image, is_tablets) VALUES($1, $2, $3, $4, $5)"
-
-	if _, err := s.db.Exec(sqlScript, data.UserID, data.Medicine.Name, data.Medicine.Count, data.Medicine.Image, data.Medicine.IsTablets); err != nil {
-		return err
+	// правила приёма по необходимости и ссылки на календарь, уже заведённые для пользователя, остаются его
+	sqlScript = "DELETE FROM prn_rules m WHERE m.to_is_user = false AND m.id_person = $1 AND EXISTS (" +
+		"SELECT 1 FROM prn_rules WHERE to_is_user = true AND id_person = $2 AND id_medicine = m.id_medicine)"
+	_, err = tx.Exec(sqlScript, memberID, userID)
+	if err != nil {
+		return "", err
 	}
 
-	return nil
-}
-
-func (s Storage) DeleteMedicine(data *proto.DeleteMed) (string, error) {
-	sqlScript := "SELECT image FROM medicine WHERE id=$1"
+	sqlScript = "UPDATE prn_rules SET to_is_user = true, id_person = $2 WHERE to_is_user = false AND id_person = $1"
+	_, err = tx.Exec(sqlScript, memberID, userID)
+	if err != nil {
+		return "", err
+	}
 
-	var image string
-	err := s.db.QueryRow(sqlScript, data.MedicineID).Scan(&image)
+	sqlScript = "DELETE FROM calendar_tokens m WHERE m.is_user = false AND m.id_person = $1 AND EXISTS (" +
+		"SELECT 1 FROM calendar_tokens WHERE is_user = true AND id_person = $2 AND id_user = m.id_user)"
+	_, err = tx.Exec(sqlScript, memberID, userID)
 	if err != nil {
 		return "", err
 	}
-	sqlScript = "DELETE FROM medicine WHERE id=$1"
 
-	_, err = s.db.Exec(sqlScript, data.MedicineID)
+	sqlScript = "UPDATE calendar_tokens SET is_user = true, id_person = $2 WHERE is_user = false AND id_person = $1"
+	_, err = tx.Exec(sqlScript, memberID, userID)
 	if err != nil {
 		return "", err
 	}
-	return image, nil
-}
 
-func (s Storage) GetMedicine(userID int64) ([]*proto.GetMedicineData, error) {
-	medicines := make([]*proto.GetMedicineData, 0)
-	sqlScript := "SELECT id, name, count, image, is_tablets FROM medicine WHERE id_user = $1"
+	// данные о здоровье тоже переходят, если пользователь не заполнил свои
+	sqlScript = "UPDATE health SET is_user = true, id_person = $2 WHERE is_user = false AND id_person = $1 " +
+		"AND NOT EXISTS (SELECT 1 FROM health WHERE is_user = true AND id_person = $2)"
+	_, err = tx.Exec(sqlScript, memberID, userID)
+	if err != nil {
+		return "", err
+	}
 
-	rows, err := s.db.Query(sqlScript, userID)
+	sqlScript = "DELETE FROM health WHERE is_user = false AND id_person = $1"
+	_, err = tx.Exec(sqlScript, memberID)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	defer rows.Close()
 
-	for rows.Next() {
-		var medicine proto.GetMedicineData
-		medicine.Medicine = &proto.Medicine{
-			Image:     "",
-			Name:      "",
-			IsTablets: false,
-			Count:     0,
-		}
-		if err = rows.Scan(&medicine.ID, &medicine.Medicine.Name, &medicine.Medicine.Count, &medicine.Medicine.Image, &medicine.Medicine.IsTablets); err != nil {
-			return nil, err
-		}
-		medicine.Medicine.Image, err = images.GenerateFileURL(medicine.Medicine.Image, constants.MedicinesObjectsBucketName)
+	for _, table := range []string{"allergies", "chronic_conditions"} {
+		sqlScript = "UPDATE " + table + " SET is_user = true, id_person = $2 WHERE is_user = false AND id_person = $1"
+		_, err = tx.Exec(sqlScript, memberID, userID)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
-		medicines = append(medicines, &medicine)
 	}
 
-	return medicines, nil
-}
-
-func (s Storage) GetMedicineFamily(familyID int64) ([]*proto.GetMedicineData, error) {
-	sqlScript := "SELECT medicine.id, medicine.name, medicine.count, medicine.image, medicine.is_tablets " +
-		"FROM medicine JOIN users u ON u.id_family = $1 AND medicine.id_user = u.id"
+	// аватар члена семьи переносится, только если пользователь ещё не загрузил свой
+	if userAvatar == constants.DefaultImage && memberAvatar != constants.DefaultImage {
+		sqlScript = "UPDATE users SET avatar = $2 WHERE id = $1"
+		_, err = tx.Exec(sqlScript, userID, memberAvatar)
+		if err != nil {
+			return "", err
+		}
+		memberAvatar = constants.DefaultImage
+	}
 
-	rows, err := s.db.Query(sqlScript, familyID)
+	sqlScript = "DELETE FROM members WHERE id = $1"
+	_, err = tx.Exec(sqlScript, memberID)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	defer rows.Close()
 
-	medicines := make([]*proto.GetMedicineData, 0)
-	for rows.Next() {
-		var medicine proto.GetMedicineData
-		medicine.Medicine = &proto.Medicine{
-			Image:     "",
-			Name:      "",
-			IsTablets: false,
-			Count:     0,
-		}
-		if err = rows.Scan(&medicine.ID, &medicine.Medicine.Name, &medicine.Medicine.Count, &medicine.Medicine.Image, &medicine.Medicine.IsTablets); err != nil {
-			return nil, err
-		}
-		medicine.Medicine.Image, err = images.GenerateFileURL(medicine.Medicine.Image, constants.MedicinesObjectsBucketName)
-		if err != nil {
-			return nil, err
-		}
-		medicines = append(medicines, &medicine)
-	}
+	return memberAvatar, tx.Commit()
+}
 
-	return medicines, nil
+var familySortKeys = map[string][]sortKey{
+	constants.SortName: {{"family.name", "text"}, {"family.is_user", "bool"}, {"family.id", "int"}},
 }
 
-func (s Storage) EditMedicine(data *proto.GetMedicineData) (string, error) {
-	sqlScript := "SELECT name, count, image, is_tablets FROM medicine WHERE id=$1"
+// GetFamily возвращает пользователей и членов семьи пользователя одной выборкой с фильтрами и постраничным выводом
+func (s Storage) GetFamily(userID int64, filter *proto.FamilyFilter) ([]*proto.ResponseMemberData, string, error) {
+	sqlScript := "SELECT id_family FROM users WHERE id=$1"
 
-	var oldName, oldImage string
-	var oldCount int64
-	var oldIsTablets bool
-	err := s.db.QueryRow(sqlScript, data.ID).Scan(&oldName, &oldCount, &oldImage, &oldIsTablets)
+	var idFamily int64
+	err := s.db.QueryRow(sqlScript, userID).Scan(&idFamily)
 	if err != nil {
-		return "", err
+		return nil, "", err
 	}
 
-	image := oldImage
-
-	if data.Medicine.Name != oldName && len(data.Medicine.Name) != 0 {
-		oldName = data.Medicine.Name
+	if idFamily == 0 {
+		return nil, "", nil
 	}
 
-	if data.Medicine.Image != oldImage && len(data.Medicine.Image) != 0 && data.Medicine.Image != constants.DefaultMedicine {
-		oldImage = data.Medicine.Image
+	sqlScript = "SELECT family.id, family.name, family.avatar, family.is_adult, family.is_user FROM (" +
+		"SELECT id, name, avatar, COALESCE(is_adult, false) AS is_adult, true AS is_user FROM users WHERE id_family = $1 " +
+		"UNION ALL SELECT id, name, avatar, false, false FROM members WHERE id_family = $1) AS family"
+	args := []interface{}{idFamily}
+	conditions := make([]string, 0)
+
+	switch filter.Kind {
+	case constants.FamilyUsers:
+		conditions = append(conditions, "family.is_user = true")
+	case constants.FamilyMembers:
+		conditions = append(conditions, "family.is_user = false")
 	}
-
-	if data.Medicine.Count != oldCount && data.Medicine.Count != -1 {
-		if !oldIsTablets {
-			return "", errors.New("cant change count for not tablets")
-		}
-		oldCount = data.Medicine.Count
+	if filter.OnlyAdults {
+		conditions = append(conditions, "family.is_adult = true")
 	}
 
-	sqlScript = "UPDATE medicine SET name = $2, count = $3, image = $4 WHERE id = $1"
+	page := pageOf(filter.Page, familySortKeys, constants.SortName)
+	sqlScript, args, err = paginate(sqlScript, conditions, args, familySortKeys[page.Sort], page)
+	if err != nil {
+		return nil, "", err
+	}
 
-	_, err = s.db.Exec(sqlScript, data.ID, oldName, oldCount, oldImage)
+	rows, err := s.db.Query(sqlScript, args...)
 	if err != nil {
-		return "", err
+		return nil, "", err
 	}
-	return image, nil
-}
+	defer rows.Close()
 
-func (s Storage) AddNotification(data *proto.NotificationData) error {
-	sqlScript := "INSERT INTO notification_user(id_from, to_is_user, id_to_user, name_to, id_medicine, name_medicine, time, is_accepted) VALUES($1, $2, $3, $4, $5, $6, $7, false)"
+	members := make([]*proto.ResponseMemberData, 0)
+	for rows.Next() {
+		var member proto.ResponseMemberData
+		if err = rows.Scan(&member.ID, &member.Name, &member.Avatar, &member.IsAdult, &member.IsUser); err != nil {
+			return nil, "", err
+		}
+		member.Avatar, err = images.GenerateFileURL(member.Avatar, constants.UserObjectsBucketName)
+		if err != nil {
+			return nil, "", err
+		}
+		members = append(members, &member)
+	}
 
-	if _, err := s.db.Exec(sqlScript, data.IDFrom, data.IsUser, data.IDTo, data.NameTo, data.IDMedicine, data.NameMedicine, data.Time); err != nil {
-		return err
+	if page.Limit <= 0 || int64(len(members)) <= page.Limit {
+		return members, "", nil
 	}
 
-	return nil
+	members = members[:page.Limit]
+	last := members[len(members)-1]
+	return members, pagination.Encode(last.Name, strconv.FormatBool(last.IsUser), strconv.FormatInt(last.ID, 10)), nil
 }
 
-func (s Storage) DeleteNotification(data *proto.DeleteNotificationData) error {
-	sqlScript := "DELETE FROM notification_user WHERE id=$1"
+func (s Storage) GetHealth(isUser bool, idPerson int64) (*proto.HealthData, error) {
+	sqlScript := "SELECT COALESCE(TO_CHAR(birthday, 'YYYY-MM-DD'), '') FROM members WHERE id=$1"
+	if isUser {
+		sqlScript = "SELECT COALESCE(TO_CHAR(birthday, 'YYYY-MM-DD'), '') FROM users WHERE id=$1"
+	}
 
-	_, err := s.db.Exec(sqlScript, data.NotificationID)
+	health := &proto.HealthData{
+		IsUser:            isUser,
+		IDPerson:          idPerson,
+		BirthDate:         "",
+		Weight:            0,
+		Allergies:         make([]string, 0),
+		ChronicConditions: make([]string, 0),
+		Notes:             "",
+	}
+
+	err := s.db.QueryRow(sqlScript, idPerson).Scan(&health.BirthDate)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
-}
 
-func (s Storage) GetNotifications(userID int64) ([]*proto.GetNotificationData, error) {
-	notifications := make([]*proto.GetNotificationData, 0)
-	sqlScript := "SELECT notification_user.id, to_is_user, id_to_user, u.name, id_medicine, medicine.name, medicine.is_tablets, time, is_accepted FROM notification_user JOIN medicine ON medicine.id = id_medicine JOIN users u ON notification_user.id_to_user = u.id AND id_to_user = $1"
+	sqlScript = "SELECT COALESCE(weight, 0), COALESCE(notes, '') FROM health WHERE is_user = $1 AND id_person = $2"
+	err = s.db.QueryRow(sqlScript, isUser, idPerson).Scan(&health.Weight, &health.Notes)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
 
-	rows, err := s.db.Query(sqlScript, userID)
+	health.Allergies, err = s.getHealthList("allergies", isUser, idPerson)
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var notification proto.GetNotificationData
-		notification.NotificationData = &proto.NotificationData{
-			IsUser:       false,
-			IDTo:         0,
-			NameTo:       "",
-			IDMedicine:   0,
-			NameMedicine: "",
-			IsTablets:    false,
-			Time:         "",
-			IsAccepted:   false,
-		}
-		if err = rows.Scan(&notification.ID, &notification.NotificationData.IsUser, &notification.NotificationData.IDTo, &notification.NotificationData.NameTo, &notification.NotificationData.IDMedicine, &notification.NotificationData.NameMedicine, &notification.NotificationData.IsTablets, &notification.NotificationData.Time, &notification.NotificationData.IsAccepted); err != nil {
-			return nil, err
-		}
 
-		notifications = append(notifications, &notification)
+	health.ChronicConditions, err = s.getHealthList("chronic_conditions", isUser, idPerson)
+	if err != nil {
+		return nil, err
 	}
 
-	return notifications, nil
+	return health, nil
 }
 
-func (s Storage) GetNotificationsFamily(familyID int64) ([]*proto.GetNotificationData, error) {
-	sqlScript := "SELECT notification_user.id, notification_user.to_is_user, notification_user.id_to_user, u.name, notification_user.id_medicine, medicine.name, medicine.is_tablets, notification_user.time, notification_user.is_accepted " +
-		"FROM notification_user JOIN medicine ON medicine.id = id_medicine JOIN users u ON u.id_family = $1 AND notification_user.id_to_user = u.id"
+func (s Storage) getHealthList(table string, isUser bool, idPerson int64) ([]string, error) {
+	sqlScript := "SELECT name FROM " + table + " WHERE is_user = $1 AND id_person = $2 ORDER BY id"
 
-	rows, err := s.db.Query(sqlScript, familyID)
+	rows, err := s.db.Query(sqlScript, isUser, idPerson)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	notifications := make([]*proto.GetNotificationData, 0)
+	names := make([]string, 0)
 	for rows.Next() {
-		var notification proto.GetNotificationData
-		notification.NotificationData = &proto.NotificationData{
-			IsUser:       false,
-			IDTo:         0,
-			NameTo:       "",
-			IDMedicine:   0,
-			NameMedicine: "",
-			IsTablets:    false,
-			Time:         "",
-			IsAccepted:   false,
-		}
-		if err = rows.Scan(&notification.ID, &notification.NotificationData.IsUser, &notification.NotificationData.IDTo, &notification.NotificationData.NameTo, &notification.NotificationData.IDMedicine, &notification.NotificationData.NameMedicine, &notification.NotificationData.IsTablets, &notification.NotificationData.Time, &notification.NotificationData.IsAccepted); err != nil {
+		var name string
+		if err = rows.Scan(&name); err != nil {
 			return nil, err
 		}
-
-		notifications = append(notifications, &notification)
+		names = append(names, name)
 	}
 
-	return notifications, nil
+	return names, nil
 }
 
-func (s Storage) AcceptNotification(data *proto.Accept) (int64, error) {
-	sqlScript := "UPDATE notification_user SET is_accepted = true WHERE id = $1 RETURNING id_medicine"
+func (s Storage) EditHealth(data *proto.HealthData) error {
+	if data.BirthDate != "" {
+		sqlScript := "UPDATE members SET birthday = TO_TIMESTAMP($2, 'YYYY-MM-DD') WHERE id = $1"
+		if data.IsUser {
+			sqlScript = "UPDATE users SET birthday = TO_TIMESTAMP($2, 'YYYY-MM-DD') WHERE id = $1"
+		}
+
+		_, err := s.db.Exec(sqlScript, data.IDPerson, data.BirthDate)
+		if err != nil {
+			return err
+		}
+	}
+
+	sqlScript := "INSERT INTO health(is_user, id_person, weight, notes) VALUES($1, $2, $3, $4) " +
+		"ON CONFLICT (is_user, id_person) DO UPDATE SET weight = $3, notes = $4"
+	_, err := s.db.Exec(sqlScript, data.IsUser, data.IDPerson, data.Weight, data.Notes)
+	if err != nil {
+		return err
+	}
+
+	err = s.setHealthList("allergies", data.IsUser, data.IDPerson, data.Allergies)
+	if err != nil {
+		return err
+	}
+
+	return s.setHealthList("chronic_conditions", data.IsUser, data.IDPerson, data.ChronicConditions)
+}
+
+func (s Storage) setHealthList(table string, isUser bool, idPerson int64, names []string) error {
+	sqlScript := "DELETE FROM " + table + " WHERE is_user = $1 AND id_person = $2"
+	_, err := s.db.Exec(sqlScript, isUser, idPerson)
+	if err != nil {
+		return err
+	}
+
+	sqlScript = "INSERT INTO " + table + "(is_user, id_person, name) VALUES($1, $2, $3)"
+	for _, name := range names {
+		if len(name) == 0 {
+			continue
+		}
+		if _, err = s.db.Exec(sqlScript, isUser, idPerson, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s Storage) DeleteMember(userID int64) (string, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	sqlScript := "SELECT avatar FROM members WHERE id=$1 FOR UPDATE"
+
+	var avatar string
+	err = tx.QueryRow(sqlScript, userID).Scan(&avatar)
+	if err != nil {
+		return "", err
+	}
+
+	for _, table := range personTables {
+		sqlScript = "DELETE FROM " + table.name + " WHERE " + table.isUser + " = false AND " + table.idPerson + " = $1"
+		_, err = tx.Exec(sqlScript, userID)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	sqlScript = "DELETE FROM members WHERE id = $1"
+	_, err = tx.Exec(sqlScript, userID)
+	if err != nil {
+		return "", err
+	}
+
+	return avatar, tx.Commit()
+}
+
+func (s Storage) IsUserExists(data *proto.EmailData) (bool, error) {
+	sqlScript := "SELECT id FROM users WHERE email=$1"
+	rows, err := s.db.Query(sqlScript, data.Email)
+	if err != nil {
+		return false, err
+	}
+	err = rows.Err()
+	if err != nil {
+		return false, err
+	}
+	// убедимся, что всё закроется при выходе из программы
+	defer func() {
+		rows.Close()
+	}()
+
+	// Из базы пришел пустой запрос, значит пользователя в базе данных нет
+	if !rows.Next() {
+		return false, constants.ErrWrongData
+	}
+
+	return true, nil
+}
+
+func (s Storage) GetUserByEmail(email string) (int64, int64, error) {
+	sqlScript := "SELECT id, id_family FROM users WHERE email=$1"
+
+	var userID, idFamily int64
+	err := s.db.QueryRow(sqlScript, email).Scan(&userID, &idFamily)
+	if err == sql.ErrNoRows {
+		return 0, 0, constants.ErrWrongData
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return userID, idFamily, nil
+}
+
+// AddMedicine одной транзакцией добавляет лекарство, начальный остаток в журнал и действующие вещества
+func (s Storage) AddMedicine(data *proto.AddMed) (int64, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	sqlScript := "INSERT INTO medicine(id_user, name, lot, count, image, is_tablets, form, strength, unit, min_count, expires, id_kit) " +
+		"VALUES($1, $2, NULLIF($3, ''), $4, $5, $6, $7, $8, $9, $10, NULLIF($11, '')::date, NULLIF($12, 0)) RETURNING id"
 
 	var medicineID int64
-	if err := s.db.QueryRow(sqlScript, data.ID).Scan(&medicineID); err != nil {
+	err = tx.QueryRow(sqlScript, data.UserID, data.Medicine.Name, data.Medicine.Lot, data.Medicine.Count, data.Medicine.Image, data.Medicine.IsTablets,
+		data.Medicine.Form, data.Medicine.Strength, data.Medicine.Unit, data.Medicine.MinCount, data.Medicine.ExpiryDate, data.Medicine.IDKit).Scan(&medicineID)
+	if err != nil {
+		return 0, err
+	}
+
+	if data.Medicine.Count != 0 {
+		sqlScript = "INSERT INTO medicine_ledger(id_medicine, id_user, delta, reason) VALUES($1, $2, $3, $4)"
+
+		_, err = tx.Exec(sqlScript, medicineID, data.UserID, data.Medicine.Count, stock.ReasonRestock)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = setMedicineIngredients(tx, medicineID, data.Medicine.Ingredients)
+	if err != nil {
 		return 0, err
 	}
 
+	return medicineID, tx.Commit()
+}
+
+// FindMedicineByLot ищет невыбывшее лекарство пользователя или его семьи с тем же названием и номером серии,
+// предпочитая лекарство самого пользователя; 0 — такого нет
+func (s Storage) FindMedicineByLot(userID, idFamily int64, name, lot string) (int64, error) {
+	sqlScript := "SELECT id FROM medicine " +
+		"WHERE (id_user = $1 OR ($2 <> 0 AND id_user IN (SELECT id FROM users WHERE id_family = $2))) " +
+		"AND lower(name) = lower($3) AND COALESCE(lot, '') = $4 AND disposed IS NULL " +
+		"ORDER BY id_user = $1 DESC, id LIMIT 1"
+
+	var medicineID int64
+	err := s.db.QueryRow(sqlScript, userID, idFamily, name, lot).Scan(&medicineID)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
 	return medicineID, nil
 }
 
-func (s Storage) Substruct(idMedicine, count int64) error {
-	sqlScript := "DO $$ DECLARE input_id INT:= " + strconv.Itoa(int(idMedicine)) +
-		"; input_count INT:= " + strconv.Itoa(int(count)) + "; BEGIN IF (SELECT count FROM medicine WHERE id = input_id) - input_count >= 0 " +
-		"THEN UPDATE medicine SET count = count - input_count WHERE ID = input_id; " +
-		"ELSE UPDATE medicine SET count = 0 WHERE ID = input_id; END IF; END $$;"
+// UpdateImportedMedicine одной транзакцией перезаписывает лекарство данными из загруженного файла;
+// расхождение в количестве проходит через журнал как корректировка
+func (s Storage) UpdateImportedMedicine(data *proto.GetMedicineData) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	sqlScript := "UPDATE medicine SET is_tablets = $2, form = $3, strength = $4, unit = $5, min_count = $6, " +
+		"expires = NULLIF($7, '')::date, id_kit = NULLIF($8, 0) WHERE id = $1 RETURNING COALESCE(count, 0)"
+
+	var oldCount int64
+	err = tx.QueryRow(sqlScript, data.ID, data.Medicine.IsTablets, data.Medicine.Form, data.Medicine.Strength, data.Medicine.Unit,
+		data.Medicine.MinCount, data.Medicine.ExpiryDate, data.Medicine.IDKit).Scan(&oldCount)
+	if err != nil {
+		return err
+	}
+
+	if data.Medicine.Count != oldCount {
+		err = changeStock(tx, data.ID, data.UserID, data.Medicine.Count-oldCount, stock.ReasonCorrection)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(data.Medicine.Ingredients) != 0 {
+		err = setMedicineIngredients(tx, data.ID, data.Medicine.Ingredients)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// execer — общее у *sql.DB и *sql.Tx, чтобы запись можно было сделать как отдельно, так и внутри транзакции
+type execer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}
+
+func setMedicineIngredients(db execer, medicineID int64, names []string) error {
+	sqlScript := "DELETE FROM medicine_ingredients WHERE id_medicine = $1"
 
-	_, err := s.db.Exec(sqlScript)
+	_, err := db.Exec(sqlScript, medicineID)
 	if err != nil {
 		return err
 	}
+
+	sqlScript = "INSERT INTO medicine_ingredients(id_medicine, name) VALUES($1, $2)"
+	for _, name := range names {
+		_, err = db.Exec(sqlScript, medicineID, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fillIngredients одним запросом дописывает действующие вещества к списку лекарств
+func (s Storage) fillIngredients(medicines []*proto.GetMedicineData) error {
+	if len(medicines) == 0 {
+		return nil
+	}
+
+	index := make(map[int64]*proto.Medicine, len(medicines))
+	args := make([]interface{}, 0, len(medicines))
+	for _, medicine := range medicines {
+		index[medicine.ID] = medicine.Medicine
+		args = append(args, medicine.ID)
+	}
+
+	sqlScript := "SELECT id_medicine, name FROM medicine_ingredients WHERE id_medicine IN (" + inList(len(args)) + ") ORDER BY id"
+
+	rows, err := s.db.Query(sqlScript, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var medicineID int64
+		var name string
+		if err = rows.Scan(&medicineID, &name); err != nil {
+			return err
+		}
+		if medicine, ok := index[medicineID]; ok {
+			medicine.Ingredients = append(medicine.Ingredients, name)
+		}
+	}
+
 	return nil
 }
+
+func (s Storage) DeleteMedicine(data *proto.DeleteMed) (string, error) {
+	sqlScript := "SELECT image FROM medicine WHERE id=$1"
+
+	var image string
+	err := s.db.QueryRow(sqlScript, data.MedicineID).Scan(&image)
+	if err != nil {
+		return "", err
+	}
+	sqlScript = "DELETE FROM medicine WHERE id=$1"
+
+	_, err = s.db.Exec(sqlScript, data.MedicineID)
+	if err != nil {
+		return "", err
+	}
+	return image, nil
+}
+
+// medicineColumns — поля лекарства, которые читает scanMedicine
+const medicineColumns = "medicine.id, medicine.name, medicine.count, medicine.image, medicine.is_tablets, " +
+	"COALESCE(medicine.form, ''), COALESCE(medicine.strength, ''), COALESCE(medicine.unit, ''), COALESCE(medicine.min_count, 0), " +
+	"COALESCE(to_char(medicine.expires, 'YYYY-MM-DD'), ''), COALESCE(medicine.id_kit, 0), " +
+	"COALESCE((SELECT kits.name FROM kits WHERE kits.id = medicine.id_kit), ''), " +
+	"COALESCE(medicine.dispose_reason, ''), COALESCE(to_char(medicine.disposed, 'YYYY-MM-DD'), ''), COALESCE(medicine.lot, ''), " +
+	"COALESCE((SELECT kits.location FROM kits WHERE kits.id = medicine.id_kit), '')"
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanMedicine(row rowScanner) (*proto.GetMedicineData, error) {
+	medicine := &proto.GetMedicineData{Medicine: &proto.Medicine{}}
+	err := row.Scan(&medicine.ID, &medicine.Medicine.Name, &medicine.Medicine.Count, &medicine.Medicine.Image, &medicine.Medicine.IsTablets,
+		&medicine.Medicine.Form, &medicine.Medicine.Strength, &medicine.Medicine.Unit, &medicine.Medicine.MinCount, &medicine.Medicine.ExpiryDate,
+		&medicine.Medicine.IDKit, &medicine.Medicine.KitName, &medicine.Medicine.DisposeReason, &medicine.Medicine.DisposedDate,
+		&medicine.Medicine.Lot, &medicine.Medicine.KitLocation)
+	if err != nil {
+		return nil, err
+	}
+
+	return medicine, nil
+}
+
+// queryMedicines выполняет выборку, начинающуюся с medicineColumns, и дополняет лекарства ссылками на картинки и действующими веществами
+func (s Storage) queryMedicines(sqlScript string, args ...interface{}) ([]*proto.GetMedicineData, error) {
+	rows, err := s.db.Query(sqlScript, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.readMedicines(rows)
+}
+
+// readMedicines читает лекарства из выборки с колонками medicineColumns и закрывает её
+func (s Storage) readMedicines(rows *sql.Rows) ([]*proto.GetMedicineData, error) {
+	defer rows.Close()
+
+	medicines := make([]*proto.GetMedicineData, 0)
+	for rows.Next() {
+		medicine, err := scanMedicine(rows)
+		if err != nil {
+			return nil, err
+		}
+		medicine.Medicine.Image, err = images.GenerateFileURL(medicine.Medicine.Image, constants.MedicinesObjectsBucketName)
+		if err != nil {
+			return nil, err
+		}
+		medicines = append(medicines, medicine)
+	}
+
+	if err := s.fillIngredients(medicines); err != nil {
+		return nil, err
+	}
+
+	return medicines, nil
+}
+
+var medicineSortKeys = map[string][]sortKey{
+	constants.SortName:   {{"medicine.name", "text"}, {"medicine.id", "int"}},
+	constants.SortExpiry: {{"COALESCE(medicine.expires, 'infinity'::date)", "date"}, {"medicine.id", "int"}},
+	constants.SortCount:  {{"COALESCE(medicine.count, 0)", "int"}, {"medicine.id", "int"}},
+	constants.SortID:     {{"medicine.id", "int"}},
+}
+
+func medicineCursor(sort string, medicine *proto.GetMedicineData) string {
+	id := strconv.FormatInt(medicine.ID, 10)
+	switch sort {
+	case constants.SortExpiry:
+		expires := medicine.Medicine.ExpiryDate
+		if len(expires) == 0 {
+			expires = "infinity"
+		}
+		return pagination.Encode(expires, id)
+	case constants.SortCount:
+		return pagination.Encode(strconv.FormatInt(medicine.Medicine.Count, 10), id)
+	case constants.SortID:
+		return pagination.Encode(id)
+	default:
+		return pagination.Encode(medicine.Medicine.Name, id)
+	}
+}
+
+// GetMedicine возвращает невыбывшие лекарства пользователя с учётом фильтров и постраничного вывода
+func (s Storage) GetMedicine(userID int64, filter *proto.MedicineFilter) ([]*proto.GetMedicineData, string, error) {
+	sqlScript := "SELECT " + medicineColumns + " FROM medicine"
+
+	return s.pageMedicines(sqlScript, []string{"medicine.id_user = $1"}, []interface{}{userID}, filter)
+}
+
+func (s Storage) GetMedicineFamily(familyID int64, filter *proto.MedicineFilter) ([]*proto.GetMedicineData, string, error) {
+	sqlScript := "SELECT " + medicineColumns + " FROM medicine JOIN users u ON u.id_family = $1 AND medicine.id_user = u.id"
+
+	return s.pageMedicines(sqlScript, make([]string, 0), []interface{}{familyID}, filter)
+}
+
+func (s Storage) pageMedicines(sqlScript string, conditions []string, args []interface{}, filter *proto.MedicineFilter) ([]*proto.GetMedicineData, string, error) {
+	conditions = append(conditions, "medicine.disposed IS NULL")
+	if filter.IDKit != 0 {
+		args = append(args, filter.IDKit)
+		conditions = append(conditions, "medicine.id_kit = $"+strconv.Itoa(len(args)))
+	}
+	if filter.IDOwner != 0 {
+		args = append(args, filter.IDOwner)
+		conditions = append(conditions, "medicine.id_user = $"+strconv.Itoa(len(args)))
+	}
+	if len(filter.ExpiresBefore) != 0 {
+		args = append(args, filter.ExpiresBefore)
+		conditions = append(conditions, "medicine.expires < $"+strconv.Itoa(len(args))+"::date")
+	}
+
+	page := pageOf(filter.Page, medicineSortKeys, constants.SortName)
+	sqlScript, args, err := paginate(sqlScript, conditions, args, medicineSortKeys[page.Sort], page)
+	if err != nil {
+		return nil, "", err
+	}
+
+	medicines, err := s.queryMedicines(sqlScript, args...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if page.Limit <= 0 || int64(len(medicines)) <= page.Limit {
+		return medicines, "", nil
+	}
+
+	medicines = medicines[:page.Limit]
+	return medicines, medicineCursor(page.Sort, medicines[len(medicines)-1]), nil
+}
+
+// searchMatch отбирает лекарства, у которых с запросом $3 совпадает название или действующее вещество:
+// полнотекстово с учётом русской морфологии или по сходству триграмм, чтобы находить слова с опечатками.
+// Оператор <% использует триграммные индексы, его порог задаётся в SearchMedicine
+const searchMatch = "(to_tsvector('russian', medicine.name) @@ plainto_tsquery('russian', $3) " +
+	"OR $3 <% medicine.name " +
+	"OR EXISTS (SELECT 1 FROM medicine_ingredients WHERE medicine_ingredients.id_medicine = medicine.id " +
+	"AND (to_tsvector('russian', medicine_ingredients.name) @@ plainto_tsquery('russian', $3) OR $3 <% medicine_ingredients.name)))"
+
+const searchRank = "ts_rank(to_tsvector('russian', medicine.name), plainto_tsquery('russian', $3)) + word_similarity($3, medicine.name) + " +
+	"COALESCE((SELECT MAX(word_similarity($3, medicine_ingredients.name)) FROM medicine_ingredients WHERE medicine_ingredients.id_medicine = medicine.id), 0)"
+
+var searchOrders = map[string]string{
+	constants.SortRelevance: searchRank + " DESC, medicine.id",
+	constants.SortName:      "lower(medicine.name), medicine.id",
+	constants.SortExpiry:    "medicine.expires NULLS LAST, medicine.id",
+	constants.SortCount:     "medicine.count DESC NULLS LAST, medicine.id",
+}
+
+// SearchMedicine ищет среди невыбывших лекарств пользователя или его семьи
+func (s Storage) SearchMedicine(userID, familyID int64, text, sort string, limit, offset int64) ([]*proto.GetMedicineData, error) {
+	order, ok := searchOrders[sort]
+	if !ok {
+		return nil, constants.ErrWrongSort
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// порог сходства для <% действует только до конца транзакции
+	sqlScript := "SELECT set_config('pg_trgm.word_similarity_threshold', $1, true)"
+
+	_, err = tx.Exec(sqlScript, strconv.FormatFloat(constants.SearchSimilarity, 'f', -1, 64))
+	if err != nil {
+		return nil, err
+	}
+
+	sqlScript = "SELECT " + medicineColumns + " FROM medicine " +
+		"WHERE (medicine.id_user = $1 OR ($2 <> 0 AND medicine.id_user IN (SELECT id FROM users WHERE id_family = $2))) " +
+		"AND medicine.disposed IS NULL AND " + searchMatch + " ORDER BY " + order + " LIMIT $4 OFFSET $5"
+
+	rows, err := tx.Query(sqlScript, userID, familyID, text, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	medicines, err := s.readMedicines(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return medicines, tx.Commit()
+}
+
+// GetTrash возвращает лекарства пользователя или его семьи, выбывшие не раньше чем days дней назад
+func (s Storage) GetTrash(userID, familyID int64, days int) ([]*proto.GetMedicineData, error) {
+	sqlScript := "SELECT " + medicineColumns + " FROM medicine " +
+		"WHERE (medicine.id_user = $1 OR ($2 <> 0 AND medicine.id_user IN (SELECT id FROM users WHERE id_family = $2))) " +
+		"AND medicine.disposed >= current_date - $3::int ORDER BY medicine.disposed DESC"
+
+	return s.queryMedicines(sqlScript, userID, familyID, days)
+}
+
+// DisposeMedicine убирает лекарство из аптечки, сохраняя его журнал остатков и историю приёма
+func (s Storage) DisposeMedicine(idMedicine int64, reason, date string) error {
+	sqlScript := "UPDATE medicine SET disposed = $2::date, dispose_reason = $3 WHERE id = $1"
+
+	_, err := s.db.Exec(sqlScript, idMedicine, date, reason)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// RestoreMedicine возвращает выбывшее лекарство в аптечку, если с выбытия прошло не больше days дней
+func (s Storage) RestoreMedicine(idMedicine int64, days int) (bool, error) {
+	sqlScript := "UPDATE medicine SET disposed = NULL, dispose_reason = NULL WHERE id = $1 AND disposed >= current_date - $2::int"
+
+	result, err := s.db.Exec(sqlScript, idMedicine, days)
+	if err != nil {
+		return false, err
+	}
+
+	restored, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return restored != 0, nil
+}
+
+func (s Storage) GetKitMedicines(idKit int64) ([]*proto.GetMedicineData, error) {
+	sqlScript := "SELECT " + medicineColumns + " FROM medicine WHERE id_kit = $1 AND disposed IS NULL"
+
+	return s.queryMedicines(sqlScript, idKit)
+}
+
+func (s Storage) SetMedicineKit(idMedicine, idKit int64) error {
+	sqlScript := "UPDATE medicine SET id_kit = NULLIF($2, 0) WHERE id = $1"
+
+	_, err := s.db.Exec(sqlScript, idMedicine, idKit)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// TransferStock одной транзакцией перекладывает count единиц из лекарства from в лекарство to.
+// Если в from осталось меньше, перекладывается весь остаток
+func (s Storage) TransferStock(from, to, userID, count int64) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// блокируем оба лекарства в одном порядке, чтобы встречные перемещения не ждали друг друга
+	sqlScript := "SELECT id, COALESCE(count, 0) FROM medicine WHERE id IN ($1, $2) ORDER BY id FOR UPDATE"
+
+	rows, err := tx.Query(sqlScript, from, to)
+	if err != nil {
+		return err
+	}
+	var left int64
+	for rows.Next() {
+		var id, medicineCount int64
+		if err = rows.Scan(&id, &medicineCount); err != nil {
+			rows.Close()
+			return err
+		}
+		if id == from {
+			left = medicineCount
+		}
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	if count > left {
+		count = left
+	}
+
+	err = changeStock(tx, from, userID, -count, stock.ReasonTransfer)
+	if err != nil {
+		return err
+	}
+
+	err = changeStock(tx, to, userID, count, stock.ReasonTransfer)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(lowStockItem, from, constants.ShoppingLowStock)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// AddBatch добавляет новую упаковку лекарства idMedicine с остатком count. Срок годности и серия
+// у новой упаковки свои, поэтому не копируются; пополнение записывается в журнал
+func (s Storage) AddBatch(idMedicine, userID, count int64) (int64, error) {
+	sqlScript := "SELECT id_user, image FROM medicine WHERE id = $1"
+
+	var owner int64
+	var image string
+	err := s.db.QueryRow(sqlScript, idMedicine).Scan(&owner, &image)
+	if err != nil {
+		return 0, err
+	}
+
+	if image != constants.DefaultMedicine {
+		image, err = s.CopyFile(image, constants.MedicinesObjectsBucketName, owner)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	sqlScript = "INSERT INTO medicine(id_user, id_kit, name, count, image, is_tablets, form, strength, unit, min_count) " +
+		"SELECT id_user, id_kit, name, $2, $3, is_tablets, form, strength, unit, min_count FROM medicine WHERE id = $1 RETURNING id"
+
+	var batchID int64
+	err = tx.QueryRow(sqlScript, idMedicine, count, image).Scan(&batchID)
+	if err != nil {
+		return 0, err
+	}
+
+	sqlScript = "INSERT INTO medicine_ingredients(id_medicine, name) SELECT $2, name FROM medicine_ingredients WHERE id_medicine = $1 ORDER BY id"
+
+	_, err = tx.Exec(sqlScript, idMedicine, batchID)
+	if err != nil {
+		return 0, err
+	}
+
+	if count != 0 {
+		sqlScript = "INSERT INTO medicine_ledger(id_medicine, id_user, delta, reason) VALUES($1, $2, $3, $4)"
+
+		_, err = tx.Exec(sqlScript, batchID, userID, count, stock.ReasonRestock)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return batchID, tx.Commit()
+}
+
+// SplitMedicine возвращает лекарство в аптечке idKit, в которое можно переложить часть упаковки idMedicine:
+// такое же лекарство с тем же сроком годности, а если его нет — копию с нулевым остатком
+func (s Storage) SplitMedicine(idMedicine, idKit int64) (int64, error) {
+	sqlScript := "SELECT target.id FROM medicine source JOIN medicine target ON target.id_user = source.id_user " +
+		"AND target.name = source.name AND COALESCE(target.form, '') = COALESCE(source.form, '') " +
+		"AND COALESCE(target.strength, '') = COALESCE(source.strength, '') AND COALESCE(target.unit, '') = COALESCE(source.unit, '') " +
+		"AND target.expires IS NOT DISTINCT FROM source.expires AND target.lot IS NOT DISTINCT FROM source.lot " +
+		"WHERE source.id = $1 AND target.id <> source.id AND COALESCE(target.id_kit, 0) = $2 AND target.disposed IS NULL LIMIT 1"
+
+	var targetID int64
+	err := s.db.QueryRow(sqlScript, idMedicine, idKit).Scan(&targetID)
+	if err == nil {
+		return targetID, nil
+	}
+	if err != sql.ErrNoRows {
+		return 0, err
+	}
+
+	sqlScript = "SELECT id_user, image FROM medicine WHERE id = $1"
+
+	var owner int64
+	var image string
+	err = s.db.QueryRow(sqlScript, idMedicine).Scan(&owner, &image)
+	if err != nil {
+		return 0, err
+	}
+
+	// у копии своя картинка, чтобы удаление одного лекарства не удалило картинку другого
+	if image != constants.DefaultMedicine {
+		image, err = s.CopyFile(image, constants.MedicinesObjectsBucketName, owner)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	sqlScript = "INSERT INTO medicine(id_user, id_kit, name, lot, count, image, is_tablets, form, strength, unit, min_count, expires) " +
+		"SELECT id_user, NULLIF($2, 0), name, lot, 0, $3, is_tablets, form, strength, unit, 0, expires FROM medicine WHERE id = $1 RETURNING id"
+
+	err = s.db.QueryRow(sqlScript, idMedicine, idKit, image).Scan(&targetID)
+	if err != nil {
+		return 0, err
+	}
+
+	sqlScript = "INSERT INTO medicine_ingredients(id_medicine, name) SELECT $2, name FROM medicine_ingredients WHERE id_medicine = $1 ORDER BY id"
+
+	_, err = s.db.Exec(sqlScript, idMedicine, targetID)
+	if err != nil {
+		return 0, err
+	}
+
+	return targetID, nil
+}
+
+func (s Storage) GetMedicineByID(medicineID int64) (*proto.GetMedicineData, error) {
+	sqlScript := "SELECT " + medicineColumns + " FROM medicine WHERE id=$1"
+
+	medicine, err := scanMedicine(s.db.QueryRow(sqlScript, medicineID))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.fillIngredients([]*proto.GetMedicineData{medicine}); err != nil {
+		return nil, err
+	}
+
+	return medicine, nil
+}
+
+func (s Storage) EditMedicine(data *proto.GetMedicineData) (string, error) {
+	sqlScript := "SELECT name, count, image, is_tablets, COALESCE(form, ''), COALESCE(strength, ''), COALESCE(unit, ''), " +
+		"COALESCE(to_char(expires, 'YYYY-MM-DD'), ''), COALESCE(lot, '') FROM medicine WHERE id=$1"
+
+	var oldName, oldImage, oldForm, oldStrength, oldUnit, oldExpires, oldLot string
+	var oldCount int64
+	var oldIsTablets bool
+	err := s.db.QueryRow(sqlScript, data.ID).Scan(&oldName, &oldCount, &oldImage, &oldIsTablets, &oldForm, &oldStrength, &oldUnit, &oldExpires, &oldLot)
+	if err != nil {
+		return "", err
+	}
+
+	image := oldImage
+
+	if data.Medicine.Name != oldName && len(data.Medicine.Name) != 0 {
+		oldName = data.Medicine.Name
+	}
+
+	if data.Medicine.Image != oldImage && len(data.Medicine.Image) != 0 && data.Medicine.Image != constants.DefaultMedicine {
+		oldImage = data.Medicine.Image
+	}
+
+	if len(data.Medicine.Form) != 0 {
+		oldForm = data.Medicine.Form
+	}
+
+	if len(data.Medicine.Strength) != 0 {
+		oldStrength = data.Medicine.Strength
+	}
+
+	if len(data.Medicine.Unit) != 0 {
+		oldUnit = data.Medicine.Unit
+		oldIsTablets = oldUnit == dosage.UnitPieces
+	}
+
+	if len(data.Medicine.ExpiryDate) != 0 {
+		oldExpires = data.Medicine.ExpiryDate
+	}
+
+	if len(data.Medicine.Lot) != 0 {
+		oldLot = data.Medicine.Lot
+	}
+
+	if data.Medicine.Count != oldCount && data.Medicine.Count != -1 {
+		if !oldIsTablets && !dosage.Countable(oldUnit) {
+			return "", errors.New("cant change count for medicine without unit")
+		}
+	}
+
+	sqlScript = "UPDATE medicine SET name = $2, image = $3, is_tablets = $4, form = $5, strength = $6, unit = $7, " +
+		"expires = NULLIF($8, '')::date, lot = NULLIF($9, '') WHERE id = $1"
+
+	_, err = s.db.Exec(sqlScript, data.ID, oldName, oldImage, oldIsTablets, oldForm, oldStrength, oldUnit, oldExpires, oldLot)
+	if err != nil {
+		return "", err
+	}
+
+	// ручное исправление количества проходит через журнал как корректировка
+	if data.Medicine.Count != oldCount && data.Medicine.Count != -1 {
+		err = s.ChangeStock(data.ID, data.UserID, data.Medicine.Count-oldCount, stock.ReasonCorrection)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if data.Medicine.IngredientsSet {
+		if err = setMedicineIngredients(s.db, data.ID, data.Medicine.Ingredients); err != nil {
+			return "", err
+		}
+	}
+
+	return image, nil
+}
+
+func (s Storage) AddNotification(data *proto.NotificationData) error {
+	sqlScript := "INSERT INTO notification_user(id_from, to_is_user, id_to_user, name_to, id_medicine, name_medicine, time, is_accepted) VALUES($1, $2, $3, $4, $5, $6, $7, false)"
+
+	if _, err := s.db.Exec(sqlScript, data.IDFrom, data.IsUser, data.IDTo, data.NameTo, data.IDMedicine, data.NameMedicine, data.Time); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s Storage) DeleteNotification(data *proto.DeleteNotificationData) error {
+	sqlScript := "DELETE FROM notification_user WHERE id=$1"
+
+	_, err := s.db.Exec(sqlScript, data.NotificationID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// recipientZone — часовой пояс получателя напоминания; у члена семьи без аккаунта
+// это пояс главы семьи, добавившего его
+const recipientZone = "COALESCE(CASE WHEN notification_user.to_is_user " +
+	"THEN (SELECT users.time_zone FROM users WHERE users.id = notification_user.id_to_user) " +
+	"ELSE (SELECT users.time_zone FROM members JOIN users ON users.id = members.id_main_user WHERE members.id = notification_user.id_to_user) END, 'UTC')"
+
+// notificationColumns — поля напоминания, которые читает queryNotifications
+const notificationColumns = "notification_user.id, notification_user.to_is_user, notification_user.id_to_user, u.name, notification_user.id_medicine, " +
+	"medicine.name, medicine.is_tablets, notification_user.time, " + recipientZone + ", notification_user.is_accepted"
+
+var notificationSortKeys = map[string][]sortKey{
+	constants.SortTime:     {{"notification_user.time", "timestamptz"}, {"notification_user.id", "int"}},
+	constants.SortMedicine: {{"medicine.name", "text"}, {"notification_user.id", "int"}},
+}
+
+func (s Storage) GetNotifications(userID int64, filter *proto.NotificationFilter) ([]*proto.GetNotificationData, string, error) {
+	sqlScript := "SELECT " + notificationColumns + " FROM notification_user JOIN medicine ON medicine.id = id_medicine JOIN users u ON notification_user.id_to_user = u.id AND id_to_user = $1"
+
+	return s.queryNotifications(sqlScript, []interface{}{userID}, filter)
+}
+
+func (s Storage) GetNotificationsFamily(familyID int64, filter *proto.NotificationFilter) ([]*proto.GetNotificationData, string, error) {
+	sqlScript := "SELECT " + notificationColumns + " " +
+		"FROM notification_user JOIN medicine ON medicine.id = id_medicine JOIN users u ON u.id_family = $1 AND notification_user.id_to_user = u.id"
+
+	return s.queryNotifications(sqlScript, []interface{}{familyID}, filter)
+}
+
+// queryNotifications дополняет выборку, начинающуюся с notificationColumns, фильтрами и постраничным выводом
+func (s Storage) queryNotifications(sqlScript string, args []interface{}, filter *proto.NotificationFilter) ([]*proto.GetNotificationData, string, error) {
+	conditions := make([]string, 0)
+	if filter.IDPerson != 0 {
+		args = append(args, filter.IsUser, filter.IDPerson)
+		conditions = append(conditions, "notification_user.to_is_user = $"+strconv.Itoa(len(args)-1)+
+			" AND notification_user.id_to_user = $"+strconv.Itoa(len(args)))
+	}
+	if filter.IDMedicine != 0 {
+		args = append(args, filter.IDMedicine)
+		conditions = append(conditions, "notification_user.id_medicine = $"+strconv.Itoa(len(args)))
+	}
+	if len(filter.From) != 0 {
+		args = append(args, filter.From)
+		conditions = append(conditions, "notification_user.time >= ($"+strconv.Itoa(len(args))+"::date::timestamp AT TIME ZONE "+recipientZone+")")
+	}
+	if len(filter.To) != 0 {
+		args = append(args, filter.To)
+		conditions = append(conditions, "notification_user.time < (($"+strconv.Itoa(len(args))+"::date + 1)::timestamp AT TIME ZONE "+recipientZone+")")
+	}
+	switch filter.Accepted {
+	case constants.NotificationsAccepted:
+		conditions = append(conditions, "notification_user.is_accepted = true")
+	case constants.NotificationsPending:
+		conditions = append(conditions, "notification_user.is_accepted = false")
+	}
+
+	page := pageOf(filter.Page, notificationSortKeys, constants.SortTime)
+	sqlScript, args, err := paginate(sqlScript, conditions, args, notificationSortKeys[page.Sort], page)
+	if err != nil {
+		return nil, "", err
+	}
+
+	rows, err := s.db.Query(sqlScript, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	notifications := make([]*proto.GetNotificationData, 0)
+	for rows.Next() {
+		var notification proto.GetNotificationData
+		notification.NotificationData = &proto.NotificationData{
+			IsUser:       false,
+			IDTo:         0,
+			NameTo:       "",
+			IDMedicine:   0,
+			NameMedicine: "",
+			IsTablets:    false,
+			Time:         "",
+			IsAccepted:   false,
+		}
+		var at time.Time
+		var zone string
+		if err = rows.Scan(&notification.ID, &notification.NotificationData.IsUser, &notification.NotificationData.IDTo, &notification.NotificationData.NameTo, &notification.NotificationData.IDMedicine, &notification.NotificationData.NameMedicine, &notification.NotificationData.IsTablets, &at, &zone, &notification.NotificationData.IsAccepted); err != nil {
+			return nil, "", err
+		}
+		notification.NotificationData.Time = schedule.In(at, zone).Format(time.RFC3339)
+
+		notifications = append(notifications, &notification)
+	}
+
+	if page.Limit <= 0 || int64(len(notifications)) <= page.Limit {
+		return notifications, "", nil
+	}
+
+	notifications = notifications[:page.Limit]
+	last := notifications[len(notifications)-1]
+	id := strconv.FormatInt(last.ID, 10)
+	if page.Sort == constants.SortMedicine {
+		return notifications, pagination.Encode(last.NotificationData.NameMedicine, id), nil
+	}
+	return notifications, pagination.Encode(last.NotificationData.Time, id), nil
+}
+
+func (s Storage) AcceptNotification(data *proto.Accept) (int64, error) {
+	sqlScript := "UPDATE notification_user SET is_accepted = true, count = NULLIF($2, 0) WHERE id = $1 RETURNING id_medicine"
+
+	var medicineID int64
+	if err := s.db.QueryRow(sqlScript, data.ID, data.Count).Scan(&medicineID); err != nil {
+		return 0, err
+	}
+
+	return medicineID, nil
+}
+
+// Substruct списывает count единиц учёта лекарства (штук, мл или г), не уходя в минус
+func (s Storage) Substruct(idMedicine, userID, count int64) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = substruct(tx, idMedicine, userID, count)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func substruct(tx *sql.Tx, idMedicine, userID, count int64) error {
+	sqlScript := "SELECT COALESCE(is_tablets, false), COALESCE(unit, '') FROM medicine WHERE id = $1"
+
+	var isTablets bool
+	var unit string
+	err := tx.QueryRow(sqlScript, idMedicine).Scan(&isTablets, &unit)
+	if err != nil {
+		return err
+	}
+
+	// для старых лекарств без единицы учёта остаток не ведётся
+	if !isTablets && !dosage.Countable(unit) {
+		return nil
+	}
+
+	return changeStock(tx, idMedicine, userID, -count, stock.ReasonIntake)
+}
+
+// ChangeStock изменяет остаток лекарства на delta и записывает изменение в журнал.
+// Если остаток разошёлся с суммой журнала (лекарство добавлено до появления журнала),
+// перед изменением записывается корректировка на разницу, так что после записи
+// остаток всегда равен сумме журнала
+func (s Storage) ChangeStock(idMedicine, userID, delta int64, reason string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = changeStock(tx, idMedicine, userID, delta, reason)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func changeStock(tx *sql.Tx, idMedicine, userID, delta int64, reason string) error {
+	sqlScript := "SELECT COALESCE(count, 0) FROM medicine WHERE id = $1 FOR UPDATE"
+
+	var count int64
+	err := tx.QueryRow(sqlScript, idMedicine).Scan(&count)
+	if err != nil {
+		return err
+	}
+
+	sqlScript = "SELECT COALESCE(SUM(delta), 0) FROM medicine_ledger WHERE id_medicine = $1"
+
+	var balance int64
+	err = tx.QueryRow(sqlScript, idMedicine).Scan(&balance)
+	if err != nil {
+		return err
+	}
+
+	if balance != count {
+		sqlScript = "INSERT INTO medicine_ledger(id_medicine, delta, reason) VALUES($1, $2, $3)"
+
+		_, err = tx.Exec(sqlScript, idMedicine, count-balance, stock.ReasonCorrection)
+		if err != nil {
+			return err
+		}
+	}
+
+	newCount := count + delta
+	if newCount < 0 {
+		newCount = 0
+	}
+
+	if newCount != count {
+		sqlScript = "INSERT INTO medicine_ledger(id_medicine, id_user, delta, reason) VALUES($1, $2, $3, $4)"
+
+		_, err = tx.Exec(sqlScript, idMedicine, userID, newCount-count, reason)
+		if err != nil {
+			return err
+		}
+
+		sqlScript = "UPDATE medicine SET count = $2 WHERE id = $1"
+		if newCount > count {
+			// после пополнения о нехватке можно предупредить снова
+			sqlScript = "UPDATE medicine SET count = $2, stock_alerted = false WHERE id = $1"
+		}
+
+		_, err = tx.Exec(sqlScript, idMedicine, newCount)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s Storage) SetMinCount(idMedicine, minCount int64) error {
+	sqlScript := "UPDATE medicine SET min_count = $2, stock_alerted = false WHERE id = $1"
+
+	_, err := s.db.Exec(sqlScript, idMedicine, minCount)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSchedules возвращает время будущих непринятых приёмов для каждого из лекарств
+func (s Storage) GetSchedules(medicineIDs []int64) (map[int64][]time.Time, error) {
+	schedules := make(map[int64][]time.Time)
+	if len(medicineIDs) == 0 {
+		return schedules, nil
+	}
+
+	args := make([]interface{}, 0, len(medicineIDs))
+	for _, id := range medicineIDs {
+		args = append(args, id)
+	}
+
+	sqlScript := "SELECT id_medicine, time FROM notification_user " +
+		"WHERE is_accepted = false AND time >= now() AND id_medicine IN (" + inList(len(args)) + ") " +
+		"ORDER BY time"
+
+	rows, err := s.db.Query(sqlScript, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var medicineID int64
+		var at time.Time
+		if err = rows.Scan(&medicineID, &at); err != nil {
+			return nil, err
+		}
+		schedules[medicineID] = append(schedules[medicineID], at)
+	}
+
+	return schedules, nil
+}
+
+// GetPersonSchedules возвращает время будущих непринятых приёмов человека по каждому лекарству
+func (s Storage) GetPersonSchedules(isUser bool, idPerson int64) (map[int64][]time.Time, error) {
+	sqlScript := "SELECT id_medicine, time FROM notification_user " +
+		"WHERE to_is_user = $1 AND id_to_user = $2 AND is_accepted = false AND time >= now() ORDER BY time"
+
+	rows, err := s.db.Query(sqlScript, isUser, idPerson)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	schedules := make(map[int64][]time.Time)
+	for rows.Next() {
+		var medicineID int64
+		var at time.Time
+		if err = rows.Scan(&medicineID, &at); err != nil {
+			return nil, err
+		}
+		schedules[medicineID] = append(schedules[medicineID], at)
+	}
+
+	return schedules, nil
+}
+
+// GetPersonAverageDoses возвращает среднее количество, списанное за один отмеченный приём человека, по каждому лекарству
+func (s Storage) GetPersonAverageDoses(isUser bool, idPerson int64) (map[int64]float64, error) {
+	sqlScript := "SELECT id_medicine, AVG(count)::float8 FROM (" +
+		"SELECT id_medicine, count FROM dose_log WHERE to_is_user = $1 AND id_person = $2 AND is_taken " +
+		"UNION ALL " +
+		"SELECT id_medicine, count FROM notification_user WHERE to_is_user = $1 AND id_to_user = $2 AND is_accepted" +
+		") doses WHERE id_medicine IS NOT NULL AND count IS NOT NULL GROUP BY id_medicine"
+
+	rows, err := s.db.Query(sqlScript, isUser, idPerson)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	doses := make(map[int64]float64)
+	for rows.Next() {
+		var medicineID int64
+		var dose float64
+		if err = rows.Scan(&medicineID, &dose); err != nil {
+			return nil, err
+		}
+		doses[medicineID] = dose
+	}
+
+	return doses, nil
+}
+
+// GetAverageDoses возвращает среднее количество, списанное за один приём, по журналу остатков
+func (s Storage) GetAverageDoses(medicineIDs []int64) (map[int64]float64, error) {
+	doses := make(map[int64]float64)
+	if len(medicineIDs) == 0 {
+		return doses, nil
+	}
+
+	args := make([]interface{}, 0, len(medicineIDs)+1)
+	for _, id := range medicineIDs {
+		args = append(args, id)
+	}
+	args = append(args, stock.ReasonIntake)
+
+	sqlScript := "SELECT id_medicine, AVG(-delta)::float8 FROM medicine_ledger " +
+		"WHERE id_medicine IN (" + inList(len(medicineIDs)) + ") AND reason = $" + strconv.Itoa(len(args)) + " GROUP BY id_medicine"
+
+	rows, err := s.db.Query(sqlScript, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var medicineID int64
+		var dose float64
+		if err = rows.Scan(&medicineID, &dose); err != nil {
+			return nil, err
+		}
+		doses[medicineID] = dose
+	}
+
+	return doses, nil
+}
+
+func (s Storage) AddShoppingItem(data *proto.ShoppingItemData, reason string) error {
+	sqlScript := "INSERT INTO shopping_list(id_user, id_medicine, name, count, unit, reason) VALUES($1, NULLIF($2, 0), $3, $4, $5, $6)"
+
+	_, err := s.db.Exec(sqlScript, data.UserID, data.IDMedicine, data.Name, data.Count, data.Unit, reason)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// lowStockItem добавляет лекарство в список покупок, если остаток опустился до минимума и некупленной позиции для него ещё нет
+const lowStockItem = "INSERT INTO shopping_list(id_user, id_medicine, name, count, unit, reason) " +
+	"SELECT id_user, id, name, min_count, COALESCE(unit, ''), $2 FROM medicine " +
+	"WHERE id = $1 AND disposed IS NULL AND COALESCE(min_count, 0) > 0 AND count <= min_count " +
+	"AND NOT EXISTS (SELECT 1 FROM shopping_list WHERE id_medicine = $1 AND is_bought = false)"
+
+func (s Storage) AddLowStockItem(idMedicine int64) error {
+	_, err := s.db.Exec(lowStockItem, idMedicine, constants.ShoppingLowStock)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// shoppingColumns — поля позиции списка покупок, которые читает scanShoppingItem
+const shoppingColumns = "shopping_list.id, shopping_list.id_user, COALESCE(shopping_list.id_medicine, 0), shopping_list.name, shopping_list.count, " +
+	"COALESCE(shopping_list.unit, ''), shopping_list.reason, shopping_list.is_bought, COALESCE(shopping_list.id_bought_by, 0), shopping_list.created"
+
+func scanShoppingItem(row rowScanner) (*proto.ShoppingItem, error) {
+	item := &proto.ShoppingItem{}
+	var created time.Time
+	err := row.Scan(&item.ID, &item.IDUser, &item.IDMedicine, &item.Name, &item.Count, &item.Unit, &item.Reason, &item.IsBought, &item.IDBoughtBy, &created)
+	if err != nil {
+		return nil, err
+	}
+	item.Created = created.Format(time.RFC3339)
+
+	return item, nil
+}
+
+func (s Storage) queryShoppingItems(sqlScript string, args ...interface{}) ([]*proto.ShoppingItem, error) {
+	rows, err := s.db.Query(sqlScript, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := make([]*proto.ShoppingItem, 0)
+	for rows.Next() {
+		item, err := scanShoppingItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (s Storage) GetShoppingList(userID int64) ([]*proto.ShoppingItem, error) {
+	sqlScript := "SELECT " + shoppingColumns + " FROM shopping_list WHERE id_user = $1 ORDER BY is_bought, created"
+
+	return s.queryShoppingItems(sqlScript, userID)
+}
+
+func (s Storage) GetShoppingListFamily(familyID int64) ([]*proto.ShoppingItem, error) {
+	sqlScript := "SELECT " + shoppingColumns + " FROM shopping_list JOIN users u ON u.id_family = $1 AND shopping_list.id_user = u.id " +
+		"ORDER BY shopping_list.is_bought, shopping_list.created"
+
+	return s.queryShoppingItems(sqlScript, familyID)
+}
+
+func (s Storage) GetShoppingItem(itemID int64) (*proto.ShoppingItem, error) {
+	sqlScript := "SELECT " + shoppingColumns + " FROM shopping_list WHERE id = $1"
+
+	return scanShoppingItem(s.db.QueryRow(sqlScript, itemID))
+}
+
+// AddMissingItem добавляет позицию в список покупок, если у владельца ещё нет некупленной позиции с тем же названием
+func (s Storage) AddMissingItem(data *proto.ShoppingItemData, reason string) error {
+	sqlScript := "INSERT INTO shopping_list(id_user, name, count, unit, reason) " +
+		"SELECT $1, $2, $3, $4, $5 WHERE NOT EXISTS " +
+		"(SELECT 1 FROM shopping_list WHERE id_user = $1 AND lower(name) = lower($2) AND is_bought = false)"
+
+	_, err := s.db.Exec(sqlScript, data.UserID, data.Name, data.Count, data.Unit, reason)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// CheckShoppingItem отмечает позицию купленной и сообщает, была ли она отмечена этим вызовом
+func (s Storage) CheckShoppingItem(itemID, userID int64) (bool, error) {
+	sqlScript := "UPDATE shopping_list SET is_bought = true, id_bought_by = $2 WHERE id = $1 AND is_bought = false"
+
+	result, err := s.db.Exec(sqlScript, itemID, userID)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected != 0, nil
+}
+
+func (s Storage) DeleteShoppingItem(itemID int64) error {
+	sqlScript := "DELETE FROM shopping_list WHERE id = $1"
+
+	_, err := s.db.Exec(sqlScript, itemID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s Storage) AddKit(userID int64, kit *proto.Kit) (int64, error) {
+	sqlScript := "INSERT INTO kits(id_user, name, location) VALUES($1, $2, $3) RETURNING id"
+
+	var kitID int64
+	err := s.db.QueryRow(sqlScript, userID, kit.Name, kit.Location).Scan(&kitID)
+	if err != nil {
+		return 0, err
+	}
+	return kitID, nil
+}
+
+func (s Storage) queryKits(sqlScript string, args ...interface{}) ([]*proto.Kit, error) {
+	rows, err := s.db.Query(sqlScript, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	kits := make([]*proto.Kit, 0)
+	for rows.Next() {
+		var kit proto.Kit
+		if err = rows.Scan(&kit.ID, &kit.IDUser, &kit.Name, &kit.Location); err != nil {
+			return nil, err
+		}
+		kits = append(kits, &kit)
+	}
+
+	return kits, nil
+}
+
+func (s Storage) GetKits(userID int64) ([]*proto.Kit, error) {
+	sqlScript := "SELECT id, id_user, name, COALESCE(location, '') FROM kits WHERE id_user = $1 ORDER BY id"
+
+	return s.queryKits(sqlScript, userID)
+}
+
+func (s Storage) GetKitsFamily(familyID int64) ([]*proto.Kit, error) {
+	sqlScript := "SELECT kits.id, kits.id_user, kits.name, COALESCE(kits.location, '') " +
+		"FROM kits JOIN users u ON u.id_family = $1 AND kits.id_user = u.id ORDER BY kits.id"
+
+	return s.queryKits(sqlScript, familyID)
+}
+
+func (s Storage) GetKitOwner(idKit int64) (int64, error) {
+	sqlScript := "SELECT id_user FROM kits WHERE id = $1"
+
+	var owner int64
+	err := s.db.QueryRow(sqlScript, idKit).Scan(&owner)
+	if err != nil {
+		return 0, err
+	}
+
+	return owner, nil
+}
+
+func (s Storage) EditKit(kit *proto.Kit) error {
+	sqlScript := "UPDATE kits SET name = COALESCE(NULLIF($2, ''), name), location = $3 WHERE id = $1"
+
+	_, err := s.db.Exec(sqlScript, kit.ID, kit.Name, kit.Location)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s Storage) DeleteKit(idKit int64) error {
+	sqlScript := "DELETE FROM kits WHERE id = $1"
+
+	_, err := s.db.Exec(sqlScript, idKit)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// ImportTemplates обновляет встроенные шаблоны по названию, так что их id не меняются между запусками
+func (s Storage) ImportTemplates(data []templates.Template) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, template := range data {
+		sqlScript := "INSERT INTO kit_templates(id_user, name) VALUES(NULL, $1) " +
+			"ON CONFLICT (name) WHERE id_user IS NULL DO UPDATE SET name = excluded.name RETURNING id"
+
+		var templateID int64
+		err = tx.QueryRow(sqlScript, template.Name).Scan(&templateID)
+		if err != nil {
+			return err
+		}
+
+		sqlScript = "DELETE FROM kit_template_items WHERE id_template = $1"
+
+		_, err = tx.Exec(sqlScript, templateID)
+		if err != nil {
+			return err
+		}
+
+		items := make([]*proto.TemplateItem, 0, len(template.Items))
+		for _, item := range template.Items {
+			items = append(items, &proto.TemplateItem{
+				Name:    item.Name,
+				Keyword: item.Keyword,
+				Count:   item.Count,
+				Unit:    item.Unit,
+			})
+		}
+
+		err = addTemplateItems(tx, templateID, items)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// AddTemplate сохраняет шаблон пользователя; userID == 0 — встроенный шаблон
+func (s Storage) AddTemplate(userID int64, template *proto.Template) error {
+	sqlScript := "INSERT INTO kit_templates(id_user, name) VALUES(NULLIF($1, 0), $2) RETURNING id"
+
+	var templateID int64
+	err := s.db.QueryRow(sqlScript, userID, template.Name).Scan(&templateID)
+	if err != nil {
+		return err
+	}
+
+	return addTemplateItems(s.db, templateID, template.Items)
+}
+
+func addTemplateItems(db execer, templateID int64, items []*proto.TemplateItem) error {
+	sqlScript := "INSERT INTO kit_template_items(id_template, name, keyword, count, unit) VALUES($1, $2, $3, $4, $5)"
+	for _, item := range items {
+		_, err := db.Exec(sqlScript, templateID, item.Name, item.Keyword, item.Count, item.Unit)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetTemplates возвращает встроенные шаблоны, шаблоны пользователя и его семьи
+func (s Storage) GetTemplates(userID, familyID int64) ([]*proto.Template, error) {
+	sqlScript := "SELECT id, COALESCE(id_user, 0), name FROM kit_templates " +
+		"WHERE id_user IS NULL OR id_user = $1 OR ($2 <> 0 AND id_user IN (SELECT id FROM users WHERE id_family = $2)) ORDER BY id"
+
+	rows, err := s.db.Query(sqlScript, userID, familyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]*proto.Template, 0)
+	for rows.Next() {
+		var template proto.Template
+		if err = rows.Scan(&template.ID, &template.IDUser, &template.Name); err != nil {
+			return nil, err
+		}
+		result = append(result, &template)
+	}
+
+	for _, template := range result {
+		template.Items, err = s.getTemplateItems(template.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (s Storage) GetTemplate(templateID int64) (*proto.Template, error) {
+	sqlScript := "SELECT id, COALESCE(id_user, 0), name FROM kit_templates WHERE id = $1"
+
+	template := &proto.Template{}
+	err := s.db.QueryRow(sqlScript, templateID).Scan(&template.ID, &template.IDUser, &template.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	template.Items, err = s.getTemplateItems(template.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func (s Storage) getTemplateItems(templateID int64) ([]*proto.TemplateItem, error) {
+	sqlScript := "SELECT name, keyword, count, COALESCE(unit, '') FROM kit_template_items WHERE id_template = $1 ORDER BY id"
+
+	rows, err := s.db.Query(sqlScript, templateID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := make([]*proto.TemplateItem, 0)
+	for rows.Next() {
+		var item proto.TemplateItem
+		if err = rows.Scan(&item.Name, &item.Keyword, &item.Count, &item.Unit); err != nil {
+			return nil, err
+		}
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func (s Storage) DeleteTemplate(templateID int64) error {
+	sqlScript := "DELETE FROM kit_templates WHERE id = $1"
+
+	_, err := s.db.Exec(sqlScript, templateID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// sortKey — выражение, по которому сортируется выборка, и тип, к которому приводится значение из курсора
+type sortKey struct {
+	expr string
+	cast string
+}
+
+// pageOf возвращает параметры страницы с известным ключом сортировки; без параметров — всю выборку в порядке по умолчанию
+func pageOf(page *proto.Page, keys map[string][]sortKey, defaultSort string) *proto.Page {
+	if page == nil {
+		page = &proto.Page{}
+	}
+	if _, ok := keys[page.Sort]; !ok {
+		page.Sort = defaultSort
+	}
+	return page
+}
+
+// paginate дописывает к запросу условия, продолжение после курсора, порядок и лимит.
+// Лимит на одну запись больше страницы, чтобы узнать, есть ли следующая;
+// последний ключ сортировки должен однозначно определять запись
+func paginate(sqlScript string, conditions []string, args []interface{}, keys []sortKey, page *proto.Page) (string, []interface{}, error) {
+	operator, direction := ">", ""
+	if page.Desc {
+		operator, direction = "<", " DESC"
+	}
+
+	exprs := make([]string, 0, len(keys))
+	order := make([]string, 0, len(keys))
+	for _, key := range keys {
+		exprs = append(exprs, key.expr)
+		order = append(order, key.expr+direction)
+	}
+
+	if len(page.Cursor) != 0 {
+		values, err := pagination.Decode(page.Cursor, len(keys))
+		if err != nil {
+			return "", nil, err
+		}
+
+		placeholders := make([]string, 0, len(keys))
+		for i, key := range keys {
+			if !validCursorValue(key.cast, values[i]) {
+				return "", nil, pagination.ErrWrongCursor
+			}
+			args = append(args, values[i])
+			placeholders = append(placeholders, "$"+strconv.Itoa(len(args))+"::"+key.cast)
+		}
+		conditions = append(conditions, "("+strings.Join(exprs, ", ")+") "+operator+" ("+strings.Join(placeholders, ", ")+")")
+	}
+
+	if len(conditions) != 0 {
+		sqlScript += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	sqlScript += " ORDER BY " + strings.Join(order, ", ")
+
+	if page.Limit > 0 {
+		args = append(args, page.Limit+1)
+		sqlScript += " LIMIT $" + strconv.Itoa(len(args))
+	}
+
+	return sqlScript, args, nil
+}
+
+// validCursorValue проверяет, что значение из курсора приводится к типу ключа сортировки,
+// чтобы подделанный курсор не доходил до ошибки приведения в базе
+func validCursorValue(cast, value string) bool {
+	var err error
+	switch cast {
+	case "int":
+		_, err = strconv.ParseInt(value, 10, 64)
+	case "bool":
+		_, err = strconv.ParseBool(value)
+	case "date":
+		if value != "infinity" {
+			_, err = time.Parse("2006-01-02", value)
+		}
+	case "timestamptz":
+		_, err = time.Parse(time.RFC3339, value)
+	case "text":
+		return !strings.ContainsRune(value, 0)
+	default:
+		return false
+	}
+	return err == nil
+}
+
+// inList возвращает список плейсхолдеров $1, $2, ... $count для условия IN
+func inList(count int) string {
+	placeholders := make([]string, 0, count)
+	for i := 1; i <= count; i++ {
+		placeholders = append(placeholders, "$"+strconv.Itoa(i))
+	}
+	return strings.Join(placeholders, ", ")
+}
+
+func (s Storage) GetStockHistory(idMedicine int64) ([]*proto.StockEntry, error) {
+	sqlScript := "SELECT medicine_ledger.id, COALESCE(medicine_ledger.id_user, 0), COALESCE(users.name, ''), medicine_ledger.delta, medicine_ledger.reason, medicine_ledger.created " +
+		"FROM medicine_ledger LEFT JOIN users ON users.id = medicine_ledger.id_user " +
+		"WHERE medicine_ledger.id_medicine = $1 ORDER BY medicine_ledger.created, medicine_ledger.id"
+
+	rows, err := s.db.Query(sqlScript, idMedicine)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]*proto.StockEntry, 0)
+	for rows.Next() {
+		var entry proto.StockEntry
+		var created time.Time
+		if err = rows.Scan(&entry.ID, &entry.IDUser, &entry.NameUser, &entry.Delta, &entry.Reason, &created); err != nil {
+			return nil, err
+		}
+		entry.Time = created.Format(time.RFC3339)
+		entries = append(entries, &entry)
+	}
+
+	return entries, nil
+}
+
+func (s Storage) GetMedicineOwner(idMedicine int64) (int64, error) {
+	sqlScript := "SELECT id_user FROM medicine WHERE id = $1"
+
+	var owner int64
+	err := s.db.QueryRow(sqlScript, idMedicine).Scan(&owner)
+	if err != nil {
+		return 0, err
+	}
+
+	return owner, nil
+}
+
+func (s Storage) GetActiveMedicines(isUser bool, idPerson int64) ([]*proto.GetMedicineData, error) {
+	sqlScript := "SELECT DISTINCT " + medicineColumns + " " +
+		"FROM notification_user JOIN medicine ON medicine.id = notification_user.id_medicine " +
+		"WHERE notification_user.to_is_user = $1 AND notification_user.id_to_user = $2 " +
+		"AND notification_user.is_accepted = false AND notification_user.time >= now() AND medicine.disposed IS NULL"
+
+	return s.queryMedicines(sqlScript, isUser, idPerson)
+}
+
+func (s Storage) ImportInteractions(data []interactions.Interaction) error {
+	sqlScript := "INSERT INTO interactions(ingredient_a, ingredient_b, severity, description) VALUES($1, $2, $3, $4) " +
+		"ON CONFLICT (ingredient_a, ingredient_b) DO UPDATE SET severity = $3, description = $4"
+
+	for _, interaction := range data {
+		_, err := s.db.Exec(sqlScript, interaction.IngredientA, interaction.IngredientB, interaction.Severity, interaction.Description)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s Storage) GetInteractions(ingredients []string) ([]interactions.Interaction, error) {
+	result := make([]interactions.Interaction, 0)
+	if len(ingredients) < 2 {
+		return result, nil
+	}
+
+	args := make([]interface{}, 0, len(ingredients))
+	for _, ingredient := range ingredients {
+		args = append(args, ingredient)
+	}
+	list := inList(len(args))
+
+	sqlScript := "SELECT ingredient_a, ingredient_b, severity, COALESCE(description, '') FROM interactions " +
+		"WHERE ingredient_a IN (" + list + ") AND ingredient_b IN (" + list + ")"
+
+	rows, err := s.db.Query(sqlScript, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var interaction interactions.Interaction
+		if err = rows.Scan(&interaction.IngredientA, &interaction.IngredientB, &interaction.Severity, &interaction.Description); err != nil {
+			return nil, err
+		}
+		result = append(result, interaction)
+	}
+
+	return result, nil
+}
+
+// SetCalendarToken выдаёт пользователю новый секретный токен подписки на календарь человека,
+// прежний токен для этого человека перестаёт действовать
+func (s Storage) SetCalendarToken(userID int64, isUser bool, idPerson int64) (string, error) {
+	token, err := uuid.NewV4()
+	if err != nil {
+		return "", err
+	}
+
+	sqlScript := "INSERT INTO calendar_tokens(id_user, is_user, id_person, token) VALUES($1, $2, $3, $4) " +
+		"ON CONFLICT (id_user, is_user, id_person) DO UPDATE SET token = excluded.token, created = now()"
+
+	if _, err = s.db.Exec(sqlScript, userID, isUser, idPerson, token.String()); err != nil {
+		return "", err
+	}
+
+	return token.String(), nil
+}
+
+func (s Storage) DeleteCalendarToken(userID int64, isUser bool, idPerson int64) (bool, error) {
+	sqlScript := "DELETE FROM calendar_tokens WHERE id_user = $1 AND is_user = $2 AND id_person = $3"
+
+	result, err := s.db.Exec(sqlScript, userID, isUser, idPerson)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected != 0, nil
+}
+
+// GetCalendarPerson возвращает владельца токена и человека, чей календарь он публикует
+func (s Storage) GetCalendarPerson(token string) (*proto.Person, error) {
+	sqlScript := "SELECT id_user, is_user, id_person FROM calendar_tokens WHERE token = $1"
+
+	person := &proto.Person{}
+	if err := s.db.QueryRow(sqlScript, token).Scan(&person.UserID, &person.IsUser, &person.IDPerson); err != nil {
+		return nil, err
+	}
+
+	return person, nil
+}
+
+func (s Storage) GetPersonName(isUser bool, idPerson int64) (string, error) {
+	sqlScript := "SELECT name FROM members WHERE id = $1"
+	if isUser {
+		sqlScript = "SELECT name FROM users WHERE id = $1"
+	}
+
+	var name string
+	if err := s.db.QueryRow(sqlScript, idPerson).Scan(&name); err != nil {
+		return "", err
+	}
+
+	return name, nil
+}
+
+// GetCalendarEvents возвращает непринятые приёмы человека начиная с days дней назад, время — в RFC 3339
+func (s Storage) GetCalendarEvents(isUser bool, idPerson int64, days int) ([]*proto.GetNotificationData, error) {
+	sqlScript := "SELECT notification_user.id, notification_user.id_medicine, medicine.name, medicine.is_tablets, notification_user.time " +
+		"FROM notification_user JOIN medicine ON medicine.id = notification_user.id_medicine " +
+		"WHERE notification_user.to_is_user = $1 AND notification_user.id_to_user = $2 AND notification_user.is_accepted = false " +
+		"AND notification_user.time >= now() - make_interval(days => $3) AND medicine.disposed IS NULL " +
+		"ORDER BY notification_user.time, notification_user.id"
+
+	rows, err := s.db.Query(sqlScript, isUser, idPerson, days)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := make([]*proto.GetNotificationData, 0)
+	for rows.Next() {
+		event := &proto.GetNotificationData{
+			NotificationData: &proto.NotificationData{
+				IsUser: isUser,
+				IDTo:   idPerson,
+			},
+		}
+		var at time.Time
+		if err = rows.Scan(&event.ID, &event.NotificationData.IDMedicine, &event.NotificationData.NameMedicine, &event.NotificationData.IsTablets, &at); err != nil {
+			return nil, err
+		}
+		event.NotificationData.Time = at.UTC().Format(time.RFC3339)
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// personMedicines — лекарства, которые человек принимает или принимал по напоминаниям
+const personMedicines = "(SELECT id_medicine FROM notification_user WHERE to_is_user = $1 AND id_to_user = $2 " +
+	"UNION SELECT id_medicine FROM dose_log WHERE to_is_user = $1 AND id_person = $2)"
+
+// GetDoses возвращает прошедшие приёмы человека в промежутке [from, to): и уже перенесённые
+// в dose_log, и ещё не удалённые из напоминаний
+func (s Storage) GetDoses(isUser bool, idPerson int64, from, to time.Time) ([]*proto.DoseEntry, error) {
+	sqlScript := "SELECT id, logged, id_medicine, name, time, is_taken FROM (" +
+		"SELECT dose_log.id, true AS logged, COALESCE(dose_log.id_medicine, 0) AS id_medicine, " +
+		"COALESCE(medicine.name, dose_log.name_medicine, '') AS name, dose_log.time, dose_log.is_taken " +
+		"FROM dose_log LEFT JOIN medicine ON medicine.id = dose_log.id_medicine " +
+		"WHERE dose_log.to_is_user = $1 AND dose_log.id_person = $2 " +
+		"UNION ALL " +
+		"SELECT notification_user.id, false, notification_user.id_medicine, medicine.name, notification_user.time, COALESCE(notification_user.is_accepted, false) " +
+		"FROM notification_user JOIN medicine ON medicine.id = notification_user.id_medicine " +
+		"WHERE notification_user.to_is_user = $1 AND notification_user.id_to_user = $2 AND notification_user.time < now()" +
+		") doses WHERE time >= $3 AND time < $4 ORDER BY time, name"
+
+	rows, err := s.db.Query(sqlScript, isUser, idPerson, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	doses := make([]*proto.DoseEntry, 0)
+	for rows.Next() {
+		var dose proto.DoseEntry
+		var at time.Time
+		if err = rows.Scan(&dose.ID, &dose.Logged, &dose.IDMedicine, &dose.NameMedicine, &at, &dose.Taken); err != nil {
+			return nil, err
+		}
+		dose.Time = at.Format(time.RFC3339)
+		doses = append(doses, &dose)
+	}
+
+	return doses, nil
+}
+
+// GetPersonStock возвращает записи журнала остатков за промежуток [from, to) по лекарствам, которые принимает человек
+func (s Storage) GetPersonStock(isUser bool, idPerson int64, from, to time.Time) ([]*proto.MedicineStockEntry, error) {
+	sqlScript := "SELECT medicine.name, COALESCE(medicine.unit, ''), medicine_ledger.id, COALESCE(medicine_ledger.id_user, 0), COALESCE(users.name, ''), " +
+		"medicine_ledger.delta, medicine_ledger.reason, medicine_ledger.created " +
+		"FROM medicine_ledger JOIN medicine ON medicine.id = medicine_ledger.id_medicine LEFT JOIN users ON users.id = medicine_ledger.id_user " +
+		"WHERE medicine_ledger.id_medicine IN " + personMedicines + " " +
+		"AND medicine_ledger.created >= $3 AND medicine_ledger.created < $4 " +
+		"ORDER BY medicine_ledger.created, medicine_ledger.id"
+
+	rows, err := s.db.Query(sqlScript, isUser, idPerson, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]*proto.MedicineStockEntry, 0)
+	for rows.Next() {
+		entry := &proto.MedicineStockEntry{Entry: &proto.StockEntry{}}
+		var created time.Time
+		if err = rows.Scan(&entry.NameMedicine, &entry.Unit, &entry.Entry.ID, &entry.Entry.IDUser, &entry.Entry.NameUser,
+			&entry.Entry.Delta, &entry.Entry.Reason, &created); err != nil {
+			return nil, err
+		}
+		entry.Entry.Time = created.Format(time.RFC3339)
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// GetTimeZone возвращает часовой пояс человека; член семьи без аккаунта живёт по поясу главы семьи
+func (s Storage) GetTimeZone(isUser bool, idPerson int64) (string, error) {
+	sqlScript := "SELECT users.time_zone FROM members JOIN users ON users.id = members.id_main_user WHERE members.id = $1"
+	if isUser {
+		sqlScript = "SELECT time_zone FROM users WHERE id = $1"
+	}
+
+	var zone string
+	if err := s.db.QueryRow(sqlScript, idPerson).Scan(&zone); err != nil {
+		return "", err
+	}
+
+	return zone, nil
+}
+
+// GetNotificationSettings возвращает настройки уведомлений; пока пользователь их не менял, действуют настройки по умолчанию
+func (s Storage) GetNotificationSettings(userID int64) (*proto.NotificationSettings, error) {
+	sqlScript := "SELECT channels, COALESCE(quiet_from, ''), COALESCE(quiet_to, ''), dependents FROM notification_settings WHERE id_user = $1"
+
+	defaults := preferences.Default()
+	settings := &proto.NotificationSettings{
+		UserID:     userID,
+		Channels:   defaults.Channels,
+		Dependents: defaults.Dependents,
+	}
+
+	var channels string
+	err := s.db.QueryRow(sqlScript, userID).Scan(&channels, &settings.QuietFrom, &settings.QuietTo, &settings.Dependents)
+	if err == sql.ErrNoRows {
+		return settings, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	settings.Channels = preferences.SplitChannels(channels)
+
+	return settings, nil
+}
+
+func (s Storage) EditNotificationSettings(settings *proto.NotificationSettings) error {
+	sqlScript := "INSERT INTO notification_settings(id_user, channels, quiet_from, quiet_to, dependents) VALUES($1, $2, NULLIF($3, ''), NULLIF($4, ''), $5) " +
+		"ON CONFLICT (id_user) DO UPDATE SET channels = excluded.channels, quiet_from = excluded.quiet_from, " +
+		"quiet_to = excluded.quiet_to, dependents = excluded.dependents"
+
+	_, err := s.db.Exec(sqlScript, settings.UserID, preferences.JoinChannels(settings.Channels), settings.QuietFrom, settings.QuietTo, settings.Dependents)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s Storage) AddWebhook(webhook *proto.Webhook) (*proto.Webhook, error) {
+	sqlScript := "INSERT INTO webhooks(id_user, url, secret) VALUES($1, $2, $3) RETURNING id, created"
+
+	var created time.Time
+	err := s.db.QueryRow(sqlScript, webhook.UserID, webhook.URL, webhook.Secret).Scan(&webhook.ID, &created)
+	if err != nil {
+		return nil, err
+	}
+	webhook.Created = created.Format(time.RFC3339)
+
+	return webhook, nil
+}
+
+// GetWebhooks возвращает вебхуки пользователя без секретов: секрет показывается только при добавлении
+func (s Storage) GetWebhooks(userID int64) ([]*proto.Webhook, error) {
+	sqlScript := "SELECT id, url, created FROM webhooks WHERE id_user = $1 ORDER BY id"
+
+	rows, err := s.db.Query(sqlScript, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	webhooks := make([]*proto.Webhook, 0)
+	for rows.Next() {
+		webhook := proto.Webhook{UserID: userID}
+		var created time.Time
+		if err = rows.Scan(&webhook.ID, &webhook.URL, &created); err != nil {
+			return nil, err
+		}
+		webhook.Created = created.Format(time.RFC3339)
+		webhooks = append(webhooks, &webhook)
+	}
+
+	return webhooks, nil
+}
+
+func (s Storage) DeleteWebhook(userID, webhookID int64) (bool, error) {
+	sqlScript := "DELETE FROM webhooks WHERE id = $1 AND id_user = $2"
+
+	result, err := s.db.Exec(sqlScript, webhookID, userID)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected != 0, nil
+}
+
+func (s Storage) SetTelegramCode(userID int64, code string, expires time.Time) error {
+	sqlScript := "INSERT INTO telegram_codes(id_user, code, expires) VALUES($1, $2, $3) " +
+		"ON CONFLICT (id_user) DO UPDATE SET code = excluded.code, expires = excluded.expires"
+
+	_, err := s.db.Exec(sqlScript, userID, code, expires)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// LinkTelegram погашает код привязки и связывает чат с его владельцем. Чат может быть привязан
+// только к одному аккаунту, поэтому прежняя привязка этого чата удаляется
+func (s Storage) LinkTelegram(code string, chatID int64) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	sqlScript := "DELETE FROM telegram_codes WHERE code = $1 AND expires > now() RETURNING id_user"
+
+	var userID int64
+	if err = tx.QueryRow(sqlScript, code).Scan(&userID); err != nil {
+		return err
+	}
+
+	sqlScript = "DELETE FROM telegram_chats WHERE chat_id = $1"
+	if _, err = tx.Exec(sqlScript, chatID); err != nil {
+		return err
+	}
+
+	sqlScript = "INSERT INTO telegram_chats(id_user, chat_id) VALUES($1, $2) " +
+		"ON CONFLICT (id_user) DO UPDATE SET chat_id = excluded.chat_id"
+	if _, err = tx.Exec(sqlScript, userID, chatID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s Storage) UnlinkTelegram(userID int64) (bool, error) {
+	sqlScript := "DELETE FROM telegram_chats WHERE id_user = $1"
+
+	result, err := s.db.Exec(sqlScript, userID)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected != 0, nil
+}
+
+func (s Storage) GetTelegramUser(chatID int64) (int64, error) {
+	sqlScript := "SELECT id_user FROM telegram_chats WHERE chat_id = $1"
+
+	var userID int64
+	if err := s.db.QueryRow(sqlScript, chatID).Scan(&userID); err != nil {
+		return 0, err
+	}
+	return userID, nil
+}
+
+// GetNotificationPerson возвращает, кому адресовано напоминание, о каком лекарстве оно и отмечен ли уже приём
+func (s Storage) GetNotificationPerson(idNotification int64) (*proto.Person, int64, bool, error) {
+	sqlScript := "SELECT COALESCE(to_is_user, true), COALESCE(id_to_user, 0), id_medicine, COALESCE(is_accepted, false) FROM notification_user WHERE id = $1"
+
+	person := &proto.Person{}
+	var medicineID int64
+	var accepted bool
+	err := s.db.QueryRow(sqlScript, idNotification).Scan(&person.IsUser, &person.IDPerson, &medicineID, &accepted)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	return person, medicineID, accepted, nil
+}
+
+// SkipNotification записывает в dose_changes отказ от приёма по напоминанию;
+// время приёма не меняется. Возвращает false, если приём уже отмечен
+func (s Storage) SkipNotification(idNotification, userID int64) (bool, error) {
+	sqlScript := "INSERT INTO dose_changes(id_notification, to_is_user, id_person, id_medicine, name_medicine, action, time_from, time_to, id_user) " +
+		"SELECT id, COALESCE(to_is_user, true), COALESCE(id_to_user, 0), id_medicine, name_medicine, $2, time, time, $3 " +
+		"FROM notification_user WHERE id = $1 AND COALESCE(is_accepted, false) = false"
+
+	result, err := s.db.Exec(sqlScript, idNotification, constants.DoseSkipped, userID)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected != 0, nil
+}
+
+// GetCourseEnd возвращает время последнего напоминания курса, к которому относится напоминание:
+// тому же человеку о том же лекарстве
+func (s Storage) GetCourseEnd(idNotification int64) (time.Time, error) {
+	sqlScript := "SELECT MAX(course.time) FROM notification_user dose JOIN notification_user course " +
+		"ON course.id_medicine = dose.id_medicine AND course.to_is_user IS NOT DISTINCT FROM dose.to_is_user " +
+		"AND course.id_to_user IS NOT DISTINCT FROM dose.id_to_user WHERE dose.id = $1"
+
+	var end time.Time
+	err := s.db.QueryRow(sqlScript, idNotification).Scan(&end)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return end, nil
+}
+
+// RescheduleNotification переносит неотмеченное напоминание на время at и записывает перенос
+// в dose_changes. Возвращает false, если напоминание уже отмечено
+func (s Storage) RescheduleNotification(idNotification, userID int64, at time.Time, action string) (bool, error) {
+	sqlScript := "WITH old AS (SELECT id, time FROM notification_user WHERE id = $1 AND COALESCE(is_accepted, false) = false FOR UPDATE), " +
+		"moved AS (UPDATE notification_user SET time = $2 FROM old WHERE notification_user.id = old.id " +
+		"RETURNING notification_user.id, COALESCE(notification_user.to_is_user, true) AS to_is_user, COALESCE(notification_user.id_to_user, 0) AS id_person, " +
+		"notification_user.id_medicine, notification_user.name_medicine, old.time AS time_from, notification_user.time AS time_to) " +
+		"INSERT INTO dose_changes(id_notification, to_is_user, id_person, id_medicine, name_medicine, action, time_from, time_to, id_user) " +
+		"SELECT id, to_is_user, id_person, id_medicine, name_medicine, $3, time_from, time_to, $4 FROM moved"
+
+	result, err := s.db.Exec(sqlScript, idNotification, at, action, userID)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected != 0, nil
+}
+
+// GetDoseChanges возвращает переносы приёмов человека, сделанные в промежутке [from, to)
+func (s Storage) GetDoseChanges(isUser bool, idPerson int64, from, to time.Time) ([]*proto.DoseChange, error) {
+	sqlScript := "SELECT COALESCE(dose_changes.id_medicine, 0), COALESCE(medicine.name, dose_changes.name_medicine, ''), dose_changes.action, " +
+		"dose_changes.time_from, dose_changes.time_to FROM dose_changes LEFT JOIN medicine ON medicine.id = dose_changes.id_medicine " +
+		"WHERE dose_changes.to_is_user = $1 AND dose_changes.id_person = $2 AND dose_changes.created >= $3 AND dose_changes.created < $4 " +
+		"ORDER BY dose_changes.created, dose_changes.id"
+
+	rows, err := s.db.Query(sqlScript, isUser, idPerson, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := make([]*proto.DoseChange, 0)
+	for rows.Next() {
+		var change proto.DoseChange
+		var timeFrom, timeTo time.Time
+		if err = rows.Scan(&change.IDMedicine, &change.NameMedicine, &change.Action, &timeFrom, &timeTo); err != nil {
+			return nil, err
+		}
+		change.From = timeFrom.Format(time.RFC3339)
+		change.To = timeTo.Format(time.RFC3339)
+		changes = append(changes, &change)
+	}
+
+	return changes, nil
+}
+
+// AddPushSubscription сохраняет подписку до конца сессии, из которой она оформлена. Подписка
+// другого пользователя с тем же адресом не перезаписывается, тогда возвращается false
+func (s Storage) AddPushSubscription(subscription *proto.PushSubscription) (bool, error) {
+	connRedis := s.redis.Get()
+	defer connRedis.Close()
+
+	ttl, err := redis.Int64(connRedis.Do("TTL", subscription.Session))
+	if err != nil {
+		return false, err
+	}
+	if ttl <= 0 {
+		return false, constants.ErrNoSession
+	}
+
+	sqlScript := "INSERT INTO push_subscriptions(id_user, endpoint, p256dh, auth, session, expires) VALUES($1, $2, $3, $4, $5, $6) " +
+		"ON CONFLICT (endpoint) DO UPDATE SET p256dh = excluded.p256dh, auth = excluded.auth, session = excluded.session, " +
+		"expires = excluded.expires, created = now() WHERE push_subscriptions.id_user = excluded.id_user"
+
+	result, err := s.db.Exec(sqlScript, subscription.UserID, subscription.Endpoint, subscription.P256Dh, subscription.Auth,
+		hash.Session(subscription.Session), time.Now().Add(time.Duration(ttl)*time.Second))
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected != 0, nil
+}
+
+func (s Storage) DeletePushSubscription(userID int64, endpoint string) (bool, error) {
+	sqlScript := "DELETE FROM push_subscriptions WHERE id_user = $1 AND endpoint = $2"
+
+	result, err := s.db.Exec(sqlScript, userID, endpoint)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected != 0, nil
+}
+
+// SetPRNRule задаёт правила приёма лекарства по необходимости; интервал хранится в минутах
+func (s Storage) SetPRNRule(rule *proto.PRNRule) error {
+	sqlScript := "INSERT INTO prn_rules(to_is_user, id_person, id_medicine, min_interval, max_daily) VALUES($1, $2, $3, $4, $5) " +
+		"ON CONFLICT (to_is_user, id_person, id_medicine) DO UPDATE SET min_interval = excluded.min_interval, max_daily = excluded.max_daily"
+
+	_, err := s.db.Exec(sqlScript, rule.IsUser, rule.IDPerson, rule.IDMedicine, rule.MinInterval, rule.MaxDaily)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s Storage) GetPRNRules(isUser bool, idPerson int64) ([]*proto.PRNRule, error) {
+	sqlScript := "SELECT prn_rules.id_medicine, medicine.name, prn_rules.min_interval, prn_rules.max_daily FROM prn_rules " +
+		"JOIN medicine ON medicine.id = prn_rules.id_medicine " +
+		"WHERE prn_rules.to_is_user = $1 AND prn_rules.id_person = $2 ORDER BY medicine.name, prn_rules.id"
+
+	rows, err := s.db.Query(sqlScript, isUser, idPerson)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := make([]*proto.PRNRule, 0)
+	for rows.Next() {
+		rule := proto.PRNRule{IsUser: isUser, IDPerson: idPerson}
+		if err = rows.Scan(&rule.IDMedicine, &rule.NameMedicine, &rule.MinInterval, &rule.MaxDaily); err != nil {
+			return nil, err
+		}
+		rules = append(rules, &rule)
+	}
+
+	return rules, nil
+}
+
+func (s Storage) DeletePRNRule(isUser bool, idPerson, idMedicine int64) (bool, error) {
+	sqlScript := "DELETE FROM prn_rules WHERE to_is_user = $1 AND id_person = $2 AND id_medicine = $3"
+
+	result, err := s.db.Exec(sqlScript, isUser, idPerson, idMedicine)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected != 0, nil
+}
+
+// GetPRNIntakes возвращает приёмы лекарства по необходимости начиная с since
+func (s Storage) GetPRNIntakes(isUser bool, idPerson, idMedicine int64, since time.Time) ([]asneeded.Intake, error) {
+	sqlScript := "SELECT time, count FROM prn_intakes WHERE to_is_user = $1 AND id_person = $2 AND id_medicine = $3 AND time > $4 ORDER BY time"
+
+	rows, err := s.db.Query(sqlScript, isUser, idPerson, idMedicine, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	intakes := make([]asneeded.Intake, 0)
+	for rows.Next() {
+		var intake asneeded.Intake
+		if err = rows.Scan(&intake.Time, &intake.Count); err != nil {
+			return nil, err
+		}
+		intakes = append(intakes, intake)
+	}
+
+	return intakes, nil
+}
+
+// AddPRNIntake сверяет приём count единиц с правилом и приёмами за последние сутки и, если
+// предупреждений нет или задан Override, записывает приём и уменьшает остаток. Правило блокируется
+// до конца транзакции, так что одновременные приёмы сверяются по очереди
+func (s Storage) AddPRNIntake(intake *proto.PRNIntake, at time.Time) (asneeded.Rule, []asneeded.Intake, []asneeded.Warning, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return asneeded.Rule{}, nil, nil, err
+	}
+	defer tx.Rollback()
+
+	sqlScript := "SELECT min_interval, max_daily FROM prn_rules WHERE to_is_user = $1 AND id_person = $2 AND id_medicine = $3 FOR UPDATE"
+
+	var minInterval, maxDaily int64
+	err = tx.QueryRow(sqlScript, intake.IsUser, intake.IDPerson, intake.IDMedicine).Scan(&minInterval, &maxDaily)
+	if err != nil {
+		return asneeded.Rule{}, nil, nil, err
+	}
+	rule := asneeded.Rule{MinInterval: time.Duration(minInterval) * time.Minute, MaxDaily: maxDaily}
+
+	sqlScript = "SELECT time, count FROM prn_intakes WHERE to_is_user = $1 AND id_person = $2 AND id_medicine = $3 AND time > $4 ORDER BY time"
+
+	rows, err := tx.Query(sqlScript, intake.IsUser, intake.IDPerson, intake.IDMedicine, at.Add(-asneeded.Window))
+	if err != nil {
+		return asneeded.Rule{}, nil, nil, err
+	}
+	defer rows.Close()
+
+	history := make([]asneeded.Intake, 0)
+	for rows.Next() {
+		var taken asneeded.Intake
+		if err = rows.Scan(&taken.Time, &taken.Count); err != nil {
+			return asneeded.Rule{}, nil, nil, err
+		}
+		history = append(history, taken)
+	}
+	if err = rows.Err(); err != nil {
+		return asneeded.Rule{}, nil, nil, err
+	}
+
+	warnings := asneeded.Check(rule, history, at, intake.Count)
+	if len(warnings) != 0 && !intake.Override {
+		return rule, history, warnings, nil
+	}
+
+	sqlScript = "INSERT INTO prn_intakes(to_is_user, id_person, id_medicine, name_medicine, count, time, id_user, overridden) " +
+		"SELECT $1, $2, id, name, $4, $5, $6, $7 FROM medicine WHERE id = $3"
+
+	_, err = tx.Exec(sqlScript, intake.IsUser, intake.IDPerson, intake.IDMedicine, intake.Count, at, intake.UserID, len(warnings) != 0)
+	if err != nil {
+		return asneeded.Rule{}, nil, nil, err
+	}
+
+	err = substruct(tx, intake.IDMedicine, intake.UserID, intake.Count)
+	if err != nil {
+		return asneeded.Rule{}, nil, nil, err
+	}
+
+	_, err = tx.Exec(lowStockItem, intake.IDMedicine, constants.ShoppingLowStock)
+	if err != nil {
+		return asneeded.Rule{}, nil, nil, err
+	}
+
+	return rule, history, warnings, tx.Commit()
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"errors"
+	"main/internal/constants"
+	proto "main/internal/microservices/profile/proto"
+	"main/internal/microservices/profile/utils/pagination"
+	"testing"
+)
+
+func TestValidCursorValue(t *testing.T) {
+	tests := []struct {
+		cast  string
+		value string
+		want  bool
+	}{
+		{"int", "42", true},
+		{"int", "-1", true},
+		{"int", "42abc", false},
+		{"int", "", false},
+		{"int", "99999999999999999999", false},
+		{"bool", "true", true},
+		{"bool", "yes", false},
+		{"date", "2026-10-19", true},
+		{"date", "infinity", true},
+		{"date", "19.10.2026", false},
+		{"date", "2026-13-01", false},
+		{"timestamptz", "2026-10-19T08:00:00+03:00", true},
+		{"timestamptz", "2026-10-19 08:00", false},
+		{"text", "аспирин", true},
+		{"text", "", true},
+		{"text", "a\x00b", false},
+		{"uuid", "42", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.cast+"/"+test.value, func(t *testing.T) {
+			if got := validCursorValue(test.cast, test.value); got != test.want {
+				t.Errorf("validCursorValue(%q, %q) = %v, want %v", test.cast, test.value, got, test.want)
+			}
+		})
+	}
+}
+
+func TestPaginateRejectsMismatchedCursor(t *testing.T) {
+	keys := medicineSortKeys[constants.SortExpiry]
+
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{"text instead of date", pagination.Encode("soon", "42")},
+		{"text instead of id", pagination.Encode("2026-10-19", "42 OR 1=1")},
+		{"wrong number of values", pagination.Encode("2026-10-19")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, _, err := paginate("SELECT id FROM medicine", nil, nil, keys, &proto.Page{Cursor: test.cursor})
+			if !errors.Is(err, pagination.ErrWrongCursor) {
+				t.Errorf("paginate error = %v, want %v", err, pagination.ErrWrongCursor)
+			}
+		})
+	}
+}
+
+func TestPaginateContinuesAfterCursor(t *testing.T) {
+	keys := medicineSortKeys[constants.SortExpiry]
+	page := &proto.Page{Cursor: pagination.Encode("2026-10-19", "42"), Limit: 10}
+
+	sqlScript, args, err := paginate("SELECT id FROM medicine", []string{"id_user = $1"}, []interface{}{int64(1)}, keys, page)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "SELECT id FROM medicine WHERE id_user = $1 AND (COALESCE(medicine.expires, 'infinity'::date), medicine.id) > ($2::date, $3::int) " +
+		"ORDER BY COALESCE(medicine.expires, 'infinity'::date), medicine.id LIMIT $4"
+	if sqlScript != want {
+		t.Errorf("sqlScript = %q, want %q", sqlScript, want)
+	}
+	if len(args) != 4 || args[3] != int64(11) {
+		t.Errorf("args = %v, want limit one more than the page", args)
+	}
+}
@@ -2,21 +2,40 @@ package usecase
 
 import (
 	"context"
-	"fmt"
+	"database/sql"
+	"errors"
 	"main/internal/constants"
 	"main/internal/microservices/profile"
 	proto "main/internal/microservices/profile/proto"
+	"main/internal/microservices/profile/utils/asneeded"
+	"main/internal/microservices/profile/utils/disposal"
+	"main/internal/microservices/profile/utils/dosage"
+	"main/internal/microservices/profile/utils/ingredients"
+	"main/internal/microservices/profile/utils/interactions"
+	"main/internal/microservices/profile/utils/notify"
+	"main/internal/microservices/profile/utils/pagination"
+	"main/internal/microservices/profile/utils/preferences"
+	"main/internal/microservices/profile/utils/schedule"
+	"main/internal/microservices/profile/utils/stock"
+	"main/internal/microservices/profile/utils/telegram"
+	"main/internal/microservices/profile/utils/templates"
+	"main/internal/microservices/profile/utils/webpush"
+	"math"
+	"sort"
+	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 type Service struct {
-	storage profile.Storage
+	storage     profile.Storage
+	ingredients *ingredients.Dataset
 }
 
-func NewService(storage profile.Storage) *Service {
-	return &Service{storage: storage}
+func NewService(storage profile.Storage, ingredients *ingredients.Dataset) *Service {
+	return &Service{storage: storage, ingredients: ingredients}
 }
 
 func (s *Service) GetUserProfile(ctx context.Context, userID *proto.UserID) (*proto.ProfileData, error) {
@@ -29,6 +48,12 @@ func (s *Service) GetUserProfile(ctx context.Context, userID *proto.UserID) (*pr
 }
 
 func (s *Service) EditProfile(ctx context.Context, data *proto.EditProfileData) (*proto.Empty, error) {
+	if data.TimeZone != "" {
+		if _, err := schedule.Location(data.TimeZone); err != nil {
+			return &proto.Empty{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	err := s.storage.EditProfile(data)
 	if err != nil {
 		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
@@ -142,6 +167,28 @@ func (s *Service) DeleteFromFamily(ctx context.Context, Delete *proto.Delete) (*
 	return &proto.Empty{}, nil
 }
 
+func (s *Service) LeaveFamily(ctx context.Context, userID *proto.UserID) (*proto.Empty, error) {
+	hasFamily, idMainUser, _, _, err := s.storage.HasFamily(userID.ID)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if hasFamily == false {
+		return &proto.Empty{}, status.Error(codes.Internal, constants.ErrNoFamily.Error())
+	}
+
+	if idMainUser == userID.ID {
+		return &proto.Empty{}, status.Error(codes.PermissionDenied, constants.ErrMainUser.Error())
+	}
+
+	err = s.storage.LeaveFamily(userID.ID, idMainUser)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.Empty{}, nil
+}
+
 func (s *Service) AddMember(ctx context.Context, data *proto.MemberData) (*proto.Empty, error) {
 	hasFamily, idMainUser, idFamily, _, err := s.storage.HasFamily(data.IDMainUser)
 	if err != nil {
@@ -166,12 +213,160 @@ func (s *Service) AddMember(ctx context.Context, data *proto.MemberData) (*proto
 	return &proto.Empty{}, nil
 }
 
-func (s *Service) GetFamily(ctx context.Context, userID *proto.UserID) (*proto.ResponseMemberDataArr, error) {
-	members, err := s.storage.GetFamily(userID.ID)
+func (s *Service) PromoteMember(ctx context.Context, data *proto.PromoteMemberData) (*proto.Empty, error) {
+	memberFamily, err := s.storage.GetMemberFamily(data.IDMember)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if memberFamily != data.IDFamily {
+		return &proto.Empty{}, status.Error(codes.PermissionDenied, constants.ErrNotInFamily.Error())
+	}
+
+	userID, userFamily, err := s.storage.GetUserByEmail(data.Email)
+	if err == constants.ErrWrongData {
+		return &proto.Empty{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if userFamily != data.IDFamily {
+		return &proto.Empty{}, status.Error(codes.PermissionDenied, constants.ErrNotInFamily.Error())
+	}
+
+	avatar, err := s.storage.PromoteMember(data.IDMember, userID)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if avatar != constants.DefaultImage {
+		err = s.storage.DeleteFile(avatar, constants.UserObjectsBucketName)
+		if err != nil {
+			return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &proto.Empty{}, nil
+}
+
+// CreateMemberInvite выдаёт главному пользователю семьи одноразовый токен, которым приглашённый
+// по email пользователь займёт место члена семьи без аккаунта
+func (s *Service) CreateMemberInvite(ctx context.Context, data *proto.MemberInvite) (*proto.MemberInviteToken, error) {
+	hasFamily, idMainUser, idFamily, _, err := s.storage.HasFamily(data.UserID)
+	if err != nil {
+		return &proto.MemberInviteToken{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if !hasFamily || idMainUser != data.UserID {
+		return &proto.MemberInviteToken{}, status.Error(codes.PermissionDenied, constants.ErrNotInFamily.Error())
+	}
+
+	memberFamily, err := s.storage.GetMemberFamily(data.IDMember)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.MemberInviteToken{}, status.Error(codes.NotFound, constants.ErrNoMember.Error())
+	}
+	if err != nil {
+		return &proto.MemberInviteToken{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if memberFamily != idFamily {
+		return &proto.MemberInviteToken{}, status.Error(codes.PermissionDenied, constants.ErrNotInFamily.Error())
+	}
+
+	expires := time.Now().AddDate(0, 0, constants.MemberInviteDays)
+	token, err := s.storage.AddMemberInvite(idFamily, data.IDMember, data.Email, data.IsAdult, expires)
+	if err != nil {
+		return &proto.MemberInviteToken{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.MemberInviteToken{Token: token}, nil
+}
+
+// AcceptMemberInvite погашает приглашение, добавляет пользователя в семью и переносит на него
+// историю члена семьи, указанного в приглашении
+func (s *Service) AcceptMemberInvite(ctx context.Context, data *proto.AcceptMemberInviteData) (*proto.Empty, error) {
+	invite, idMember, err := s.storage.UseMemberInvite(data.Token, data.Email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.Empty{}, status.Error(codes.PermissionDenied, constants.ErrWrongInvite.Error())
+	}
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.storage.AcceptInvitationToFamily(invite)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return s.PromoteMember(ctx, &proto.PromoteMemberData{
+		IDFamily: invite.ID,
+		IDMember: idMember,
+		Email:    invite.Email,
+	})
+}
+
+func (s *Service) GetFamily(ctx context.Context, filter *proto.FamilyFilter) (*proto.ResponseMemberDataArr, error) {
+	err := checkPage(filter.Page, constants.SortName)
+	if err != nil {
+		return &proto.ResponseMemberDataArr{}, err
+	}
+
+	if filter.Kind != "" && filter.Kind != constants.FamilyUsers && filter.Kind != constants.FamilyMembers {
+		return &proto.ResponseMemberDataArr{}, status.Error(codes.InvalidArgument, constants.ErrWrongFilter.Error())
+	}
+
+	members, cursor, err := s.storage.GetFamily(filter.UserID, filter)
+	if err != nil {
+		return &proto.ResponseMemberDataArr{}, pageError(err)
+	}
+	return &proto.ResponseMemberDataArr{ResponseMemberData: members, NextCursor: cursor}, nil
+}
+
+// checkPage проверяет параметры постраничного вывода: известный ключ сортировки и неотрицательный лимит.
+// Слишком большой лимит уменьшается до допустимого
+func checkPage(page *proto.Page, sorts ...string) error {
+	if page == nil {
+		return nil
+	}
+
+	if page.Limit < 0 {
+		return status.Error(codes.InvalidArgument, constants.ErrWrongData.Error())
+	}
+	if page.Limit > pagination.MaxLimit {
+		page.Limit = pagination.MaxLimit
+	}
+
+	if len(page.Sort) == 0 {
+		return nil
+	}
+	for _, sort := range sorts {
+		if page.Sort == sort {
+			return nil
+		}
+	}
+	return status.Error(codes.InvalidArgument, constants.ErrWrongSort.Error())
+}
+
+// checkDate проверяет дату фильтра в формате 2006-01-02; пустая дата означает отсутствие фильтра
+func checkDate(date string) error {
+	if len(date) == 0 {
+		return nil
+	}
+
+	_, err := time.Parse("2006-01-02", date)
 	if err != nil {
-		return &proto.ResponseMemberDataArr{}, status.Error(codes.Internal, err.Error())
+		return status.Error(codes.InvalidArgument, constants.ErrWrongFilter.Error())
 	}
-	return &proto.ResponseMemberDataArr{ResponseMemberData: members}, nil
+	return nil
+}
+
+// pageError отличает испорченный курсор, присланный клиентом, от ошибки хранилища
+func pageError(err error) error {
+	if errors.Is(err, pagination.ErrWrongCursor) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
 }
 
 func (s *Service) DeleteMember(ctx context.Context, Delete *proto.Delete) (*proto.Empty, error) {
@@ -215,6 +410,70 @@ func (s *Service) HasFamily(ctx context.Context, userID *proto.UserID) (*proto.H
 	}, nil
 }
 
+// checkPersonAccess разрешает работать с данными человека ему самому и взрослым членам его семьи
+func (s *Service) checkPersonAccess(userID int64, isUser bool, idPerson int64) error {
+	if isUser && idPerson == userID {
+		return nil
+	}
+
+	has, _, family, isAdult, err := s.storage.HasFamily(userID)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if !has || !isAdult {
+		return status.Error(codes.PermissionDenied, constants.ErrNotInFamily.Error())
+	}
+
+	var personFamily int64
+	if isUser {
+		_, _, personFamily, _, err = s.storage.HasFamily(idPerson)
+	} else {
+		personFamily, err = s.storage.GetMemberFamily(idPerson)
+	}
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if personFamily != family {
+		return status.Error(codes.PermissionDenied, constants.ErrNotInFamily.Error())
+	}
+
+	return nil
+}
+
+func (s *Service) GetHealth(ctx context.Context, person *proto.Person) (*proto.HealthData, error) {
+	err := s.checkPersonAccess(person.UserID, person.IsUser, person.IDPerson)
+	if err != nil {
+		return &proto.HealthData{}, err
+	}
+
+	health, err := s.storage.GetHealth(person.IsUser, person.IDPerson)
+	if err != nil {
+		return &proto.HealthData{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return health, nil
+}
+
+func (s *Service) EditHealth(ctx context.Context, data *proto.EditHealthData) (*proto.Empty, error) {
+	err := s.checkPersonAccess(data.UserID, data.HealthData.IsUser, data.HealthData.IDPerson)
+	if err != nil {
+		return &proto.Empty{}, err
+	}
+
+	if data.HealthData.Weight < 0 {
+		return &proto.Empty{}, status.Error(codes.InvalidArgument, constants.ErrWrongData.Error())
+	}
+
+	err = s.storage.EditHealth(data.HealthData)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.Empty{}, nil
+}
+
 func (s *Service) UserExists(ctx context.Context, data *proto.EmailData) (*proto.Exists, error) {
 	exists, err := s.storage.IsUserExists(data)
 	if err != nil {
@@ -225,59 +484,162 @@ func (s *Service) UserExists(ctx context.Context, data *proto.EmailData) (*proto
 	}, nil
 }
 
+// normalizeMedicine проверяет лекарственную форму и единицу учёта и приводит список действующих веществ к единому виду
+func normalizeMedicine(medicine *proto.Medicine) error {
+	form, unit, err := dosage.Normalize(medicine.Form, medicine.Unit, medicine.IsTablets)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if len(medicine.ExpiryDate) != 0 {
+		if _, err = time.Parse("2006-01-02", medicine.ExpiryDate); err != nil {
+			return status.Error(codes.InvalidArgument, constants.ErrWrongData.Error())
+		}
+	}
+
+	medicine.Form = form
+	medicine.Unit = unit
+	medicine.Ingredients = dosage.Ingredients(medicine.Ingredients)
+	return nil
+}
+
 func (s *Service) AddMedicine(ctx context.Context, data *proto.AddMed) (*proto.Empty, error) {
-	err := s.storage.AddMedicine(data)
+	err := normalizeMedicine(data.Medicine)
+	if err != nil {
+		return &proto.Empty{}, err
+	}
+
+	if data.Medicine.IDKit != 0 {
+		err = s.checkKitAccess(data.UserID, data.Medicine.IDKit)
+		if err != nil {
+			return &proto.Empty{}, err
+		}
+	}
+	data.Medicine.IsTablets = data.Medicine.Unit == dosage.UnitPieces
+
+	if data.Medicine.MinCount < 0 {
+		return &proto.Empty{}, status.Error(codes.InvalidArgument, constants.ErrWrongData.Error())
+	}
+
+	_, err = s.storage.AddMedicine(data)
 	if err != nil {
 		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
 	}
 	return &proto.Empty{}, nil
 }
 
-func (s *Service) DeleteMedicine(ctx context.Context, data *proto.DeleteMed) (*proto.Empty, error) {
-	image, err := s.storage.DeleteMedicine(data)
+// ImportMedicines загружает лекарства из файла: лекарство пользователя или его семьи с тем же названием и серией обновляется,
+// остальные добавляются. Ошибка в строке не прерывает загрузку, а попадает в отчёт
+func (s *Service) ImportMedicines(ctx context.Context, data *proto.MedicineImport) (*proto.ImportReport, error) {
+	kits, err := s.GetKits(ctx, &proto.UserID{ID: data.UserID})
 	if err != nil {
-		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+		return &proto.ImportReport{}, err
 	}
 
-	if image != constants.DefaultMedicine {
-		err = s.storage.DeleteFile(image, constants.MedicinesObjectsBucketName)
+	has, _, family, _, err := s.storage.HasFamily(data.UserID)
+	if err != nil {
+		return &proto.ImportReport{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if !has {
+		family = 0
+	}
+
+	// при совпадении названий предпочитаем аптечку самого пользователя
+	kitIDs := make(map[string]int64)
+	for _, kit := range kits.Kits {
+		name := strings.ToLower(kit.Name)
+		if _, ok := kitIDs[name]; !ok || kit.IDUser == data.UserID {
+			kitIDs[name] = kit.ID
+		}
+	}
+
+	results := make([]*proto.ImportResult, 0, len(data.Rows))
+	for _, row := range data.Rows {
+		result := &proto.ImportResult{Row: row.Row}
+		result.IDMedicine, result.Created, err = s.importMedicine(data.UserID, family, row.Medicine, kitIDs)
 		if err != nil {
-			return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+			result.Error = importError(err)
 		}
+		results = append(results, result)
 	}
 
-	return &proto.Empty{}, nil
+	return &proto.ImportReport{Results: results}, nil
 }
 
-func (s *Service) GetMedicine(ctx context.Context, userID *proto.UserID) (*proto.MedicineArr, error) {
-	has, _, family, _, err := s.storage.HasFamily(userID.ID)
+// importError возвращает для отчёта текст ошибки в данных строки; о сбоях хранилища сообщается
+// общим текстом, чтобы в отчёт не попадал текст запросов
+func importError(err error) string {
+	if errors.Is(err, constants.ErrWrongData) {
+		return err.Error()
+	}
+	if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+		return st.Message()
+	}
+	return constants.ErrImportRow.Error()
+}
+
+func (s *Service) importMedicine(userID, idFamily int64, medicine *proto.Medicine, kitIDs map[string]int64) (int64, bool, error) {
+	medicine.Name = strings.TrimSpace(medicine.Name)
+	medicine.Lot = strings.TrimSpace(medicine.Lot)
+	if len(medicine.Name) == 0 || medicine.Count < 0 || medicine.MinCount < 0 {
+		return 0, false, constants.ErrWrongData
+	}
+
+	err := normalizeMedicine(medicine)
 	if err != nil {
-		return &proto.MedicineArr{}, status.Error(codes.Internal, err.Error())
+		return 0, false, err
+	}
+	medicine.IsTablets = medicine.Unit == dosage.UnitPieces
+
+	if len(medicine.KitName) != 0 {
+		name := strings.ToLower(medicine.KitName)
+		idKit, ok := kitIDs[name]
+		if !ok {
+			idKit, err = s.storage.AddKit(userID, &proto.Kit{Name: medicine.KitName, Location: medicine.KitLocation})
+			if err != nil {
+				return 0, false, err
+			}
+			kitIDs[name] = idKit
+		}
+		medicine.IDKit = idKit
 	}
 
-	if !has {
-		medicines, err := s.storage.GetMedicine(userID.ID)
+	idMedicine, err := s.storage.FindMedicineByLot(userID, idFamily, medicine.Name, medicine.Lot)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if idMedicine == 0 {
+		medicine.Image = constants.DefaultMedicine
+		idMedicine, err = s.storage.AddMedicine(&proto.AddMed{UserID: userID, Medicine: medicine})
 		if err != nil {
-			return &proto.MedicineArr{}, status.Error(codes.Internal, err.Error())
+			return 0, false, err
 		}
-		return &proto.MedicineArr{MedicineArr: medicines}, nil
+		return idMedicine, true, nil
 	}
 
-	medicines, err := s.storage.GetMedicineFamily(family)
+	err = s.storage.UpdateImportedMedicine(&proto.GetMedicineData{ID: idMedicine, UserID: userID, Medicine: medicine})
 	if err != nil {
-		return &proto.MedicineArr{}, status.Error(codes.Internal, err.Error())
+		return 0, false, err
 	}
-	return &proto.MedicineArr{MedicineArr: medicines}, nil
+
+	err = s.storage.AddLowStockItem(idMedicine)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return idMedicine, false, nil
 }
 
-func (s *Service) EditMedicine(ctx context.Context, data *proto.GetMedicineData) (*proto.Empty, error) {
-	img, err := s.storage.EditMedicine(data)
+func (s *Service) DeleteMedicine(ctx context.Context, data *proto.DeleteMed) (*proto.Empty, error) {
+	image, err := s.storage.DeleteMedicine(data)
 	if err != nil {
 		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
 	}
 
-	if img != constants.DefaultMedicine {
-		err = s.storage.DeleteFile(img, constants.MedicinesObjectsBucketName)
+	if image != constants.DefaultMedicine {
+		err = s.storage.DeleteFile(image, constants.MedicinesObjectsBucketName)
 		if err != nil {
 			return &proto.Empty{}, status.Error(codes.Internal, err.Error())
 		}
@@ -286,16 +648,69 @@ func (s *Service) EditMedicine(ctx context.Context, data *proto.GetMedicineData)
 	return &proto.Empty{}, nil
 }
 
-func (s *Service) AddNotification(ctx context.Context, data *proto.NotificationData) (*proto.Empty, error) {
-	err := s.storage.AddNotification(data)
+// SearchMedicine ищет лекарства в аптечке пользователя или его семьи по названию и действующим веществам
+func (s *Service) SearchMedicine(ctx context.Context, data *proto.MedicineSearch) (*proto.MedicineSearchResult, error) {
+	data.Text = strings.TrimSpace(data.Text)
+	if len(data.Text) == 0 || data.Limit < 0 || data.Offset < 0 {
+		return &proto.MedicineSearchResult{}, status.Error(codes.InvalidArgument, constants.ErrWrongData.Error())
+	}
+
+	if len(data.Sort) == 0 {
+		data.Sort = constants.SortRelevance
+	}
+
+	if data.Limit == 0 {
+		data.Limit = constants.SearchLimit
+	}
+	if data.Limit > constants.SearchMaxLimit {
+		data.Limit = constants.SearchMaxLimit
+	}
+
+	has, _, family, _, err := s.storage.HasFamily(data.UserID)
 	if err != nil {
-		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+		return &proto.MedicineSearchResult{}, status.Error(codes.Internal, err.Error())
 	}
-	return &proto.Empty{}, nil
+
+	if !has {
+		family = 0
+	}
+
+	// запрашиваем на одно лекарство больше, чтобы узнать, есть ли следующая страница
+	medicines, err := s.storage.SearchMedicine(data.UserID, family, data.Text, data.Sort, data.Limit+1, data.Offset)
+	if errors.Is(err, constants.ErrWrongSort) {
+		return &proto.MedicineSearchResult{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return &proto.MedicineSearchResult{}, status.Error(codes.Internal, err.Error())
+	}
+
+	hasMore := int64(len(medicines)) > data.Limit
+	if hasMore {
+		medicines = medicines[:data.Limit]
+	}
+
+	return &proto.MedicineSearchResult{Medicines: medicines, HasMore: hasMore}, nil
 }
 
-func (s *Service) DeleteNotification(ctx context.Context, data *proto.DeleteNotificationData) (*proto.Empty, error) {
-	err := s.storage.DeleteNotification(data)
+// DisposeMedicine убирает лекарство из аптечки в корзину с указанием причины и даты выбытия.
+// В отличие от DeleteMedicine журнал остатков и напоминания сохраняются
+func (s *Service) DisposeMedicine(ctx context.Context, data *proto.DisposeData) (*proto.Empty, error) {
+	err := s.checkMedicineAccess(data.UserID, data.IDMedicine)
+	if err != nil {
+		return &proto.Empty{}, err
+	}
+
+	err = disposal.ValidateReason(data.Reason)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	date, err := disposal.Date(data.Date, time.Now(), constants.TrashRetentionDays)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.storage.DisposeMedicine(data.IDMedicine, data.Reason, date)
 	if err != nil {
 		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
 	}
@@ -303,45 +718,1659 @@ func (s *Service) DeleteNotification(ctx context.Context, data *proto.DeleteNoti
 	return &proto.Empty{}, nil
 }
 
-func (s *Service) GetNotifications(ctx context.Context, userID *proto.UserID) (*proto.NotificationArr, error) {
-	has, _, family, isAdult, err := s.storage.HasFamily(userID.ID)
+func (s *Service) GetTrash(ctx context.Context, userID *proto.UserID) (*proto.MedicineArr, error) {
+	has, _, family, _, err := s.storage.HasFamily(userID.ID)
 	if err != nil {
-		return &proto.NotificationArr{}, status.Error(codes.Internal, err.Error())
+		return &proto.MedicineArr{}, status.Error(codes.Internal, err.Error())
 	}
 
-	fmt.Println(has, isAdult)
-
-	if !has || (has && !isAdult) {
-		notifications, err := s.storage.GetNotifications(userID.ID)
-		if err != nil {
-			return &proto.NotificationArr{}, status.Error(codes.Internal, err.Error())
-		}
-		return &proto.NotificationArr{GetNotificationData: notifications}, nil
+	if !has {
+		family = 0
 	}
 
-	fmt.Println(1)
-
-	notifications, err := s.storage.GetNotificationsFamily(family)
+	medicines, err := s.storage.GetTrash(userID.ID, family, constants.TrashRetentionDays)
 	if err != nil {
-		return &proto.NotificationArr{}, status.Error(codes.Internal, err.Error())
+		return &proto.MedicineArr{}, status.Error(codes.Internal, err.Error())
 	}
 
-	fmt.Println(notifications)
-	return &proto.NotificationArr{GetNotificationData: notifications}, nil
+	return &proto.MedicineArr{MedicineArr: medicines}, nil
 }
 
-func (s *Service) AcceptNotification(ctx context.Context, acceptData *proto.Accept) (*proto.Empty, error) {
-	idMedicine, err := s.storage.AcceptNotification(acceptData)
+func (s *Service) RestoreMedicine(ctx context.Context, data *proto.MedicineRequest) (*proto.Empty, error) {
+	err := s.checkMedicineAccess(data.UserID, data.IDMedicine)
+	if err != nil {
+		return &proto.Empty{}, err
+	}
+
+	restored, err := s.storage.RestoreMedicine(data.IDMedicine, constants.TrashRetentionDays)
 	if err != nil {
 		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
 	}
 
-	if acceptData.Count > 0 {
-		err = s.storage.Substruct(idMedicine, acceptData.Count)
-		if err != nil {
-			return &proto.Empty{}, status.Error(codes.Internal, err.Error())
-		}
+	if !restored {
+		return &proto.Empty{}, status.Error(codes.InvalidArgument, constants.ErrRestorePeriod.Error())
+	}
+
+	err = s.storage.AddLowStockItem(data.IDMedicine)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
 	}
 
 	return &proto.Empty{}, nil
 }
+
+func (s *Service) GetMedicine(ctx context.Context, filter *proto.MedicineFilter) (*proto.MedicineArr, error) {
+	err := checkPage(filter.Page, constants.SortName, constants.SortExpiry, constants.SortCount, constants.SortID)
+	if err != nil {
+		return &proto.MedicineArr{}, err
+	}
+
+	err = checkDate(filter.ExpiresBefore)
+	if err != nil {
+		return &proto.MedicineArr{}, err
+	}
+
+	has, _, family, _, err := s.storage.HasFamily(filter.UserID)
+	if err != nil {
+		return &proto.MedicineArr{}, status.Error(codes.Internal, err.Error())
+	}
+
+	var medicines []*proto.GetMedicineData
+	var cursor string
+	if !has {
+		medicines, cursor, err = s.storage.GetMedicine(filter.UserID, filter)
+	} else {
+		medicines, cursor, err = s.storage.GetMedicineFamily(family, filter)
+	}
+	if err != nil {
+		return &proto.MedicineArr{}, pageError(err)
+	}
+
+	err = s.fillForecast(medicines, time.Now())
+	if err != nil {
+		return &proto.MedicineArr{}, status.Error(codes.Internal, err.Error())
+	}
+	return &proto.MedicineArr{MedicineArr: medicines, NextCursor: cursor}, nil
+}
+
+// fillForecast считает по расписанию напоминаний, на сколько дней хватит остатка каждого лекарства
+func (s *Service) fillForecast(medicines []*proto.GetMedicineData, now time.Time) error {
+	ids := make([]int64, 0, len(medicines))
+	for _, medicine := range medicines {
+		medicine.Medicine.DaysOfSupply = -1
+		if medicine.Medicine.IsTablets || dosage.Countable(medicine.Medicine.Unit) {
+			ids = append(ids, medicine.ID)
+		}
+	}
+
+	schedules, err := s.storage.GetSchedules(ids)
+	if err != nil {
+		return err
+	}
+
+	doses, err := s.storage.GetAverageDoses(ids)
+	if err != nil {
+		return err
+	}
+
+	for _, medicine := range medicines {
+		schedule, ok := schedules[medicine.ID]
+		if !ok {
+			continue
+		}
+
+		runOut, ok := stock.Forecast(medicine.Medicine.Count, doses[medicine.ID], schedule)
+		medicine.Medicine.DaysOfSupply = stock.DaysOfSupply(runOut, ok, now)
+		if ok {
+			medicine.Medicine.RunOutDate = runOut.Format("2006-01-02")
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) SetMinCount(ctx context.Context, data *proto.MinCountData) (*proto.Empty, error) {
+	if data.MinCount < 0 {
+		return &proto.Empty{}, status.Error(codes.InvalidArgument, constants.ErrWrongData.Error())
+	}
+
+	err := s.checkMedicineAccess(data.UserID, data.IDMedicine)
+	if err != nil {
+		return &proto.Empty{}, err
+	}
+
+	err = s.storage.SetMinCount(data.IDMedicine, data.MinCount)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.storage.AddLowStockItem(data.IDMedicine)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.Empty{}, nil
+}
+
+func (s *Service) EditMedicine(ctx context.Context, data *proto.GetMedicineData) (*proto.Empty, error) {
+	err := normalizeMedicine(data.Medicine)
+	if err != nil {
+		return &proto.Empty{}, err
+	}
+
+	img, err := s.storage.EditMedicine(data)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.storage.AddLowStockItem(data.ID)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if img != constants.DefaultMedicine {
+		err = s.storage.DeleteFile(img, constants.MedicinesObjectsBucketName)
+		if err != nil {
+			return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &proto.Empty{}, nil
+}
+
+// checkMedicineAccess разрешает работать с лекарством его владельцу и членам его семьи
+func (s *Service) checkMedicineAccess(userID, idMedicine int64) error {
+	owner, err := s.storage.GetMedicineOwner(idMedicine)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return s.checkOwnerAccess(userID, owner)
+}
+
+// checkOwnerAccess разрешает работать с данными пользователя owner ему самому и членам его семьи
+func (s *Service) checkOwnerAccess(userID, owner int64) error {
+	if owner == userID {
+		return nil
+	}
+
+	has, _, family, _, err := s.storage.HasFamily(userID)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if !has {
+		return status.Error(codes.PermissionDenied, constants.ErrNotInFamily.Error())
+	}
+
+	_, _, ownerFamily, _, err := s.storage.HasFamily(owner)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if ownerFamily != family {
+		return status.Error(codes.PermissionDenied, constants.ErrNotInFamily.Error())
+	}
+
+	return nil
+}
+
+func (s *Service) ChangeStock(ctx context.Context, data *proto.StockChange) (*proto.Empty, error) {
+	err := stock.ValidateReason(data.Reason)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if data.Delta == 0 {
+		return &proto.Empty{}, status.Error(codes.InvalidArgument, constants.ErrWrongData.Error())
+	}
+
+	err = s.checkMedicineAccess(data.UserID, data.IDMedicine)
+	if err != nil {
+		return &proto.Empty{}, err
+	}
+
+	medicine, err := s.storage.GetMedicineByID(data.IDMedicine)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if !medicine.Medicine.IsTablets && !dosage.Countable(medicine.Medicine.Unit) {
+		return &proto.Empty{}, status.Error(codes.InvalidArgument, constants.ErrNoStockUnit.Error())
+	}
+
+	err = s.storage.ChangeStock(data.IDMedicine, data.UserID, data.Delta, data.Reason)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.storage.AddLowStockItem(data.IDMedicine)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.Empty{}, nil
+}
+
+func (s *Service) GetStockHistory(ctx context.Context, data *proto.MedicineRequest) (*proto.StockHistory, error) {
+	err := s.checkMedicineAccess(data.UserID, data.IDMedicine)
+	if err != nil {
+		return &proto.StockHistory{}, err
+	}
+
+	medicine, err := s.storage.GetMedicineByID(data.IDMedicine)
+	if err != nil {
+		return &proto.StockHistory{}, status.Error(codes.Internal, err.Error())
+	}
+
+	entries, err := s.storage.GetStockHistory(data.IDMedicine)
+	if err != nil {
+		return &proto.StockHistory{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.StockHistory{
+		Count:   medicine.Medicine.Count,
+		Unit:    medicine.Medicine.Unit,
+		Entries: entries,
+	}, nil
+}
+
+// checkKitAccess разрешает работать с аптечкой её владельцу и членам его семьи
+func (s *Service) checkKitAccess(userID, idKit int64) error {
+	owner, err := s.storage.GetKitOwner(idKit)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return s.checkOwnerAccess(userID, owner)
+}
+
+func (s *Service) AddKit(ctx context.Context, data *proto.KitData) (*proto.Empty, error) {
+	if len(data.Kit.Name) == 0 {
+		return &proto.Empty{}, status.Error(codes.InvalidArgument, constants.ErrWrongData.Error())
+	}
+
+	_, err := s.storage.AddKit(data.UserID, data.Kit)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.Empty{}, nil
+}
+
+func (s *Service) GetKits(ctx context.Context, userID *proto.UserID) (*proto.KitArr, error) {
+	has, _, family, _, err := s.storage.HasFamily(userID.ID)
+	if err != nil {
+		return &proto.KitArr{}, status.Error(codes.Internal, err.Error())
+	}
+
+	var kits []*proto.Kit
+	if !has {
+		kits, err = s.storage.GetKits(userID.ID)
+	} else {
+		kits, err = s.storage.GetKitsFamily(family)
+	}
+	if err != nil {
+		return &proto.KitArr{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.KitArr{Kits: kits}, nil
+}
+
+func (s *Service) EditKit(ctx context.Context, data *proto.KitData) (*proto.Empty, error) {
+	err := s.checkKitAccess(data.UserID, data.Kit.ID)
+	if err != nil {
+		return &proto.Empty{}, err
+	}
+
+	err = s.storage.EditKit(data.Kit)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.Empty{}, nil
+}
+
+// DeleteKit удаляет аптечку; лекарства из неё остаются без аптечки
+func (s *Service) DeleteKit(ctx context.Context, data *proto.KitRequest) (*proto.Empty, error) {
+	err := s.checkKitAccess(data.UserID, data.ID)
+	if err != nil {
+		return &proto.Empty{}, err
+	}
+
+	err = s.storage.DeleteKit(data.ID)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.Empty{}, nil
+}
+
+// MoveMedicine перекладывает лекарство в другую аптечку целиком или, если указано количество, частично.
+// При частичном переносе остаток списывается и зачисляется через журнал
+func (s *Service) MoveMedicine(ctx context.Context, data *proto.MoveMedicineData) (*proto.Empty, error) {
+	err := s.checkMedicineAccess(data.UserID, data.IDMedicine)
+	if err != nil {
+		return &proto.Empty{}, err
+	}
+
+	if data.IDKit != 0 {
+		err = s.checkKitAccess(data.UserID, data.IDKit)
+		if err != nil {
+			return &proto.Empty{}, err
+		}
+	}
+
+	if data.Count < 0 {
+		return &proto.Empty{}, status.Error(codes.InvalidArgument, constants.ErrWrongData.Error())
+	}
+
+	medicine, err := s.storage.GetMedicineByID(data.IDMedicine)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if medicine.Medicine.IDKit == data.IDKit {
+		return &proto.Empty{}, nil
+	}
+
+	if data.Count == 0 || data.Count >= medicine.Medicine.Count {
+		err = s.storage.SetMedicineKit(data.IDMedicine, data.IDKit)
+		if err != nil {
+			return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+		}
+		return &proto.Empty{}, nil
+	}
+
+	if !medicine.Medicine.IsTablets && !dosage.Countable(medicine.Medicine.Unit) {
+		return &proto.Empty{}, status.Error(codes.InvalidArgument, constants.ErrNoStockUnit.Error())
+	}
+
+	targetID, err := s.storage.SplitMedicine(data.IDMedicine, data.IDKit)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.storage.TransferStock(data.IDMedicine, targetID, data.UserID, data.Count)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.Empty{}, nil
+}
+
+// checkTemplateAccess разрешает пользоваться встроенными шаблонами всем,
+// а собственными — владельцу и членам его семьи
+func (s *Service) checkTemplateAccess(userID int64, template *proto.Template) error {
+	if template.IDUser == 0 {
+		return nil
+	}
+
+	return s.checkOwnerAccess(userID, template.IDUser)
+}
+
+func (s *Service) AddTemplate(ctx context.Context, data *proto.TemplateData) (*proto.Empty, error) {
+	if len(data.Template.Name) == 0 || len(data.Template.Items) == 0 {
+		return &proto.Empty{}, status.Error(codes.InvalidArgument, constants.ErrWrongData.Error())
+	}
+
+	for _, item := range data.Template.Items {
+		if len(item.Name) == 0 || item.Count <= 0 {
+			return &proto.Empty{}, status.Error(codes.InvalidArgument, constants.ErrWrongData.Error())
+		}
+		if len(item.Keyword) == 0 {
+			item.Keyword = item.Name
+		}
+		item.Keyword = strings.ToLower(strings.TrimSpace(item.Keyword))
+	}
+
+	err := s.storage.AddTemplate(data.UserID, data.Template)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.Empty{}, nil
+}
+
+func (s *Service) GetTemplates(ctx context.Context, userID *proto.UserID) (*proto.TemplateArr, error) {
+	has, _, family, _, err := s.storage.HasFamily(userID.ID)
+	if err != nil {
+		return &proto.TemplateArr{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if !has {
+		family = 0
+	}
+
+	result, err := s.storage.GetTemplates(userID.ID, family)
+	if err != nil {
+		return &proto.TemplateArr{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.TemplateArr{Templates: result}, nil
+}
+
+// DeleteTemplate удаляет шаблон пользователя; встроенные шаблоны удалить нельзя
+func (s *Service) DeleteTemplate(ctx context.Context, data *proto.TemplateRequest) (*proto.Empty, error) {
+	template, err := s.storage.GetTemplate(data.ID)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if template.IDUser == 0 {
+		return &proto.Empty{}, status.Error(codes.PermissionDenied, constants.ErrBuiltinTemplate.Error())
+	}
+
+	err = s.checkTemplateAccess(data.UserID, template)
+	if err != nil {
+		return &proto.Empty{}, err
+	}
+
+	err = s.storage.DeleteTemplate(template.ID)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.Empty{}, nil
+}
+
+// kitQuantity возвращает количество лекарства в единицах позиции шаблона.
+// Если единицы не совпадают или остаток не ведётся, упаковка считается закрывающей позицию целиком
+func kitQuantity(medicine *proto.Medicine, item *proto.TemplateItem) int64 {
+	unit := medicine.Unit
+	if len(unit) == 0 && medicine.IsTablets {
+		unit = dosage.UnitPieces
+	}
+
+	if len(item.Unit) != 0 && unit == item.Unit && dosage.Countable(unit) {
+		return medicine.Count
+	}
+	if medicine.Count <= 0 && dosage.Countable(unit) {
+		return 0
+	}
+	return item.Count
+}
+
+// CheckKit сравнивает содержимое аптечки с шаблоном. Каждое лекарство засчитывается
+// только в той позиции, которой оно нужно: остаток после закрытия позиции переходит к следующим
+func (s *Service) CheckKit(ctx context.Context, data *proto.KitCheck) (*proto.KitReport, error) {
+	err := s.checkKitAccess(data.UserID, data.IDKit)
+	if err != nil {
+		return &proto.KitReport{}, err
+	}
+
+	template, err := s.storage.GetTemplate(data.IDTemplate)
+	if err != nil {
+		return &proto.KitReport{}, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.checkTemplateAccess(data.UserID, template)
+	if err != nil {
+		return &proto.KitReport{}, err
+	}
+
+	medicines, err := s.storage.GetKitMedicines(data.IDKit)
+	if err != nil {
+		return &proto.KitReport{}, status.Error(codes.Internal, err.Error())
+	}
+
+	names := make([][]string, len(medicines))
+	for i, medicine := range medicines {
+		for _, ingredient := range s.medicineIngredients(medicine.Medicine) {
+			names[i] = append(names[i], ingredient.Name)
+		}
+	}
+
+	today := time.Now().Format("2006-01-02")
+	used := make([]bool, len(medicines))
+	taken := make([]int64, len(medicines))
+	report := make([]*proto.KitReportItem, 0, len(template.Items))
+	for _, item := range template.Items {
+		var available, expired int64
+		for i, medicine := range medicines {
+			if used[i] || !templates.Match(item.Keyword, medicine.Medicine.Name, names[i]) {
+				continue
+			}
+
+			quantity := kitQuantity(medicine.Medicine, item) - taken[i]
+			if quantity <= 0 {
+				continue
+			}
+
+			if len(medicine.Medicine.ExpiryDate) != 0 && medicine.Medicine.ExpiryDate < today {
+				expired += quantity
+				used[i] = true
+				continue
+			}
+
+			if need := item.Count - available; quantity > need {
+				available += need
+				taken[i] += need
+				continue
+			}
+			available += quantity
+			used[i] = true
+		}
+
+		report = append(report, &proto.KitReportItem{
+			Name:      item.Name,
+			Required:  item.Count,
+			Available: available,
+			Expired:   expired,
+			Unit:      item.Unit,
+			Status:    templates.Status(item.Count, available, expired),
+		})
+	}
+
+	if data.AddToShoppingList {
+		owner, err := s.storage.GetKitOwner(data.IDKit)
+		if err != nil {
+			return &proto.KitReport{}, status.Error(codes.Internal, err.Error())
+		}
+
+		for _, item := range report {
+			if item.Status == templates.StatusOK {
+				continue
+			}
+
+			count := item.Required - item.Available
+			if count < 1 {
+				count = 1
+			}
+
+			err = s.storage.AddMissingItem(&proto.ShoppingItemData{
+				UserID: owner,
+				Name:   item.Name,
+				Count:  count,
+				Unit:   item.Unit,
+			}, constants.ShoppingTemplate)
+			if err != nil {
+				return &proto.KitReport{}, status.Error(codes.Internal, err.Error())
+			}
+		}
+	}
+
+	return &proto.KitReport{Items: report}, nil
+}
+
+func (s *Service) AddShoppingItem(ctx context.Context, data *proto.ShoppingItemData) (*proto.Empty, error) {
+	if data.IDMedicine != 0 {
+		err := s.checkMedicineAccess(data.UserID, data.IDMedicine)
+		if err != nil {
+			return &proto.Empty{}, err
+		}
+
+		medicine, err := s.storage.GetMedicineByID(data.IDMedicine)
+		if err != nil {
+			return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+		}
+
+		if len(data.Name) == 0 {
+			data.Name = medicine.Medicine.Name
+		}
+		if len(data.Unit) == 0 {
+			data.Unit = medicine.Medicine.Unit
+		}
+	}
+
+	if len(data.Name) == 0 || data.Count < 0 {
+		return &proto.Empty{}, status.Error(codes.InvalidArgument, constants.ErrWrongData.Error())
+	}
+
+	if data.Count == 0 {
+		data.Count = 1
+	}
+
+	err := s.storage.AddShoppingItem(data, constants.ShoppingManual)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.Empty{}, nil
+}
+
+func (s *Service) GetShoppingList(ctx context.Context, userID *proto.UserID) (*proto.ShoppingList, error) {
+	has, _, family, _, err := s.storage.HasFamily(userID.ID)
+	if err != nil {
+		return &proto.ShoppingList{}, status.Error(codes.Internal, err.Error())
+	}
+
+	var items []*proto.ShoppingItem
+	if !has {
+		items, err = s.storage.GetShoppingList(userID.ID)
+	} else {
+		items, err = s.storage.GetShoppingListFamily(family)
+	}
+	if err != nil {
+		return &proto.ShoppingList{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.ShoppingList{Items: items}, nil
+}
+
+func (s *Service) getShoppingItem(userID, itemID int64) (*proto.ShoppingItem, error) {
+	item, err := s.storage.GetShoppingItem(itemID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.checkOwnerAccess(userID, item.IDUser)
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// CheckShoppingItem отмечает позицию купленной; купленное лекарство пополняет остаток через журнал.
+// Взамен просроченного лекарства заводится новая упаковка, а просроченная остаётся до утилизации
+func (s *Service) CheckShoppingItem(ctx context.Context, data *proto.ShoppingItemRequest) (*proto.Empty, error) {
+	item, err := s.getShoppingItem(data.UserID, data.ID)
+	if err != nil {
+		return &proto.Empty{}, err
+	}
+
+	checked, err := s.storage.CheckShoppingItem(item.ID, data.UserID)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if !checked || item.IDMedicine == 0 {
+		return &proto.Empty{}, nil
+	}
+
+	medicine, err := s.storage.GetMedicineByID(item.IDMedicine)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	countable := medicine.Medicine.IsTablets || dosage.Countable(medicine.Medicine.Unit)
+
+	if item.Reason == constants.ShoppingExpired {
+		var count int64
+		if countable {
+			count = item.Count
+		}
+
+		_, err = s.storage.AddBatch(item.IDMedicine, data.UserID, count)
+		if err != nil {
+			return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+		}
+		return &proto.Empty{}, nil
+	}
+
+	if countable {
+		err = s.storage.ChangeStock(item.IDMedicine, data.UserID, item.Count, stock.ReasonRestock)
+		if err != nil {
+			return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &proto.Empty{}, nil
+}
+
+func (s *Service) DeleteShoppingItem(ctx context.Context, data *proto.ShoppingItemRequest) (*proto.Empty, error) {
+	item, err := s.getShoppingItem(data.UserID, data.ID)
+	if err != nil {
+		return &proto.Empty{}, err
+	}
+
+	err = s.storage.DeleteShoppingItem(item.ID)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.Empty{}, nil
+}
+
+// AddNotification добавляет напоминание. Если задано местное время приёма Clock, момент
+// напоминания на день Day считается в часовом поясе получателя
+func (s *Service) AddNotification(ctx context.Context, data *proto.NotificationData) (*proto.NotificationResult, error) {
+	if data.Clock != "" {
+		at, err := s.doseTime(data.IsUser, data.IDTo, data.Clock, data.Day)
+		if err != nil {
+			return &proto.NotificationResult{}, err
+		}
+		data.Time = at.Format(time.RFC3339)
+	} else if _, err := time.Parse(time.RFC3339, data.Time); err != nil {
+		return &proto.NotificationResult{}, status.Error(codes.InvalidArgument, schedule.ErrWrongClock.Error())
+	}
+
+	warnings, err := s.checkMedicine(data.IsUser, data.IDTo, data.IDMedicine)
+	if err != nil {
+		return &proto.NotificationResult{}, status.Error(codes.Internal, err.Error())
+	}
+
+	blocking := false
+	for _, warning := range warnings {
+		// незначительные взаимодействия только показываются и не требуют подтверждения
+		if warning.Severity != interactions.SeverityMinor {
+			blocking = true
+		}
+	}
+
+	if blocking && !data.Override {
+		return &proto.NotificationResult{Added: false, Warnings: warnings}, nil
+	}
+
+	err = s.storage.AddNotification(data)
+	if err != nil {
+		return &proto.NotificationResult{}, status.Error(codes.Internal, err.Error())
+	}
+	return &proto.NotificationResult{Added: true, Warnings: warnings}, nil
+}
+
+// personLocation возвращает часовой пояс человека; испорченный пояс в базе заменяется на UTC
+func (s *Service) personLocation(isUser bool, idPerson int64) (*time.Location, error) {
+	zone, err := s.storage.GetTimeZone(isUser, idPerson)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	loc, err := schedule.Location(zone)
+	if err != nil {
+		return time.UTC, nil
+	}
+	return loc, nil
+}
+
+func (s *Service) doseTime(isUser bool, idPerson int64, clock string, day int64) (time.Time, error) {
+	loc, err := s.personLocation(isUser, idPerson)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	at, err := schedule.Dose(clock, day, time.Now(), loc)
+	if err != nil {
+		return time.Time{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return at, nil
+}
+
+// checkMedicine сверяет действующие вещества лекарства с аллергиями и возрастом получателя напоминания
+func (s *Service) checkMedicine(isUser bool, idPerson, idMedicine int64) ([]*proto.Warning, error) {
+	health, err := s.storage.GetHealth(isUser, idPerson)
+	if err != nil {
+		return nil, err
+	}
+
+	medicine, err := s.storage.GetMedicineByID(idMedicine)
+	if err != nil {
+		return nil, err
+	}
+
+	found := s.medicineIngredients(medicine.Medicine)
+
+	warnings := make([]*proto.Warning, 0)
+	for _, warning := range ingredients.Check(medicine.Medicine.Name, found, health.Allergies, health.BirthDate, time.Now()) {
+		warnings = append(warnings, &proto.Warning{
+			Type:       warning.Type,
+			Ingredient: warning.Ingredient,
+			Message:    warning.Message,
+			Severity:   interactions.SeverityMajor,
+		})
+	}
+
+	active, err := s.storage.GetActiveMedicines(isUser, idPerson)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := make([][2]*proto.GetMedicineData, 0)
+	for _, other := range active {
+		if other.ID != idMedicine {
+			pairs = append(pairs, [2]*proto.GetMedicineData{medicine, other})
+		}
+	}
+
+	interactionsFound, err := s.findInteractions(pairs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, interaction := range interactionsFound {
+		warningType := constants.WarningInteraction
+		if interaction.IngredientA == interaction.IngredientB {
+			warningType = constants.WarningDuplication
+		}
+		warnings = append(warnings, &proto.Warning{
+			Type:       warningType,
+			Ingredient: interaction.IngredientA + " + " + interaction.IngredientB,
+			Message:    interaction.Description + " (" + interaction.NameMedicineB + ")",
+			Severity:   interaction.Severity,
+		})
+	}
+
+	return warnings, nil
+}
+
+// medicineIngredients возвращает действующие вещества, указанные у лекарства,
+// а если их нет — найденные в справочнике по названию
+func (s *Service) medicineIngredients(medicine *proto.Medicine) []ingredients.Ingredient {
+	if len(medicine.Ingredients) != 0 {
+		return s.ingredients.Resolve(medicine.Ingredients)
+	}
+	return s.ingredients.Find(medicine.Name)
+}
+
+// findInteractions ищет в таблице взаимодействий пары действующих веществ для каждой пары лекарств,
+// а одно и то же вещество в обоих лекарствах отмечает как дублирование
+func (s *Service) findInteractions(pairs [][2]*proto.GetMedicineData) ([]*proto.Interaction, error) {
+	found := make(map[int64][]ingredients.Ingredient)
+	names := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, pair := range pairs {
+		for _, medicine := range pair {
+			if _, ok := found[medicine.ID]; ok {
+				continue
+			}
+			found[medicine.ID] = s.medicineIngredients(medicine.Medicine)
+			for _, ingredient := range found[medicine.ID] {
+				if !seen[ingredient.Name] {
+					seen[ingredient.Name] = true
+					names = append(names, ingredient.Name)
+				}
+			}
+		}
+	}
+
+	known, err := s.storage.GetInteractions(names)
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]interactions.Interaction)
+	for _, interaction := range known {
+		index[interactions.Key(interaction.IngredientA, interaction.IngredientB)] = interaction
+	}
+
+	result := make([]*proto.Interaction, 0)
+	for _, pair := range pairs {
+		for _, a := range found[pair[0].ID] {
+			for _, b := range found[pair[1].ID] {
+				interaction, ok := index[interactions.Key(a.Name, b.Name)]
+				if a.Name == b.Name {
+					interaction, ok = interactions.Duplication(a.Name), true
+				}
+				if !ok {
+					continue
+				}
+				result = append(result, &proto.Interaction{
+					IDMedicineA:   pair[0].ID,
+					NameMedicineA: pair[0].Medicine.Name,
+					IDMedicineB:   pair[1].ID,
+					NameMedicineB: pair[1].Medicine.Name,
+					IngredientA:   a.Name,
+					IngredientB:   b.Name,
+					Severity:      interaction.Severity,
+					Description:   interaction.Description,
+				})
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (s *Service) AuditRegimen(ctx context.Context, person *proto.Person) (*proto.InteractionArr, error) {
+	err := s.checkPersonAccess(person.UserID, person.IsUser, person.IDPerson)
+	if err != nil {
+		return &proto.InteractionArr{}, err
+	}
+
+	active, err := s.storage.GetActiveMedicines(person.IsUser, person.IDPerson)
+	if err != nil {
+		return &proto.InteractionArr{}, status.Error(codes.Internal, err.Error())
+	}
+
+	pairs := make([][2]*proto.GetMedicineData, 0)
+	for i := range active {
+		for j := i + 1; j < len(active); j++ {
+			pairs = append(pairs, [2]*proto.GetMedicineData{active[i], active[j]})
+		}
+	}
+
+	found, err := s.findInteractions(pairs)
+	if err != nil {
+		return &proto.InteractionArr{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.InteractionArr{Interactions: found}, nil
+}
+
+func (s *Service) DeleteNotification(ctx context.Context, data *proto.DeleteNotificationData) (*proto.Empty, error) {
+	err := s.storage.DeleteNotification(data)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.Empty{}, nil
+}
+
+func (s *Service) GetNotifications(ctx context.Context, filter *proto.NotificationFilter) (*proto.NotificationArr, error) {
+	err := checkPage(filter.Page, constants.SortTime, constants.SortMedicine)
+	if err != nil {
+		return &proto.NotificationArr{}, err
+	}
+
+	if err = checkDate(filter.From); err != nil {
+		return &proto.NotificationArr{}, err
+	}
+	if err = checkDate(filter.To); err != nil {
+		return &proto.NotificationArr{}, err
+	}
+
+	if filter.Accepted != "" && filter.Accepted != constants.NotificationsAccepted && filter.Accepted != constants.NotificationsPending {
+		return &proto.NotificationArr{}, status.Error(codes.InvalidArgument, constants.ErrWrongFilter.Error())
+	}
+
+	has, _, family, isAdult, err := s.storage.HasFamily(filter.UserID)
+	if err != nil {
+		return &proto.NotificationArr{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if !has || (has && !isAdult) {
+		notifications, cursor, err := s.storage.GetNotifications(filter.UserID, filter)
+		if err != nil {
+			return &proto.NotificationArr{}, pageError(err)
+		}
+		return &proto.NotificationArr{GetNotificationData: notifications, NextCursor: cursor}, nil
+	}
+
+	notifications, cursor, err := s.storage.GetNotificationsFamily(family, filter)
+	if err != nil {
+		return &proto.NotificationArr{}, pageError(err)
+	}
+
+	return &proto.NotificationArr{GetNotificationData: notifications, NextCursor: cursor}, nil
+}
+
+func (s *Service) AcceptNotification(ctx context.Context, acceptData *proto.Accept) (*proto.Empty, error) {
+	idMedicine, err := s.storage.AcceptNotification(acceptData)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if acceptData.Count > 0 {
+		err = s.storage.Substruct(idMedicine, acceptData.UserID, acceptData.Count)
+		if err != nil {
+			return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+		}
+
+		err = s.storage.AddLowStockItem(idMedicine)
+		if err != nil {
+			return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &proto.Empty{}, nil
+}
+
+// CreateCalendarToken выдаёт ссылку на календарь приёмов человека; взрослые члены семьи
+// могут подписаться на календарь подопечного
+func (s *Service) CreateCalendarToken(ctx context.Context, person *proto.Person) (*proto.CalendarToken, error) {
+	err := s.checkPersonAccess(person.UserID, person.IsUser, person.IDPerson)
+	if err != nil {
+		return &proto.CalendarToken{}, err
+	}
+
+	token, err := s.storage.SetCalendarToken(person.UserID, person.IsUser, person.IDPerson)
+	if err != nil {
+		return &proto.CalendarToken{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.CalendarToken{Token: token}, nil
+}
+
+func (s *Service) RevokeCalendarToken(ctx context.Context, person *proto.Person) (*proto.Empty, error) {
+	deleted, err := s.storage.DeleteCalendarToken(person.UserID, person.IsUser, person.IDPerson)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if !deleted {
+		return &proto.Empty{}, status.Error(codes.NotFound, constants.ErrNoCalendar.Error())
+	}
+
+	return &proto.Empty{}, nil
+}
+
+// GetCalendar возвращает приёмы по токену подписки; доступ к человеку проверяется заново,
+// чтобы ушедший из семьи пользователь больше не видел его календарь
+func (s *Service) GetCalendar(ctx context.Context, token *proto.CalendarToken) (*proto.CalendarFeed, error) {
+	person, err := s.storage.GetCalendarPerson(token.Token)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.CalendarFeed{}, status.Error(codes.NotFound, constants.ErrNoCalendar.Error())
+	}
+	if err != nil {
+		return &proto.CalendarFeed{}, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.checkPersonAccess(person.UserID, person.IsUser, person.IDPerson)
+	if err != nil {
+		if status.Code(err) == codes.PermissionDenied {
+			return &proto.CalendarFeed{}, status.Error(codes.NotFound, constants.ErrNoCalendar.Error())
+		}
+		return &proto.CalendarFeed{}, err
+	}
+
+	name, err := s.storage.GetPersonName(person.IsUser, person.IDPerson)
+	if err != nil {
+		return &proto.CalendarFeed{}, status.Error(codes.Internal, err.Error())
+	}
+
+	events, err := s.storage.GetCalendarEvents(person.IsUser, person.IDPerson, constants.CalendarHistoryDays)
+	if err != nil {
+		return &proto.CalendarFeed{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.CalendarFeed{Name: name, Events: events}, nil
+}
+
+// reportPeriod проверяет период отчёта; без дат отчёт строится за последние ReportDays дней.
+// Дни считаются по местному времени now
+func reportPeriod(from, to string, now time.Time) (string, string, error) {
+	if err := checkDate(from); err != nil {
+		return "", "", err
+	}
+	if err := checkDate(to); err != nil {
+		return "", "", err
+	}
+
+	if to == "" {
+		to = now.Format("2006-01-02")
+	}
+	end, _ := time.Parse("2006-01-02", to)
+	if from == "" {
+		from = end.AddDate(0, 0, 1-constants.ReportDays).Format("2006-01-02")
+	}
+	start, _ := time.Parse("2006-01-02", from)
+
+	if start.After(end) || end.Sub(start) > constants.ReportMaxDays*24*time.Hour {
+		return "", "", status.Error(codes.InvalidArgument, constants.ErrWrongPeriod.Error())
+	}
+
+	return from, to, nil
+}
+
+func localTime(value string, loc *time.Location) string {
+	at, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return value
+	}
+	return at.In(loc).Format(time.RFC3339)
+}
+
+// GetAdherenceReport собирает для врача схему приёма, отмеченные и пропущенные приёмы,
+// изменения остатков за период и записанные аллергии человека
+func (s *Service) GetAdherenceReport(ctx context.Context, data *proto.ReportRequest) (*proto.AdherenceReport, error) {
+	err := s.checkPersonAccess(data.UserID, data.IsUser, data.IDPerson)
+	if err != nil {
+		return &proto.AdherenceReport{}, err
+	}
+
+	loc, err := s.personLocation(data.IsUser, data.IDPerson)
+	if err != nil {
+		return &proto.AdherenceReport{}, err
+	}
+
+	from, to, err := reportPeriod(data.From, data.To, time.Now().In(loc))
+	if err != nil {
+		return &proto.AdherenceReport{}, err
+	}
+
+	// границы периода — полночь по местному времени человека, а не сервера базы
+	start, err := schedule.Day(from, loc)
+	if err != nil {
+		return &proto.AdherenceReport{}, status.Error(codes.InvalidArgument, constants.ErrWrongPeriod.Error())
+	}
+	end, err := schedule.Day(to, loc)
+	if err != nil {
+		return &proto.AdherenceReport{}, status.Error(codes.InvalidArgument, constants.ErrWrongPeriod.Error())
+	}
+	end = end.AddDate(0, 0, 1)
+
+	name, err := s.storage.GetPersonName(data.IsUser, data.IDPerson)
+	if err != nil {
+		return &proto.AdherenceReport{}, status.Error(codes.Internal, err.Error())
+	}
+
+	health, err := s.storage.GetHealth(data.IsUser, data.IDPerson)
+	if err != nil {
+		return &proto.AdherenceReport{}, status.Error(codes.Internal, err.Error())
+	}
+
+	active, err := s.storage.GetActiveMedicines(data.IsUser, data.IDPerson)
+	if err != nil {
+		return &proto.AdherenceReport{}, status.Error(codes.Internal, err.Error())
+	}
+
+	// лекарство может быть общим для семьи, поэтому время и доза берутся только из приёмов этого человека
+	schedules, err := s.storage.GetPersonSchedules(data.IsUser, data.IDPerson)
+	if err != nil {
+		return &proto.AdherenceReport{}, status.Error(codes.Internal, err.Error())
+	}
+
+	doses, err := s.storage.GetPersonAverageDoses(data.IsUser, data.IDPerson)
+	if err != nil {
+		return &proto.AdherenceReport{}, status.Error(codes.Internal, err.Error())
+	}
+
+	regimen := make([]*proto.RegimenItem, 0, len(active))
+	for _, medicine := range active {
+		item := &proto.RegimenItem{
+			IDMedicine:  medicine.ID,
+			Name:        medicine.Medicine.Name,
+			Strength:    medicine.Medicine.Strength,
+			Unit:        medicine.Medicine.Unit,
+			Dose:        doses[medicine.ID],
+			Form:        medicine.Medicine.Form,
+			Ingredients: medicine.Medicine.Ingredients,
+		}
+
+		seen := make(map[string]bool)
+		for _, at := range schedules[medicine.ID] {
+			clock := at.In(loc).Format("15:04")
+			if !seen[clock] {
+				seen[clock] = true
+				item.Times = append(item.Times, clock)
+			}
+		}
+		sort.Strings(item.Times)
+
+		regimen = append(regimen, item)
+	}
+
+	taken, err := s.storage.GetDoses(data.IsUser, data.IDPerson, start, end)
+	if err != nil {
+		return &proto.AdherenceReport{}, status.Error(codes.Internal, err.Error())
+	}
+
+	stockEntries, err := s.storage.GetPersonStock(data.IsUser, data.IDPerson, start, end)
+	if err != nil {
+		return &proto.AdherenceReport{}, status.Error(codes.Internal, err.Error())
+	}
+
+	changes, err := s.storage.GetDoseChanges(data.IsUser, data.IDPerson, start, end)
+	if err != nil {
+		return &proto.AdherenceReport{}, status.Error(codes.Internal, err.Error())
+	}
+
+	// время в отчёте показывается по часовому поясу человека
+	for _, dose := range taken {
+		dose.Time = localTime(dose.Time, loc)
+	}
+	for _, entry := range stockEntries {
+		entry.Entry.Time = localTime(entry.Entry.Time, loc)
+	}
+	for _, change := range changes {
+		change.From = localTime(change.From, loc)
+		change.To = localTime(change.To, loc)
+	}
+
+	return &proto.AdherenceReport{
+		Name:     name,
+		From:     from,
+		To:       to,
+		Health:   health,
+		Regimen:  regimen,
+		Doses:    taken,
+		Stock:    stockEntries,
+		TimeZone: loc.String(),
+		Changes:  changes,
+	}, nil
+}
+
+func (s *Service) GetNotificationSettings(ctx context.Context, userID *proto.UserID) (*proto.NotificationSettings, error) {
+	settings, err := s.storage.GetNotificationSettings(userID.ID)
+	if err != nil {
+		return &proto.NotificationSettings{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return settings, nil
+}
+
+// EditNotificationSettings сохраняет каналы, тихие часы и режим уведомлений о приёмах членов семьи
+func (s *Service) EditNotificationSettings(ctx context.Context, settings *proto.NotificationSettings) (*proto.Empty, error) {
+	if settings.Dependents == "" {
+		settings.Dependents = preferences.DependentsCopies
+	}
+
+	err := preferences.Validate(preferences.Preferences{
+		Channels:   settings.Channels,
+		QuietFrom:  settings.QuietFrom,
+		QuietTo:    settings.QuietTo,
+		Dependents: settings.Dependents,
+	})
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.storage.EditNotificationSettings(settings)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.Empty{}, nil
+}
+
+// AddWebhook регистрирует адрес, на который будут приходить подписанные уведомления,
+// когда у пользователя включён канал webhook
+func (s *Service) AddWebhook(ctx context.Context, webhook *proto.Webhook) (*proto.Webhook, error) {
+	if err := notify.ValidateURL(webhook.URL); err != nil {
+		return &proto.Webhook{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	webhooks, err := s.storage.GetWebhooks(webhook.UserID)
+	if err != nil {
+		return &proto.Webhook{}, status.Error(codes.Internal, err.Error())
+	}
+	if len(webhooks) >= constants.MaxWebhooks {
+		return &proto.Webhook{}, status.Error(codes.InvalidArgument, constants.ErrTooManyWebhooks.Error())
+	}
+
+	webhook.Secret, err = notify.NewSecret()
+	if err != nil {
+		return &proto.Webhook{}, status.Error(codes.Internal, err.Error())
+	}
+
+	webhook, err = s.storage.AddWebhook(webhook)
+	if err != nil {
+		return &proto.Webhook{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return webhook, nil
+}
+
+func (s *Service) GetWebhooks(ctx context.Context, userID *proto.UserID) (*proto.WebhookArr, error) {
+	webhooks, err := s.storage.GetWebhooks(userID.ID)
+	if err != nil {
+		return &proto.WebhookArr{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.WebhookArr{Webhooks: webhooks}, nil
+}
+
+func (s *Service) DeleteWebhook(ctx context.Context, data *proto.WebhookRequest) (*proto.Empty, error) {
+	deleted, err := s.storage.DeleteWebhook(data.UserID, data.ID)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if !deleted {
+		return &proto.Empty{}, status.Error(codes.NotFound, constants.ErrNoWebhook.Error())
+	}
+
+	return &proto.Empty{}, nil
+}
+
+// CreateTelegramCode выдаёт одноразовый код, который пользователь отправляет боту командой /start
+func (s *Service) CreateTelegramCode(ctx context.Context, userID *proto.UserID) (*proto.TelegramCode, error) {
+	code, err := telegram.NewCode()
+	if err != nil {
+		return &proto.TelegramCode{}, status.Error(codes.Internal, err.Error())
+	}
+
+	expires := time.Now().Add(constants.TelegramCodeMinutes * time.Minute)
+	err = s.storage.SetTelegramCode(userID.ID, code, expires)
+	if err != nil {
+		return &proto.TelegramCode{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.TelegramCode{Code: code, Expires: expires.UTC().Format(time.RFC3339)}, nil
+}
+
+func (s *Service) LinkTelegram(ctx context.Context, link *proto.TelegramLink) (*proto.Empty, error) {
+	err := s.storage.LinkTelegram(link.Code, link.ChatID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.Empty{}, status.Error(codes.NotFound, constants.ErrWrongTelegramCode.Error())
+	}
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.Empty{}, nil
+}
+
+func (s *Service) UnlinkTelegram(ctx context.Context, userID *proto.UserID) (*proto.Empty, error) {
+	deleted, err := s.storage.UnlinkTelegram(userID.ID)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if !deleted {
+		return &proto.Empty{}, status.Error(codes.NotFound, constants.ErrNoTelegram.Error())
+	}
+
+	return &proto.Empty{}, nil
+}
+
+// HandleTelegramCallback выполняет действие кнопки под напоминанием от имени владельца чата.
+// «Принял» отмечает приём так же, как AcceptNotification, списывая среднюю дозу по журналу остатков;
+// «Пропустить» оставляет приём неотмеченным и записывает отказ в историю приёмов, «Отложить» присылает напоминание позже
+func (s *Service) HandleTelegramCallback(ctx context.Context, callback *proto.TelegramCallback) (*proto.Empty, error) {
+	userID, err := s.storage.GetTelegramUser(callback.ChatID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.Empty{}, status.Error(codes.NotFound, constants.ErrNoTelegram.Error())
+	}
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	person, idMedicine, err := s.pendingNotification(userID, callback.IDNotification)
+	if err != nil {
+		return &proto.Empty{}, err
+	}
+
+	switch callback.Action {
+	case telegram.ActionTaken:
+		// списывается обычная доза получателя, а если он ещё не отмечал приёмы — средняя доза по лекарству
+		personDoses, err := s.storage.GetPersonAverageDoses(person.IsUser, person.IDPerson)
+		if err != nil {
+			return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+		}
+
+		doses, err := s.storage.GetAverageDoses([]int64{idMedicine})
+		if err != nil {
+			return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+		}
+
+		return s.AcceptNotification(ctx, &proto.Accept{
+			ID:     callback.IDNotification,
+			Count:  telegram.TakenCount(personDoses[idMedicine], doses[idMedicine]),
+			UserID: userID,
+		})
+	case telegram.ActionSkip:
+		skipped, err := s.storage.SkipNotification(callback.IDNotification, userID)
+		if err != nil {
+			return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+		}
+		if !skipped {
+			return &proto.Empty{}, status.Error(codes.AlreadyExists, constants.ErrNotificationAccepted.Error())
+		}
+		return &proto.Empty{}, nil
+	case telegram.ActionSnooze:
+		_, err = s.RescheduleNotification(ctx, &proto.RescheduleData{
+			UserID:  userID,
+			ID:      callback.IDNotification,
+			Minutes: constants.SnoozeMinutes,
+		})
+		if err != nil {
+			return &proto.Empty{}, err
+		}
+		return &proto.Empty{}, nil
+	default:
+		return &proto.Empty{}, status.Error(codes.InvalidArgument, telegram.ErrWrongCallback.Error())
+	}
+}
+
+// pendingNotification проверяет, что пользователь может отметить или перенести приём
+// по напоминанию и что приём ещё не отмечен; возвращает получателя и лекарство
+func (s *Service) pendingNotification(userID, idNotification int64) (*proto.Person, int64, error) {
+	person, idMedicine, accepted, err := s.storage.GetNotificationPerson(idNotification)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, 0, status.Error(codes.NotFound, constants.ErrNoNotification.Error())
+	}
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.checkPersonAccess(userID, person.IsUser, person.IDPerson)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if accepted {
+		return nil, 0, status.Error(codes.AlreadyExists, constants.ErrNotificationAccepted.Error())
+	}
+
+	return person, idMedicine, nil
+}
+
+// RescheduleNotification откладывает один приём на Minutes минут или переносит его на момент Time
+// (RFC3339) либо на местное время Clock дня Day; остальные напоминания курса не меняются.
+// Приём можно перенести не дальше чем на SnoozeMaxMinutes после последнего приёма курса.
+// Перенос записывается в историю приёмов, а cron присылает напоминание в новое время
+func (s *Service) RescheduleNotification(ctx context.Context, data *proto.RescheduleData) (*proto.RescheduleResult, error) {
+	person, _, err := s.pendingNotification(data.UserID, data.ID)
+	if err != nil {
+		return &proto.RescheduleResult{}, err
+	}
+
+	now := time.Now()
+	var at time.Time
+	var action string
+	switch {
+	case data.Minutes != 0 && data.Time == "" && data.Clock == "":
+		if data.Minutes < 1 || data.Minutes > constants.SnoozeMaxMinutes {
+			return &proto.RescheduleResult{}, status.Error(codes.InvalidArgument, constants.ErrWrongReschedule.Error())
+		}
+		at = now.Truncate(time.Minute).Add(time.Duration(data.Minutes) * time.Minute)
+		action = constants.DoseSnoozed
+	case data.Minutes == 0 && data.Clock != "" && data.Time == "":
+		at, err = s.doseTime(person.IsUser, person.IDPerson, data.Clock, data.Day)
+		if err != nil {
+			return &proto.RescheduleResult{}, err
+		}
+		action = constants.DoseRescheduled
+	case data.Minutes == 0 && data.Time != "" && data.Clock == "":
+		at, err = time.Parse(time.RFC3339, data.Time)
+		if err != nil {
+			return &proto.RescheduleResult{}, status.Error(codes.InvalidArgument, schedule.ErrWrongClock.Error())
+		}
+		action = constants.DoseRescheduled
+	default:
+		return &proto.RescheduleResult{}, status.Error(codes.InvalidArgument, constants.ErrWrongReschedule.Error())
+	}
+
+	if !at.After(now) {
+		return &proto.RescheduleResult{}, status.Error(codes.InvalidArgument, constants.ErrWrongReschedule.Error())
+	}
+
+	end, err := s.storage.GetCourseEnd(data.ID)
+	if err != nil {
+		return &proto.RescheduleResult{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if at.After(end.Add(constants.SnoozeMaxMinutes * time.Minute)) {
+		return &proto.RescheduleResult{}, status.Error(codes.InvalidArgument, constants.ErrWrongReschedule.Error())
+	}
+
+	moved, err := s.storage.RescheduleNotification(data.ID, data.UserID, at, action)
+	if err != nil {
+		return &proto.RescheduleResult{}, status.Error(codes.Internal, err.Error())
+	}
+	if !moved {
+		return &proto.RescheduleResult{}, status.Error(codes.AlreadyExists, constants.ErrNotificationAccepted.Error())
+	}
+
+	loc, err := s.personLocation(person.IsUser, person.IDPerson)
+	if err != nil {
+		return &proto.RescheduleResult{}, err
+	}
+
+	return &proto.RescheduleResult{Time: at.In(loc).Format(time.RFC3339)}, nil
+}
+
+func (s *Service) AddPushSubscription(ctx context.Context, subscription *proto.PushSubscription) (*proto.Empty, error) {
+	err := webpush.Validate(webpush.Subscription{
+		Endpoint: subscription.Endpoint,
+		P256dh:   subscription.P256Dh,
+		Auth:     subscription.Auth,
+	})
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	added, err := s.storage.AddPushSubscription(subscription)
+	if errors.Is(err, constants.ErrNoSession) {
+		return &proto.Empty{}, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if !added {
+		return &proto.Empty{}, status.Error(codes.AlreadyExists, constants.ErrPushSubscriptionTaken.Error())
+	}
+
+	return &proto.Empty{}, nil
+}
+
+func (s *Service) DeletePushSubscription(ctx context.Context, subscription *proto.PushSubscription) (*proto.Empty, error) {
+	deleted, err := s.storage.DeletePushSubscription(subscription.UserID, subscription.Endpoint)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if !deleted {
+		return &proto.Empty{}, status.Error(codes.NotFound, constants.ErrNoPushSubscription.Error())
+	}
+
+	return &proto.Empty{}, nil
+}
+
+func prnRule(rule *proto.PRNRule) asneeded.Rule {
+	return asneeded.Rule{MinInterval: time.Duration(rule.MinInterval) * time.Minute, MaxDaily: rule.MaxDaily}
+}
+
+// checkPRNAccess проверяет доступ и к человеку, и к лекарству из аптечки
+func (s *Service) checkPRNAccess(userID int64, isUser bool, idPerson, idMedicine int64) error {
+	err := s.checkPersonAccess(userID, isUser, idPerson)
+	if err != nil {
+		return err
+	}
+
+	return s.checkMedicineAccess(userID, idMedicine)
+}
+
+// SetPRNRule задаёт для лекарства, принимаемого по необходимости, наименьший интервал между
+// приёмами в минутах и суточный максимум; нулевое значение снимает соответствующее ограничение
+func (s *Service) SetPRNRule(ctx context.Context, rule *proto.PRNRule) (*proto.Empty, error) {
+	// интервал проверяется до перевода в time.Duration, чтобы избежать переполнения
+	if rule.MinInterval > int64(asneeded.Window/time.Minute) || rule.MaxDaily > math.MaxInt32 {
+		return &proto.Empty{}, status.Error(codes.InvalidArgument, asneeded.ErrWrongRule.Error())
+	}
+
+	err := asneeded.Validate(prnRule(rule))
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.checkPRNAccess(rule.UserID, rule.IsUser, rule.IDPerson, rule.IDMedicine)
+	if err != nil {
+		return &proto.Empty{}, err
+	}
+
+	err = s.storage.SetPRNRule(rule)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.Empty{}, nil
+}
+
+// GetPRNRules возвращает правила человека вместе с принятым за последние сутки
+// и временем, начиная с которого можно принять ещё одну единицу
+func (s *Service) GetPRNRules(ctx context.Context, person *proto.Person) (*proto.PRNRuleArr, error) {
+	err := s.checkPersonAccess(person.UserID, person.IsUser, person.IDPerson)
+	if err != nil {
+		return &proto.PRNRuleArr{}, err
+	}
+
+	rules, err := s.storage.GetPRNRules(person.IsUser, person.IDPerson)
+	if err != nil {
+		return &proto.PRNRuleArr{}, status.Error(codes.Internal, err.Error())
+	}
+
+	loc, err := s.personLocation(person.IsUser, person.IDPerson)
+	if err != nil {
+		return &proto.PRNRuleArr{}, err
+	}
+
+	now := time.Now()
+	for _, rule := range rules {
+		rule.UserID = person.UserID
+
+		history, err := s.storage.GetPRNIntakes(person.IsUser, person.IDPerson, rule.IDMedicine, now.Add(-asneeded.Window))
+		if err != nil {
+			return &proto.PRNRuleArr{}, status.Error(codes.Internal, err.Error())
+		}
+
+		taken, last := asneeded.Taken(history, now)
+		rule.Taken = taken
+		if !last.IsZero() {
+			rule.LastTime = last.In(loc).Format(time.RFC3339)
+		}
+		if next := asneeded.NextAllowed(prnRule(rule), history, now, 1); !next.IsZero() {
+			rule.NextAllowed = next.In(loc).Format(time.RFC3339)
+		}
+	}
+
+	return &proto.PRNRuleArr{Rules: rules}, nil
+}
+
+func (s *Service) DeletePRNRule(ctx context.Context, rule *proto.PRNRule) (*proto.Empty, error) {
+	err := s.checkPersonAccess(rule.UserID, rule.IsUser, rule.IDPerson)
+	if err != nil {
+		return &proto.Empty{}, err
+	}
+
+	deleted, err := s.storage.DeletePRNRule(rule.IsUser, rule.IDPerson, rule.IDMedicine)
+	if err != nil {
+		return &proto.Empty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if !deleted {
+		return &proto.Empty{}, status.Error(codes.NotFound, constants.ErrNoPRNRule.Error())
+	}
+
+	return &proto.Empty{}, nil
+}
+
+// LogPRNIntake записывает приём лекарства по необходимости. Если приём нарушает интервал или
+// суточный максимум, он не записывается без Override, а в ответе указано, когда приём будет допустим.
+// Остаток уменьшается так же, как при отметке приёма по напоминанию
+func (s *Service) LogPRNIntake(ctx context.Context, intake *proto.PRNIntake) (*proto.PRNResult, error) {
+	if intake.Count < 1 || intake.Count > math.MaxInt32 {
+		return &proto.PRNResult{}, status.Error(codes.InvalidArgument, constants.ErrWrongCount.Error())
+	}
+
+	err := s.checkPRNAccess(intake.UserID, intake.IsUser, intake.IDPerson, intake.IDMedicine)
+	if err != nil {
+		return &proto.PRNResult{}, err
+	}
+
+	now := time.Now()
+	rule, history, found, err := s.storage.AddPRNIntake(intake, now)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.PRNResult{}, status.Error(codes.NotFound, constants.ErrNoPRNRule.Error())
+	}
+	if err != nil {
+		return &proto.PRNResult{}, status.Error(codes.Internal, err.Error())
+	}
+
+	warnings := make([]*proto.Warning, 0)
+	for _, warning := range found {
+		warnings = append(warnings, &proto.Warning{
+			Type:     warning.Type,
+			Message:  warning.Message,
+			Severity: interactions.SeverityMajor,
+		})
+	}
+
+	if len(warnings) != 0 && !intake.Override {
+		loc, err := s.personLocation(intake.IsUser, intake.IDPerson)
+		if err != nil {
+			return &proto.PRNResult{}, err
+		}
+
+		result := &proto.PRNResult{Added: false, Warnings: warnings}
+		if next := asneeded.NextAllowed(rule, history, now, intake.Count); !next.IsZero() {
+			result.NextAllowed = next.In(loc).Format(time.RFC3339)
+		}
+		return result, nil
+	}
+
+	return &proto.PRNResult{Added: true, Warnings: warnings}, nil
+}
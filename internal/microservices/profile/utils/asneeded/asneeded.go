@@ -0,0 +1,126 @@
+package asneeded
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Window — период, за который считается суточный максимум
+const Window = 24 * time.Hour
+
+// Типы предупреждений о нарушении правил приёма по необходимости
+const (
+	WarningInterval = "min_interval"
+	WarningDailyMax = "daily_max"
+)
+
+var ErrWrongRule = errors.New("wrong as-needed rule")
+
+// Rule — ограничения приёма лекарства по необходимости: наименьший интервал между приёмами
+// и наибольшее количество за сутки в единицах учёта лекарства; нулевое значение снимает ограничение
+type Rule struct {
+	MinInterval time.Duration
+	MaxDaily    int64
+}
+
+type Intake struct {
+	Time  time.Time
+	Count int64
+}
+
+type Warning struct {
+	Type    string
+	Message string
+}
+
+func Validate(rule Rule) error {
+	if rule.MinInterval < 0 || rule.MinInterval > Window || rule.MaxDaily < 0 {
+		return ErrWrongRule
+	}
+	if rule.MinInterval == 0 && rule.MaxDaily == 0 {
+		return ErrWrongRule
+	}
+	return nil
+}
+
+// Taken возвращает количество, принятое за сутки до at, и время последнего приёма
+func Taken(history []Intake, at time.Time) (int64, time.Time) {
+	var total int64
+	var last time.Time
+	for _, intake := range history {
+		if intake.Time.After(at) || !intake.Time.After(at.Add(-Window)) {
+			continue
+		}
+		total += intake.Count
+		if intake.Time.After(last) {
+			last = intake.Time
+		}
+	}
+	return total, last
+}
+
+// Check сверяет приём count единиц в момент at с правилом и приёмами за последние сутки
+func Check(rule Rule, history []Intake, at time.Time, count int64) []Warning {
+	warnings := make([]Warning, 0)
+	total, last := Taken(history, at)
+
+	if rule.MinInterval > 0 && !last.IsZero() && at.Sub(last) < rule.MinInterval {
+		warnings = append(warnings, Warning{
+			Type:    WarningInterval,
+			Message: "minimum interval between doses is " + strconv.FormatInt(int64(rule.MinInterval/time.Minute), 10) + " minutes",
+		})
+	}
+
+	if rule.MaxDaily > 0 && total+count > rule.MaxDaily {
+		warnings = append(warnings, Warning{
+			Type: WarningDailyMax,
+			Message: "daily maximum is " + strconv.FormatInt(rule.MaxDaily, 10) + ", already taken " +
+				strconv.FormatInt(total, 10) + " in 24 hours",
+		})
+	}
+
+	return warnings
+}
+
+// NextAllowed возвращает ближайший момент не раньше at, когда приём count единиц не нарушит правило.
+// Нулевое время означает, что count больше суточного максимума и допустимого момента нет
+func NextAllowed(rule Rule, history []Intake, at time.Time, count int64) time.Time {
+	if rule.MaxDaily > 0 && count > rule.MaxDaily {
+		return time.Time{}
+	}
+
+	next := at
+	recent := make([]Intake, 0, len(history))
+	for _, intake := range history {
+		if !intake.Time.After(at) && intake.Time.After(at.Add(-Window)) {
+			recent = append(recent, intake)
+		}
+	}
+	sort.Slice(recent, func(i, j int) bool {
+		return recent[i].Time.Before(recent[j].Time)
+	})
+
+	if rule.MinInterval > 0 && len(recent) != 0 {
+		if allowed := recent[len(recent)-1].Time.Add(rule.MinInterval); allowed.After(next) {
+			next = allowed
+		}
+	}
+
+	if rule.MaxDaily > 0 {
+		total, _ := Taken(recent, at)
+		// самые ранние приёмы по очереди выходят из суточного окна
+		for _, intake := range recent {
+			if total+count <= rule.MaxDaily {
+				break
+			}
+			total -= intake.Count
+			if expires := intake.Time.Add(Window); expires.After(next) {
+				next = expires
+			}
+		}
+	}
+
+	return next
+}
@@ -0,0 +1,100 @@
+package asneeded
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+var base = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+var rule = Rule{MinInterval: 4 * time.Hour, MaxDaily: 4}
+
+var history = []Intake{
+	{Time: base.Add(-2 * time.Hour), Count: 1},
+	{Time: base.Add(-10 * time.Hour), Count: 2},
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		rule Rule
+		want error
+	}{
+		{"interval only", Rule{MinInterval: 4 * time.Hour}, nil},
+		{"daily maximum only", Rule{MaxDaily: 3}, nil},
+		{"both", rule, nil},
+		{"no limits", Rule{}, ErrWrongRule},
+		{"negative interval", Rule{MinInterval: -time.Hour, MaxDaily: 3}, ErrWrongRule},
+		{"interval longer than a day", Rule{MinInterval: 25 * time.Hour}, ErrWrongRule},
+		{"negative maximum", Rule{MinInterval: time.Hour, MaxDaily: -1}, ErrWrongRule},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := Validate(test.rule); !errors.Is(err, test.want) {
+				t.Errorf("Validate(%+v) = %v, want %v", test.rule, err, test.want)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name    string
+		history []Intake
+		at      time.Time
+		count   int64
+		want    []string
+	}{
+		{"first dose", nil, base, 4, nil},
+		{"too soon", history, base, 1, []string{WarningInterval}},
+		{"reaches the maximum", history, base.Add(2 * time.Hour), 1, nil},
+		{"over the maximum", history, base.Add(2 * time.Hour), 2, []string{WarningDailyMax}},
+		{"too soon and over the maximum", history, base, 2, []string{WarningInterval, WarningDailyMax}},
+		{"earlier dose left the window", history, base.Add(14 * time.Hour), 3, nil},
+		{"dose exactly a day ago is not counted", []Intake{{Time: base.Add(-Window), Count: 4}}, base, 4, nil},
+		{"later dose is not counted", []Intake{{Time: base.Add(time.Hour), Count: 4}}, base, 1, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			warnings := Check(rule, test.history, test.at, test.count)
+			if len(warnings) != len(test.want) {
+				t.Fatalf("Check() = %+v, want types %v", warnings, test.want)
+			}
+			for i, warning := range warnings {
+				if warning.Type != test.want[i] {
+					t.Errorf("warning %d type = %q, want %q", i, warning.Type, test.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestNextAllowed(t *testing.T) {
+	tests := []struct {
+		name    string
+		history []Intake
+		count   int64
+		want    time.Time
+	}{
+		{"no history", nil, 1, base},
+		{"after the interval", history, 1, base.Add(2 * time.Hour)},
+		{"after the earliest dose leaves the window", history, 2, base.Add(14 * time.Hour)},
+		{"after both doses leave the window", history, 4, base.Add(22 * time.Hour)},
+		{"more than the daily maximum", history, 5, time.Time{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := NextAllowed(rule, test.history, base, test.count)
+			if !got.Equal(test.want) {
+				t.Fatalf("NextAllowed() = %v, want %v", got, test.want)
+			}
+			if !got.IsZero() && len(Check(rule, test.history, got, test.count)) != 0 {
+				t.Errorf("dose at %v still breaks the rule", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package calendar
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ContentType — тип ответа для подписки на календарь
+const ContentType = "text/calendar; charset=utf-8"
+
+const (
+	dateFormat = "20060102T150405Z"
+	lineLength = 75
+)
+
+// Event — приём лекарства в календаре; напоминание срабатывает за Alarm до начала
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	Start       time.Time
+	Duration    time.Duration
+	Alarm       time.Duration
+}
+
+var escaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// Write записывает календарь в формате iCalendar (RFC 5545)
+func Write(w io.Writer, name string, events []Event, now time.Time) error {
+	writer := bufio.NewWriter(w)
+
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//MyAidKit//Medication schedule//RU",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:" + escaper.Replace(name),
+	}
+	for _, event := range events {
+		lines = append(lines,
+			"BEGIN:VEVENT",
+			"UID:"+event.UID,
+			"DTSTAMP:"+now.UTC().Format(dateFormat),
+			"DTSTART:"+event.Start.UTC().Format(dateFormat),
+			"DTEND:"+event.Start.Add(event.Duration).UTC().Format(dateFormat),
+			"SUMMARY:"+escaper.Replace(event.Summary),
+			"DESCRIPTION:"+escaper.Replace(event.Description),
+			"BEGIN:VALARM",
+			"ACTION:DISPLAY",
+			"DESCRIPTION:"+escaper.Replace(event.Summary),
+			"TRIGGER:-PT"+formatMinutes(event.Alarm),
+			"END:VALARM",
+			"END:VEVENT",
+		)
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	for _, line := range lines {
+		if _, err := writer.WriteString(fold(line)); err != nil {
+			return err
+		}
+	}
+
+	return writer.Flush()
+}
+
+func formatMinutes(duration time.Duration) string {
+	minutes := int64(duration / time.Minute)
+	if minutes < 0 {
+		minutes = 0
+	}
+	return strconv.FormatInt(minutes, 10) + "M"
+}
+
+// fold разбивает строку длиннее 75 байт на строки продолжения, не разрывая символы utf-8
+func fold(line string) string {
+	var builder strings.Builder
+	length := 0
+	for _, r := range line {
+		size := len(string(r))
+		if length+size > lineLength {
+			builder.WriteString("\r\n ")
+			length = 1
+		}
+		builder.WriteRune(r)
+		length += size
+	}
+	builder.WriteString("\r\n")
+	return builder.String()
+}
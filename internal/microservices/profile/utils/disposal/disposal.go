@@ -0,0 +1,54 @@
+package disposal
+
+import (
+	"errors"
+	"time"
+)
+
+// Причины выбытия лекарства из аптечки
+const (
+	ReasonExpired  = "expired"
+	ReasonFinished = "finished"
+	ReasonLost     = "lost"
+	ReasonRecalled = "recalled"
+)
+
+var (
+	ErrWrongReason = errors.New("wrong dispose reason")
+	ErrWrongDate   = errors.New("wrong dispose date")
+)
+
+var reasons = map[string]interface{}{
+	ReasonExpired:  nil,
+	ReasonFinished: nil,
+	ReasonLost:     nil,
+	ReasonRecalled: nil,
+}
+
+func ValidateReason(reason string) error {
+	if _, ok := reasons[reason]; !ok {
+		return ErrWrongReason
+	}
+	return nil
+}
+
+// Date проверяет дату выбытия в формате 2006-01-02: пустая дата означает сегодня,
+// дата из будущего или раньше начала срока хранения корзины не принимается
+func Date(date string, now time.Time, retentionDays int) (string, error) {
+	today := now.Format("2006-01-02")
+	if len(date) == 0 {
+		return today, nil
+	}
+
+	disposed, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return "", ErrWrongDate
+	}
+
+	date = disposed.Format("2006-01-02")
+	if date > today || date < now.AddDate(0, 0, -retentionDays).Format("2006-01-02") {
+		return "", ErrWrongDate
+	}
+
+	return date, nil
+}
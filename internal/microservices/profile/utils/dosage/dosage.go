@@ -0,0 +1,94 @@
+package dosage
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	FormTablet   = "tablet"
+	FormCapsule  = "capsule"
+	FormSyrup    = "syrup"
+	FormDrops    = "drops"
+	FormOintment = "ointment"
+	FormSpray    = "spray"
+	FormAmpoule  = "ampoule"
+
+	UnitPieces = "pieces"
+	UnitML     = "ml"
+	UnitG      = "g"
+)
+
+var (
+	ErrWrongForm = errors.New("wrong dosage form")
+	ErrWrongUnit = errors.New("wrong unit of measure")
+)
+
+// forms сопоставляет лекарственной форме единицу учёта запаса по умолчанию
+var forms = map[string]string{
+	FormTablet:   UnitPieces,
+	FormCapsule:  UnitPieces,
+	FormSyrup:    UnitML,
+	FormDrops:    UnitML,
+	FormOintment: UnitG,
+	FormSpray:    UnitML,
+	FormAmpoule:  UnitPieces,
+}
+
+var units = map[string]interface{}{
+	UnitPieces: nil,
+	UnitML:     nil,
+	UnitG:      nil,
+}
+
+// Normalize проверяет форму и единицу измерения и подставляет единицу по умолчанию.
+// Пустая форма допустима для лекарств, добавленных до появления форм: тогда единица
+// определяется старым признаком is_tablets
+func Normalize(form, unit string, isTablets bool) (string, string, error) {
+	if form != "" {
+		defaultUnit, ok := forms[form]
+		if !ok {
+			return "", "", ErrWrongForm
+		}
+		if unit == "" {
+			unit = defaultUnit
+		}
+	}
+
+	if unit == "" && isTablets {
+		unit = UnitPieces
+	}
+
+	if unit != "" {
+		if _, ok := units[unit]; !ok {
+			return "", "", ErrWrongUnit
+		}
+	}
+
+	return form, unit, nil
+}
+
+// Countable сообщает, ведётся ли для лекарства учёт остатка
+func Countable(unit string) bool {
+	_, ok := units[unit]
+	return ok
+}
+
+// Ingredients приводит список действующих веществ к нижнему регистру и убирает повторы.
+// Элементы списка могут содержать несколько веществ через запятую
+func Ingredients(names []string) []string {
+	result := make([]string, 0, len(names))
+	seen := make(map[string]bool)
+	for _, name := range names {
+		for _, part := range strings.Split(name, ",") {
+			part = strings.ToLower(strings.TrimSpace(part))
+			if len(part) == 0 || seen[part] {
+				continue
+			}
+			seen[part] = true
+			result = append(result, part)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,143 @@
+package fhir
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// ContentType — тип ответа с ресурсами FHIR в JSON
+const ContentType = "application/fhir+json; charset=utf-8"
+
+// Типы и статусы ресурсов FHIR R4, которые использует выгрузка
+const (
+	StatementActive        = "active"
+	StatementCompleted     = "completed"
+	AdministrationDone     = "completed"
+	AdministrationNotDone  = "not-done"
+	BundleTypeCollection   = "collection"
+	ResourcePatient        = "Patient"
+	ResourceMedication     = "Medication"
+	ResourceStatement      = "MedicationStatement"
+	ResourceAdministration = "MedicationAdministration"
+)
+
+type CodeableConcept struct {
+	Text string `json:"text"`
+}
+
+type Reference struct {
+	Reference string `json:"reference"`
+	Display   string `json:"display,omitempty"`
+}
+
+type HumanName struct {
+	Text string `json:"text"`
+}
+
+type Quantity struct {
+	Value float64 `json:"value"`
+	Unit  string  `json:"unit,omitempty"`
+}
+
+type Patient struct {
+	ResourceType string      `json:"resourceType"`
+	ID           string      `json:"id"`
+	Name         []HumanName `json:"name"`
+	BirthDate    string      `json:"birthDate,omitempty"`
+}
+
+type Ingredient struct {
+	ItemCodeableConcept CodeableConcept `json:"itemCodeableConcept"`
+}
+
+type Medication struct {
+	ResourceType string           `json:"resourceType"`
+	ID           string           `json:"id"`
+	Code         CodeableConcept  `json:"code"`
+	Form         *CodeableConcept `json:"form,omitempty"`
+	Ingredient   []Ingredient     `json:"ingredient,omitempty"`
+}
+
+type TimingRepeat struct {
+	TimeOfDay []string `json:"timeOfDay"`
+}
+
+type Timing struct {
+	Repeat TimingRepeat `json:"repeat"`
+}
+
+type DoseAndRate struct {
+	DoseQuantity Quantity `json:"doseQuantity"`
+}
+
+type Dosage struct {
+	Text        string        `json:"text,omitempty"`
+	Timing      *Timing       `json:"timing,omitempty"`
+	DoseAndRate []DoseAndRate `json:"doseAndRate,omitempty"`
+}
+
+type MedicationStatement struct {
+	ResourceType        string    `json:"resourceType"`
+	ID                  string    `json:"id"`
+	Status              string    `json:"status"`
+	MedicationReference Reference `json:"medicationReference"`
+	Subject             Reference `json:"subject"`
+	Dosage              []Dosage  `json:"dosage,omitempty"`
+}
+
+type MedicationAdministration struct {
+	ResourceType        string    `json:"resourceType"`
+	ID                  string    `json:"id"`
+	Status              string    `json:"status"`
+	MedicationReference Reference `json:"medicationReference"`
+	Subject             Reference `json:"subject"`
+	EffectiveDateTime   string    `json:"effectiveDateTime"`
+}
+
+type Entry struct {
+	FullURL  string      `json:"fullUrl"`
+	Resource interface{} `json:"resource"`
+}
+
+type Bundle struct {
+	ResourceType string  `json:"resourceType"`
+	Type         string  `json:"type"`
+	Timestamp    string  `json:"timestamp"`
+	Entry        []Entry `json:"entry"`
+}
+
+func NewBundle(now time.Time) *Bundle {
+	return &Bundle{
+		ResourceType: "Bundle",
+		Type:         BundleTypeCollection,
+		Timestamp:    now.UTC().Format(time.RFC3339),
+		Entry:        make([]Entry, 0),
+	}
+}
+
+// namespace — пространство имён, в котором из типа и id ресурса получается его постоянный UUID
+var namespace = uuid.NewV5(uuid.NamespaceURL, "urn:myaidkit:fhir")
+
+// Add добавляет ресурс в пакет под адресом URN; ресурсы ссылаются друг на друга этими же адресами
+func (b *Bundle) Add(resourceType, id string, resource interface{}) {
+	b.Entry = append(b.Entry, Entry{FullURL: URN(resourceType, id), Resource: resource})
+}
+
+// URN возвращает адрес ресурса вида urn:uuid:..., одинаковый для одного ресурса во всех выгрузках
+func URN(resourceType, id string) string {
+	return "urn:uuid:" + uuid.NewV5(namespace, resourceType+"/"+id).String()
+}
+
+// TimeOfDay переводит время приёма 15:04 в формат FHIR time
+func TimeOfDay(clock string) string {
+	return clock + ":00"
+}
+
+func (b *Bundle) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(b)
+}
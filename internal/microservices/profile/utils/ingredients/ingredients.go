@@ -0,0 +1,191 @@
+package ingredients
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	WarningAllergy = "allergy"
+	WarningAge     = "age"
+)
+
+type Ingredient struct {
+	Name   string
+	Group  string
+	MinAge int
+}
+
+type Warning struct {
+	Type       string
+	Ingredient string
+	Message    string
+}
+
+// Dataset — справочник действующих веществ, загружаемый из локального csv-файла
+// со строками вида: name,ingredient,group,min_age
+type Dataset struct {
+	medicines  map[string][]Ingredient
+	substances map[string]Ingredient
+}
+
+func Load(path string) (*Dataset, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return Parse(file)
+}
+
+func Parse(r io.Reader) (*Dataset, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 4
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, errors.New("empty ingredients dataset")
+	}
+
+	d := &Dataset{medicines: make(map[string][]Ingredient), substances: make(map[string]Ingredient)}
+	// первая строка — заголовок
+	for _, record := range records[1:] {
+		minAge, err := strconv.Atoi(record[3])
+		if err != nil {
+			return nil, err
+		}
+
+		name := strings.ToLower(record[0])
+		ingredient := Ingredient{
+			Name:   strings.ToLower(record[1]),
+			Group:  strings.ToLower(record[2]),
+			MinAge: minAge,
+		}
+		d.medicines[name] = append(d.medicines[name], ingredient)
+
+		// у одного вещества в разных лекарствах бывают разные возрастные ограничения,
+		// для вещества без торгового названия берём наименее строгое
+		if known, ok := d.substances[ingredient.Name]; ok {
+			if known.MinAge < ingredient.MinAge {
+				ingredient.MinAge = known.MinAge
+			}
+			if ingredient.Group == "" {
+				ingredient.Group = known.Group
+			}
+		}
+		d.substances[ingredient.Name] = ingredient
+	}
+
+	return d, nil
+}
+
+// Find возвращает действующие вещества лекарства, название которого
+// совпадает с торговым названием из справочника или начинается с него
+func (d *Dataset) Find(medicine string) []Ingredient {
+	medicine = strings.ToLower(strings.TrimSpace(medicine))
+	if found, ok := d.medicines[medicine]; ok {
+		return found
+	}
+
+	result := make([]Ingredient, 0)
+	seen := make(map[string]bool)
+	for name, found := range d.medicines {
+		if !strings.HasPrefix(medicine, name+" ") && !strings.HasPrefix(medicine, name+"-") {
+			continue
+		}
+		for _, ingredient := range found {
+			if !seen[ingredient.Name] {
+				seen[ingredient.Name] = true
+				result = append(result, ingredient)
+			}
+		}
+	}
+
+	return result
+}
+
+// Resolve возвращает сведения о перечисленных действующих веществах.
+// Вещества, которых нет в справочнике, возвращаются без группы и возрастных ограничений
+func (d *Dataset) Resolve(names []string) []Ingredient {
+	result := make([]Ingredient, 0, len(names))
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if ingredient, ok := d.substances[name]; ok {
+			result = append(result, ingredient)
+			continue
+		}
+		result = append(result, Ingredient{Name: name})
+	}
+
+	return result
+}
+
+// Check сверяет действующие вещества с аллергиями и возрастом человека
+func Check(medicine string, found []Ingredient, allergies []string, birthDate string, now time.Time) []Warning {
+	warnings := make([]Warning, 0)
+	medicine = strings.ToLower(medicine)
+
+	for _, allergy := range allergies {
+		allergy = strings.ToLower(strings.TrimSpace(allergy))
+		if len(allergy) == 0 {
+			continue
+		}
+
+		matched := false
+		for _, ingredient := range found {
+			if strings.Contains(ingredient.Name, allergy) || (ingredient.Group != "" && ingredient.Group == allergy) {
+				warnings = append(warnings, Warning{
+					Type:       WarningAllergy,
+					Ingredient: ingredient.Name,
+					Message:    "allergy to " + allergy,
+				})
+				matched = true
+			}
+		}
+
+		if !matched && strings.Contains(medicine, allergy) {
+			warnings = append(warnings, Warning{
+				Type:       WarningAllergy,
+				Ingredient: allergy,
+				Message:    "allergy to " + allergy,
+			})
+		}
+	}
+
+	birthday, err := time.Parse("2006-01-02", birthDate)
+	if err != nil {
+		return warnings
+	}
+
+	age := Age(birthday, now)
+	for _, ingredient := range found {
+		if age < ingredient.MinAge {
+			warnings = append(warnings, Warning{
+				Type:       WarningAge,
+				Ingredient: ingredient.Name,
+				Message:    "not recommended under " + strconv.Itoa(ingredient.MinAge) + " years",
+			})
+		}
+	}
+
+	return warnings
+}
+
+func Age(birthday, now time.Time) int {
+	age := now.Year() - birthday.Year()
+	if now.Month() < birthday.Month() || (now.Month() == birthday.Month() && now.Day() < birthday.Day()) {
+		age--
+	}
+	return age
+}
@@ -0,0 +1,103 @@
+package interactions
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"os"
+	"strings"
+)
+
+const (
+	SeverityMinor           = "minor"
+	SeverityModerate        = "moderate"
+	SeverityMajor           = "major"
+	SeverityContraindicated = "contraindicated"
+)
+
+var ErrWrongSeverity = errors.New("wrong interaction severity")
+
+var severities = map[string]interface{}{
+	SeverityMinor:           nil,
+	SeverityModerate:        nil,
+	SeverityMajor:           nil,
+	SeverityContraindicated: nil,
+}
+
+type Interaction struct {
+	IngredientA string
+	IngredientB string
+	Severity    string
+	Description string
+}
+
+func Load(path string) ([]Interaction, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return Parse(file)
+}
+
+// Parse читает csv со строками вида: ingredient_a,ingredient_b,severity,description
+func Parse(r io.Reader) ([]Interaction, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 4
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Interaction, 0)
+	// первая строка — заголовок
+	for i, record := range records {
+		if i == 0 {
+			continue
+		}
+
+		severity := strings.ToLower(record[2])
+		if _, ok := severities[severity]; !ok {
+			return nil, ErrWrongSeverity
+		}
+
+		a, b := Pair(record[0], record[1])
+		result = append(result, Interaction{
+			IngredientA: a,
+			IngredientB: b,
+			Severity:    severity,
+			Description: record[3],
+		})
+	}
+
+	return result, nil
+}
+
+// Pair приводит пару действующих веществ к виду, в котором она хранится в таблице
+func Pair(a, b string) (string, string) {
+	a = strings.ToLower(strings.TrimSpace(a))
+	b = strings.ToLower(strings.TrimSpace(b))
+	if a > b {
+		return b, a
+	}
+	return a, b
+}
+
+func Key(a, b string) string {
+	a, b = Pair(a, b)
+	return a + "|" + b
+}
+
+// Duplication описывает терапевтическое дублирование: одно действующее вещество в двух лекарствах
+func Duplication(ingredient string) Interaction {
+	ingredient = strings.ToLower(strings.TrimSpace(ingredient))
+	return Interaction{
+		IngredientA: ingredient,
+		IngredientB: ingredient,
+		Severity:    SeverityMajor,
+		Description: "same active ingredient in both medicines, risk of overdose",
+	}
+}
@@ -0,0 +1,164 @@
+package inventory
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+)
+
+var ErrWrongFormat = errors.New("wrong file format")
+
+// Header — столбцы csv-файла с содержимым аптечки; действующие вещества в нём перечисляются через ";"
+var Header = []string{"name", "lot", "count", "unit", "form", "strength", "ingredients", "min_count", "expiry_date", "kit", "location", "image"}
+
+// Row — лекарство в файле выгрузки. Картинка выгружается ссылкой и при загрузке не используется
+type Row struct {
+	Name        string   `json:"name"`
+	Lot         string   `json:"lot"`
+	Count       int64    `json:"count"`
+	Unit        string   `json:"unit"`
+	Form        string   `json:"form"`
+	Strength    string   `json:"strength"`
+	Ingredients []string `json:"ingredients"`
+	MinCount    int64    `json:"min_count"`
+	ExpiryDate  string   `json:"expiry_date"`
+	Kit         string   `json:"kit"`
+	Location    string   `json:"location"`
+	Image       string   `json:"image"`
+}
+
+// RowError — ошибка разбора строки файла; строки нумеруются с единицы без учёта заголовка
+type RowError struct {
+	Row     int64
+	Message string
+}
+
+func WriteCSV(w io.Writer, rows []Row) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(Header); err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		record := []string{
+			row.Name,
+			row.Lot,
+			strconv.FormatInt(row.Count, 10),
+			row.Unit,
+			row.Form,
+			row.Strength,
+			strings.Join(row.Ingredients, ";"),
+			strconv.FormatInt(row.MinCount, 10),
+			row.ExpiryDate,
+			row.Kit,
+			row.Location,
+			row.Image,
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func WriteJSON(w io.Writer, rows []Row) error {
+	return json.NewEncoder(w).Encode(rows)
+}
+
+// ParseCSV читает лекарства из csv-файла. Столбцы определяются по заголовку, поэтому их порядок
+// и отсутствие необязательных столбцов не важны; строки с ошибками возвращаются отдельно
+func ParseCSV(r io.Reader) ([]Row, []RowError, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, nil, ErrWrongFormat
+	}
+
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["name"]; !ok {
+		return nil, nil, ErrWrongFormat
+	}
+
+	rows := make([]Row, 0, len(records)-1)
+	rowErrors := make([]RowError, 0)
+	for i, record := range records[1:] {
+		number := int64(i + 1)
+		field := func(name string) string {
+			column, ok := columns[name]
+			if !ok || column >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[column])
+		}
+
+		row := Row{
+			Name:       field("name"),
+			Lot:        field("lot"),
+			Unit:       field("unit"),
+			Form:       field("form"),
+			Strength:   field("strength"),
+			ExpiryDate: field("expiry_date"),
+			Kit:        field("kit"),
+			Location:   field("location"),
+			Image:      field("image"),
+		}
+
+		if ingredients := field("ingredients"); len(ingredients) != 0 {
+			row.Ingredients = strings.Split(ingredients, ";")
+		}
+
+		if row.Count, err = parseCount(field("count")); err != nil {
+			rowErrors = append(rowErrors, RowError{Row: number, Message: "count: " + err.Error()})
+			continue
+		}
+		if row.MinCount, err = parseCount(field("min_count")); err != nil {
+			rowErrors = append(rowErrors, RowError{Row: number, Message: "min_count: " + err.Error()})
+			continue
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, rowErrors, nil
+}
+
+// ParseJSON читает лекарства из json-массива в формате WriteJSON
+func ParseJSON(r io.Reader) ([]Row, error) {
+	rows := make([]Row, 0)
+	if err := json.NewDecoder(r).Decode(&rows); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func parseCount(value string) (int64, error) {
+	if len(value) == 0 {
+		return 0, nil
+	}
+
+	count, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, errors.New("not a number")
+	}
+	return count, nil
+}
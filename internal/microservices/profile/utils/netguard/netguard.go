@@ -0,0 +1,91 @@
+package netguard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+const (
+	dialTimeout   = 5 * time.Second
+	lookupTimeout = 5 * time.Second
+)
+
+var ErrForbiddenAddress = errors.New("address is not public")
+
+// reserved — сети, которые не покрываются методами net.IP: «этот» хост 0.0.0.0/8
+// и разделяемое адресное пространство провайдеров 100.64.0.0/10
+var reserved = []*net.IPNet{
+	{IP: net.IPv4(0, 0, 0, 0), Mask: net.CIDRMask(8, 32)},
+	{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)},
+}
+
+// Public сообщает, можно ли отправлять на ip запросы, адрес которых задал пользователь:
+// loopback, частные сети, link-local, multicast и неуказанный адрес запрещены
+func Public(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+	for _, network := range reserved {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckHost разрешает имя host и проверяет, что все его адреса публичные.
+// Используется при сохранении адреса, чтобы сразу отклонить localhost и имена сервисов compose
+func CheckHost(host string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), lookupTimeout)
+	defer cancel()
+
+	addresses, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, host)
+	}
+	for _, address := range addresses {
+		if !Public(address.IP) {
+			return fmt.Errorf("%w: %s", ErrForbiddenAddress, host)
+		}
+	}
+	return nil
+}
+
+// control проверяет адрес уже после разрешения имени, поэтому имя, которое после
+// регистрации стало указывать во внутреннюю сеть, и перенаправления тоже отклоняются
+func control(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !Public(ip) {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, host)
+	}
+	return nil
+}
+
+// NewClient возвращает HTTP-клиент, который соединяется только с публичными адресами.
+// Прокси из окружения не используется: через него проверка адреса теряет смысл
+func NewClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: dialTimeout,
+		Control: control,
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   timeout,
+	}
+}
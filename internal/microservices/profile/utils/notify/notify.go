@@ -0,0 +1,58 @@
+package notify
+
+import (
+	"errors"
+	"time"
+)
+
+// События, о которых сообщают уведомления
+const (
+	EventDose     = "dose"
+	EventDoseCopy = "dose_copy"
+	EventDigest   = "digest"
+	EventLowStock = "low_stock"
+)
+
+// ErrUnavailable — временная ошибка доставки, после которой попытку стоит повторить
+var ErrUnavailable = errors.New("recipient is temporarily unavailable")
+
+// Message — уведомление, одинаковое для всех каналов: письмо содержит Text,
+// вебхук получает всё сообщение в JSON
+type Message struct {
+	Event          string    `json:"event"`
+	Text           string    `json:"text"`
+	IDNotification int64     `json:"id_notification,omitempty"`
+	Person         string    `json:"person,omitempty"`
+	Medicine       string    `json:"medicine,omitempty"`
+	Time           time.Time `json:"time"`
+}
+
+// Target — адрес получателя в канале: почта, чат Telegram, URL вебхука с секретом подписи
+// или подписка Web Push, у которой Secret — ключ auth, а Key — открытый ключ p256dh
+type Target struct {
+	Address string
+	Secret  string
+	Key     string
+}
+
+// Notifier доставляет сообщение по одному каналу
+type Notifier interface {
+	Send(target Target, message Message) error
+}
+
+// Retry — политика повторной доставки: после неудачной попытки номер attempt следующая
+// откладывается на Backoff·2^(attempt-1), после Attempts попыток доставка прекращается.
+// Повторяются только временные ошибки
+type Retry struct {
+	Attempts int
+	Backoff  time.Duration
+}
+
+// Next возвращает время следующей попытки после неудачной попытки attempt с ошибкой err
+// или false, если доставку пора прекратить
+func (r Retry) Next(err error, attempt int, now time.Time) (time.Time, bool) {
+	if !errors.Is(err, ErrUnavailable) || attempt >= r.Attempts {
+		return time.Time{}, false
+	}
+	return now.Add(r.Backoff << (attempt - 1)), true
+}
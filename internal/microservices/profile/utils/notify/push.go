@@ -0,0 +1,40 @@
+package notify
+
+import (
+	"encoding/json"
+	"main/internal/microservices/profile/utils/webpush"
+	"unicode/utf8"
+)
+
+// pushTextLength ограничивает текст, чтобы сообщение с длинной сводкой поместилось в одну запись Web Push
+const pushTextLength = 2000
+
+// Push отправляет уведомления в браузер через Web Push; сервис-воркер получает сообщение в JSON
+type Push struct {
+	client *webpush.Client
+}
+
+func NewPush(client *webpush.Client) *Push {
+	return &Push{client: client}
+}
+
+func (p *Push) Send(target Target, message Message) error {
+	if len(message.Text) > pushTextLength {
+		text := message.Text[:pushTextLength]
+		for !utf8.ValidString(text) {
+			text = text[:len(text)-1]
+		}
+		message.Text = text + "…"
+	}
+
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	return p.client.Send(webpush.Subscription{
+		Endpoint: target.Address,
+		P256dh:   target.Key,
+		Auth:     target.Secret,
+	}, payload)
+}
@@ -0,0 +1,25 @@
+package notify
+
+import "net/smtp"
+
+// SMTP отправляет уведомления письмами через почтовый сервер с авторизацией PLAIN
+type SMTP struct {
+	from     string
+	password string
+	host     string
+	port     string
+}
+
+func NewSMTP(from, password, host, port string) *SMTP {
+	return &SMTP{
+		from:     from,
+		password: password,
+		host:     host,
+		port:     port,
+	}
+}
+
+func (s *SMTP) Send(target Target, message Message) error {
+	authSMTP := smtp.PlainAuth("", s.from, s.password, s.host)
+	return smtp.SendMail(s.host+":"+s.port, authSMTP, s.from, []string{target.Address}, []byte(message.Text))
+}
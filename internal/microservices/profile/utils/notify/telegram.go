@@ -0,0 +1,29 @@
+package notify
+
+import (
+	"main/internal/microservices/profile/utils/telegram"
+	"strconv"
+)
+
+// Telegram отправляет уведомления сообщением бота в привязанный чат; под напоминанием
+// о собственном приёме появляются кнопки, которые обрабатывает шлюз
+type Telegram struct {
+	client *telegram.Client
+}
+
+func NewTelegram(client *telegram.Client) *Telegram {
+	return &Telegram{client: client}
+}
+
+func (t *Telegram) Send(target Target, message Message) error {
+	chatID, err := strconv.ParseInt(target.Address, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	var markup *telegram.InlineKeyboardMarkup
+	if message.Event == EventDose && message.IDNotification != 0 {
+		markup = telegram.DoseKeyboard(message.IDNotification)
+	}
+	return t.client.SendMessage(chatID, message.Text, markup)
+}
@@ -0,0 +1,114 @@
+package notify
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"main/internal/microservices/profile/utils/netguard"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Заголовки запроса вебхука. Подпись — HMAC-SHA256 от "<timestamp>.<тело>" на секрете вебхука,
+// получатель сверяет её и отбрасывает запросы со старой меткой времени
+const (
+	SignatureHeader = "X-MyAidKit-Signature"
+	TimestampHeader = "X-MyAidKit-Timestamp"
+	EventHeader     = "X-MyAidKit-Event"
+)
+
+const secretBytes = 32
+
+var (
+	ErrWrongURL = errors.New("wrong webhook url")
+	ErrDelivery = errors.New("webhook delivery failed")
+)
+
+// Webhook отправляет уведомления POST-запросом с JSON и подписью. Send делает одну попытку:
+// сетевые ошибки, ответы 429 и 5xx возвращаются как ErrUnavailable, и повтор планирует вызывающий
+type Webhook struct {
+	client *http.Client
+}
+
+func NewWebhook(client *http.Client) *Webhook {
+	return &Webhook{
+		client: client,
+	}
+}
+
+func (w *Webhook) Send(target Target, message Message) error {
+	body, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	return w.post(target, message.Event, body)
+}
+
+func (w *Webhook) post(target Target, event string, body []byte) error {
+	request, err := http.NewRequest(http.MethodPost, target.Address, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(EventHeader, event)
+	request.Header.Set(TimestampHeader, timestamp)
+	request.Header.Set(SignatureHeader, Sign(target.Secret, timestamp, body))
+
+	response, err := w.client.Do(request)
+	if errors.Is(err, netguard.ErrForbiddenAddress) {
+		return fmt.Errorf("%w: %v", ErrDelivery, err)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUnavailable, err)
+	}
+	response.Body.Close()
+
+	switch {
+	case response.StatusCode >= 200 && response.StatusCode < 300:
+		return nil
+	case response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= 500:
+		return fmt.Errorf("%w: %s returned %d", ErrUnavailable, target.Address, response.StatusCode)
+	default:
+		return fmt.Errorf("%w: %s returned %d", ErrDelivery, target.Address, response.StatusCode)
+	}
+}
+
+// Sign возвращает значение заголовка подписи вида sha256=<hex>
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewSecret создаёт случайный секрет подписи вебхука
+func NewSecret() (string, error) {
+	secret := make([]byte, secretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+// ValidateURL проверяет, что вебхук — абсолютный адрес http или https на публичном хосте
+func ValidateURL(address string) error {
+	parsed, err := url.Parse(address)
+	if err != nil || parsed.Hostname() == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return ErrWrongURL
+	}
+
+	if err = netguard.CheckHost(parsed.Hostname()); err != nil {
+		return ErrWrongURL
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package notify
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWebhookSignsRequest(t *testing.T) {
+	const secret = "secret"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r.Header.Get(EventHeader) != EventDose {
+			t.Errorf("event header = %q, want %q", r.Header.Get(EventHeader), EventDose)
+		}
+		want := Sign(secret, r.Header.Get(TimestampHeader), body)
+		if r.Header.Get(SignatureHeader) != want {
+			t.Errorf("signature = %q, want %q", r.Header.Get(SignatureHeader), want)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	webhook := NewWebhook(server.Client())
+	err := webhook.Send(Target{Address: server.URL, Secret: secret}, Message{Event: EventDose, Text: "text"})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWebhookRetriesTemporaryErrors(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	webhook := NewWebhook(server.Client())
+	policy := Retry{Attempts: 3, Backoff: time.Minute}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	err := webhook.Send(Target{Address: server.URL}, Message{Event: EventDose})
+	if !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("first attempt error = %v, want ErrUnavailable", err)
+	}
+
+	next, ok := policy.Next(err, 1, now)
+	if !ok || !next.Equal(now.Add(time.Minute)) {
+		t.Fatalf("next attempt = %v %v, want %v", next, ok, now.Add(time.Minute))
+	}
+
+	if err = webhook.Send(Target{Address: server.URL}, Message{Event: EventDose}); err != nil {
+		t.Fatalf("second attempt error = %v", err)
+	}
+}
+
+func TestWebhookGivesUp(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/gone" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	webhook := NewWebhook(server.Client())
+	policy := Retry{Attempts: 3, Backoff: time.Minute}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	err := webhook.Send(Target{Address: server.URL + "/gone"}, Message{Event: EventDose})
+	if !errors.Is(err, ErrDelivery) {
+		t.Fatalf("error = %v, want ErrDelivery", err)
+	}
+	if _, ok := policy.Next(err, 1, now); ok {
+		t.Fatal("client error is retried")
+	}
+
+	for attempt := 1; attempt <= policy.Attempts; attempt++ {
+		err = webhook.Send(Target{Address: server.URL}, Message{Event: EventDose})
+		next, ok := policy.Next(err, attempt, now)
+		if attempt < policy.Attempts && (!ok || !next.Equal(now.Add(time.Minute<<(attempt-1)))) {
+			t.Fatalf("attempt %d: next = %v %v", attempt, next, ok)
+		}
+		if attempt == policy.Attempts && ok {
+			t.Fatalf("attempt %d is retried after the last attempt", attempt)
+		}
+	}
+}
+
+func TestWebhookUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	address := server.URL
+	server.Close()
+
+	err := NewWebhook(server.Client()).Send(Target{Address: address}, Message{Event: EventDose})
+	if !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("error = %v, want ErrUnavailable", err)
+	}
+}
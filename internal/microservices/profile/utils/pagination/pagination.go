@@ -0,0 +1,40 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+const (
+	SortAsc  = "asc"
+	SortDesc = "desc"
+
+	MaxLimit = 200
+)
+
+var ErrWrongCursor = errors.New("wrong cursor")
+
+// Encode упаковывает значения ключа сортировки последней записи страницы в непрозрачный курсор
+func Encode(values ...string) string {
+	data, err := json.Marshal(values)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decode распаковывает курсор, созданный Encode для ключа сортировки из count значений
+func Decode(cursor string, count int) ([]string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrWrongCursor
+	}
+
+	values := make([]string, 0, count)
+	if err = json.Unmarshal(data, &values); err != nil || len(values) != count {
+		return nil, ErrWrongCursor
+	}
+
+	return values, nil
+}
@@ -0,0 +1,41 @@
+package pagination
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	values := []string{"аспирин", "42"}
+
+	got, err := Decode(Encode(values...), len(values))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, values) {
+		t.Errorf("Decode(Encode(%v)) = %v", values, got)
+	}
+}
+
+func TestDecodeWrongCursor(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor string
+		count  int
+	}{
+		{"not base64", "!!!", 1},
+		{"not json", "bm90IGpzb24", 1},
+		{"not a list of strings", "e30", 1},
+		{"fewer values", Encode("42"), 2},
+		{"more values", Encode("a", "42"), 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := Decode(test.cursor, test.count); !errors.Is(err, ErrWrongCursor) {
+				t.Errorf("Decode(%q, %d) error = %v, want %v", test.cursor, test.count, err, ErrWrongCursor)
+			}
+		})
+	}
+}
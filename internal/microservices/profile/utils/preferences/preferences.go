@@ -0,0 +1,151 @@
+package preferences
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Каналы доставки напоминаний
+const (
+	ChannelEmail    = "email"
+	ChannelWebhook  = "webhook"
+	ChannelTelegram = "telegram"
+	ChannelPush     = "push"
+)
+
+// Что получает взрослый о приёмах лекарств другими членами семьи
+const (
+	DependentsCopies = "copies"
+	DependentsDigest = "digest"
+	DependentsOff    = "off"
+)
+
+// DigestClock — местное время отправки ежедневной сводки, если оно не попадает в тихие часы
+const DigestClock = "08:00"
+
+var (
+	ErrWrongChannel    = errors.New("wrong notification channel")
+	ErrWrongQuietHours = errors.New("wrong quiet hours")
+	ErrWrongDependents = errors.New("wrong dependents notification mode")
+)
+
+var channels = map[string]interface{}{
+	ChannelEmail:    nil,
+	ChannelWebhook:  nil,
+	ChannelTelegram: nil,
+	ChannelPush:     nil,
+}
+
+var dependents = map[string]interface{}{
+	DependentsCopies: nil,
+	DependentsDigest: nil,
+	DependentsOff:    nil,
+}
+
+// Preferences — настройки уведомлений пользователя; тихие часы задаются по его местному времени
+type Preferences struct {
+	Channels   []string
+	QuietFrom  string
+	QuietTo    string
+	Dependents string
+}
+
+// Default — настройки пользователя, который их не менял: письма обо всех приёмах без тихих часов
+func Default() Preferences {
+	return Preferences{
+		Channels:   []string{ChannelEmail},
+		Dependents: DependentsCopies,
+	}
+}
+
+func Validate(prefs Preferences) error {
+	for _, channel := range prefs.Channels {
+		if _, ok := channels[channel]; !ok {
+			return ErrWrongChannel
+		}
+	}
+
+	if (prefs.QuietFrom == "") != (prefs.QuietTo == "") {
+		return ErrWrongQuietHours
+	}
+	for _, clock := range []string{prefs.QuietFrom, prefs.QuietTo} {
+		if clock == "" {
+			continue
+		}
+		if _, err := time.Parse("15:04", clock); err != nil || len(clock) != len("15:04") {
+			return ErrWrongQuietHours
+		}
+	}
+
+	if _, ok := dependents[prefs.Dependents]; !ok {
+		return ErrWrongDependents
+	}
+	return nil
+}
+
+// JoinChannels и SplitChannels переводят список каналов в строку для хранения и обратно
+func JoinChannels(list []string) string {
+	return strings.Join(list, ",")
+}
+
+func SplitChannels(value string) []string {
+	list := make([]string, 0)
+	for _, channel := range strings.Split(value, ",") {
+		if channel = strings.TrimSpace(channel); channel != "" {
+			list = append(list, channel)
+		}
+	}
+	return list
+}
+
+func (p Preferences) Enabled(channel string) bool {
+	for _, enabled := range p.Channels {
+		if enabled == channel {
+			return true
+		}
+	}
+	return false
+}
+
+// Quiet сообщает, попадает ли местное время now в тихие часы; интервал может переходить через полночь
+func (p Preferences) Quiet(now time.Time) bool {
+	return p.quietAt(now.Format("15:04"))
+}
+
+func (p Preferences) quietAt(clock string) bool {
+	if p.QuietFrom == "" || p.QuietFrom == p.QuietTo {
+		return false
+	}
+	if p.QuietFrom < p.QuietTo {
+		return clock >= p.QuietFrom && clock < p.QuietTo
+	}
+	return clock >= p.QuietFrom || clock < p.QuietTo
+}
+
+// QuietEnd возвращает ближайший конец тихих часов после местного времени now
+// или само now, если сейчас не тихие часы
+func (p Preferences) QuietEnd(now time.Time) time.Time {
+	if !p.Quiet(now) {
+		return now
+	}
+
+	clock, err := time.Parse("15:04", p.QuietTo)
+	if err != nil {
+		return now
+	}
+
+	end := time.Date(now.Year(), now.Month(), now.Day(), clock.Hour(), clock.Minute(), 0, 0, now.Location())
+	if !end.After(now) {
+		end = end.AddDate(0, 0, 1)
+	}
+	return end
+}
+
+// Digest возвращает местное время отправки сводки: DigestClock или конец тихих часов, если сводка в них попадает
+func (p Preferences) Digest() string {
+	if p.quietAt(DigestClock) {
+		return p.QuietTo
+	}
+	return DigestClock
+}
@@ -0,0 +1,442 @@
+package report
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	xfont "golang.org/x/image/font"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+var errWrongFont = errors.New("wrong truetype font")
+
+// Таблицы TrueType, которые нужны для вывода глифов; cmap, name, post и остальные
+// не встраиваются: текст набирается номерами глифов, а Unicode восстанавливается по ToUnicode
+var keepTables = []string{"cvt ", "fpgm", "glyf", "head", "hhea", "hmtx", "loca", "maxp", "prep"}
+
+// font — шрифт TrueType, который встраивается в PDF как CIDFontType2 с кодировкой Identity-H.
+// В файл попадает подмножество: глифы, которые не использованы в документе, остаются пустыми,
+// поэтому номера глифов не меняются и CIDToGIDMap остаётся тождественным
+type font struct {
+	name   string
+	data   []byte
+	parsed *sfnt.Font
+	buffer sfnt.Buffer
+	glyphs map[rune]sfnt.GlyphIndex
+	used   map[sfnt.GlyphIndex]rune
+}
+
+func newFont(name string, data []byte) (*font, error) {
+	parsed, err := sfnt.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &font{
+		name:   name,
+		data:   data,
+		parsed: parsed,
+		glyphs: make(map[rune]sfnt.GlyphIndex),
+		used:   make(map[sfnt.GlyphIndex]rune),
+	}, nil
+}
+
+// glyph возвращает номер глифа символа r; символы, которых нет в шрифте, заменяются на «?»
+func (f *font) glyph(r rune) sfnt.GlyphIndex {
+	if index, ok := f.glyphs[r]; ok {
+		return index
+	}
+
+	index, err := f.parsed.GlyphIndex(&f.buffer, r)
+	if (err != nil || index == 0) && r != '?' {
+		index = f.glyph('?')
+	}
+	f.glyphs[r] = index
+	if _, ok := f.used[index]; !ok {
+		f.used[index] = r
+	}
+	return index
+}
+
+// encode записывает строку шестнадцатеричными двухбайтовыми номерами глифов
+func (f *font) encode(text string) string {
+	var buffer strings.Builder
+	buffer.WriteByte('<')
+	for _, r := range text {
+		fmt.Fprintf(&buffer, "%04X", uint16(f.glyph(r)))
+	}
+	buffer.WriteByte('>')
+	return buffer.String()
+}
+
+// scale переводит единицы шрифта в тысячные доли кегля, в которых задаются размеры в PDF
+func (f *font) scale(value fixed.Int26_6) int {
+	return int(value) / 64 * 1000 / int(f.parsed.UnitsPerEm())
+}
+
+func (f *font) ppem() fixed.Int26_6 {
+	return fixed.Int26_6(f.parsed.UnitsPerEm()) << 6
+}
+
+// tag — шесть заглавных букв перед именем подмножества шрифта, одинаковые для одного набора глифов
+func (f *font) tag() string {
+	indices := f.sortedGlyphs()
+	var hash uint32 = 2166136261
+	for _, index := range indices {
+		hash = (hash ^ uint32(index)) * 16777619
+	}
+
+	tag := make([]byte, 6)
+	for i := range tag {
+		tag[i] = byte('A' + hash%26)
+		hash /= 26
+	}
+	return string(tag)
+}
+
+func (f *font) sortedGlyphs() []sfnt.GlyphIndex {
+	indices := make([]sfnt.GlyphIndex, 0, len(f.used))
+	for index := range f.used {
+		indices = append(indices, index)
+	}
+	sort.Slice(indices, func(i, j int) bool {
+		return indices[i] < indices[j]
+	})
+	return indices
+}
+
+// widths возвращает массив W с шириной каждого использованного глифа
+func (f *font) widths() (string, error) {
+	parts := make([]string, 0, len(f.used))
+	for _, index := range f.sortedGlyphs() {
+		advance, err := f.parsed.GlyphAdvance(&f.buffer, index, f.ppem(), xfont.HintingNone)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, fmt.Sprintf("%d [%d]", index, f.scale(advance)))
+	}
+	return "[" + strings.Join(parts, " ") + "]", nil
+}
+
+// toUnicode возвращает CMap, по которому просмотрщик восстанавливает текст для поиска и копирования
+func (f *font) toUnicode() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n" +
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def\n" +
+		"/CMapName /Adobe-Identity-UCS def\n/CMapType 2 def\n" +
+		"1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n")
+
+	indices := f.sortedGlyphs()
+	for start := 0; start < len(indices); start += 100 {
+		end := start + 100
+		if end > len(indices) {
+			end = len(indices)
+		}
+		fmt.Fprintf(&buffer, "%d beginbfchar\n", end-start)
+		for _, index := range indices[start:end] {
+			fmt.Fprintf(&buffer, "<%04X> <%s>\n", uint16(index), utf16Hex(f.used[index]))
+		}
+		buffer.WriteString("endbfchar\n")
+	}
+
+	buffer.WriteString("endcmap\nCMapName currentdict /CMap defineresource pop\nend\nend\n")
+	return buffer.String()
+}
+
+func utf16Hex(r rune) string {
+	if r < 0x10000 {
+		return fmt.Sprintf("%04X", r)
+	}
+	r -= 0x10000
+	return fmt.Sprintf("%04X%04X", 0xD800+(r>>10), 0xDC00+(r&0x3FF))
+}
+
+// objects возвращает объекты шрифта: Type0, CIDFontType2, FontDescriptor, сжатый FontFile2
+// и ToUnicode. first — номер первого из них
+func (f *font) objects(first int) ([]string, error) {
+	name := f.tag() + "+" + f.name
+
+	widths, err := f.widths()
+	if err != nil {
+		return nil, err
+	}
+
+	bounds, err := f.parsed.Bounds(&f.buffer, f.ppem(), xfont.HintingNone)
+	if err != nil {
+		return nil, err
+	}
+	metrics, err := f.parsed.Metrics(&f.buffer, f.ppem(), xfont.HintingNone)
+	if err != nil {
+		return nil, err
+	}
+
+	subset, err := f.subset()
+	if err != nil {
+		return nil, err
+	}
+	var compressed bytes.Buffer
+	writer := zlib.NewWriter(&compressed)
+	if _, err = writer.Write(subset); err != nil {
+		return nil, err
+	}
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+
+	cmap := f.toUnicode()
+
+	return []string{
+		fmt.Sprintf("<< /Type /Font /Subtype /Type0 /BaseFont /%s /Encoding /Identity-H /DescendantFonts [%d 0 R] /ToUnicode %d 0 R >>",
+			name, first+1, first+4),
+		fmt.Sprintf("<< /Type /Font /Subtype /CIDFontType2 /BaseFont /%s "+
+			"/CIDSystemInfo << /Registry (Adobe) /Ordering (Identity) /Supplement 0 >> "+
+			"/FontDescriptor %d 0 R /CIDToGIDMap /Identity /W %s >>", name, first+2, widths),
+		// флаги: моноширинный (1) и символьный (4) — набор глифов не совпадает со стандартным латинским
+		fmt.Sprintf("<< /Type /FontDescriptor /FontName /%s /Flags 5 /FontBBox [%d %d %d %d] /ItalicAngle 0 "+
+			"/Ascent %d /Descent %d /CapHeight %d /StemV 80 /FontFile2 %d 0 R >>", name,
+			f.scale(bounds.Min.X), -f.scale(bounds.Max.Y), f.scale(bounds.Max.X), -f.scale(bounds.Min.Y),
+			f.scale(metrics.Ascent), -f.scale(metrics.Descent), f.scale(metrics.CapHeight), first+3),
+		fmt.Sprintf("<< /Length %d /Length1 %d /Filter /FlateDecode >>\nstream\n%s\nendstream",
+			compressed.Len(), len(subset), compressed.String()),
+		fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", len(cmap), cmap),
+	}, nil
+}
+
+type table struct {
+	tag  string
+	data []byte
+}
+
+func tables(data []byte) (map[string][]byte, error) {
+	if len(data) < 12 {
+		return nil, errWrongFont
+	}
+
+	count := int(binary.BigEndian.Uint16(data[4:]))
+	if len(data) < 12+16*count {
+		return nil, errWrongFont
+	}
+
+	result := make(map[string][]byte, count)
+	for i := 0; i < count; i++ {
+		record := data[12+16*i:]
+		offset := int(binary.BigEndian.Uint32(record[8:]))
+		length := int(binary.BigEndian.Uint32(record[12:]))
+		if offset < 0 || length < 0 || offset+length > len(data) {
+			return nil, errWrongFont
+		}
+		result[string(record[:4])] = data[offset : offset+length]
+	}
+	return result, nil
+}
+
+// subset собирает файл шрифта, в котором сохранены только использованные глифы и глифы,
+// из которых они составлены. Таблица loca всегда записывается в длинном формате
+func (f *font) subset() ([]byte, error) {
+	source, err := tables(f.data)
+	if err != nil {
+		return nil, err
+	}
+
+	head, glyf, loca, maxp := source["head"], source["glyf"], source["loca"], source["maxp"]
+	if len(head) < 54 || len(maxp) < 6 || glyf == nil || loca == nil {
+		return nil, errWrongFont
+	}
+
+	count := int(binary.BigEndian.Uint16(maxp[4:]))
+	long := binary.BigEndian.Uint16(head[50:]) == 1
+	offsets := make([]int, count+1)
+	for i := range offsets {
+		switch {
+		case long && len(loca) >= 4*(i+1):
+			offsets[i] = int(binary.BigEndian.Uint32(loca[4*i:]))
+		case !long && len(loca) >= 2*(i+1):
+			offsets[i] = 2 * int(binary.BigEndian.Uint16(loca[2*i:]))
+		default:
+			return nil, errWrongFont
+		}
+	}
+	glyph := func(index int) ([]byte, error) {
+		if index >= count || offsets[index] > offsets[index+1] || offsets[index+1] > len(glyf) {
+			return nil, errWrongFont
+		}
+		return glyf[offsets[index]:offsets[index+1]], nil
+	}
+
+	// .notdef обязателен, составные глифы тянут за собой компоненты
+	keep := map[int]bool{0: true}
+	queue := []int{0}
+	for index := range f.used {
+		if !keep[int(index)] {
+			keep[int(index)] = true
+			queue = append(queue, int(index))
+		}
+	}
+	for len(queue) != 0 {
+		index := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+
+		data, err := glyph(index)
+		if err != nil {
+			return nil, err
+		}
+		for _, component := range components(data) {
+			if !keep[component] {
+				keep[component] = true
+				queue = append(queue, component)
+			}
+		}
+	}
+
+	var newGlyf bytes.Buffer
+	newLoca := make([]byte, 4*(count+1))
+	for index := 0; index < count; index++ {
+		binary.BigEndian.PutUint32(newLoca[4*index:], uint32(newGlyf.Len()))
+		if !keep[index] {
+			continue
+		}
+		data, err := glyph(index)
+		if err != nil {
+			return nil, err
+		}
+		newGlyf.Write(data)
+		for newGlyf.Len()%4 != 0 {
+			newGlyf.WriteByte(0)
+		}
+	}
+	binary.BigEndian.PutUint32(newLoca[4*count:], uint32(newGlyf.Len()))
+
+	newHead := append([]byte(nil), head...)
+	binary.BigEndian.PutUint32(newHead[8:], 0)
+	binary.BigEndian.PutUint16(newHead[50:], 1)
+
+	result := make([]table, 0, len(keepTables))
+	for _, tag := range keepTables {
+		var data []byte
+		switch tag {
+		case "glyf":
+			data = newGlyf.Bytes()
+		case "loca":
+			data = newLoca
+		case "head":
+			data = newHead
+		default:
+			data = source[tag]
+		}
+		if data != nil {
+			result = append(result, table{tag: tag, data: data})
+		}
+	}
+
+	return writeFont(result), nil
+}
+
+// components возвращает номера глифов, из которых составлен составной глиф
+func components(data []byte) []int {
+	if len(data) < 10 || int16(binary.BigEndian.Uint16(data)) >= 0 {
+		return nil
+	}
+
+	const (
+		argsAreWords   = 0x0001
+		haveScale      = 0x0008
+		moreComponents = 0x0020
+		haveXYScale    = 0x0040
+		haveTwoByTwo   = 0x0080
+	)
+
+	result := make([]int, 0, 2)
+	for offset := 10; offset+4 <= len(data); {
+		flags := binary.BigEndian.Uint16(data[offset:])
+		result = append(result, int(binary.BigEndian.Uint16(data[offset+2:])))
+		offset += 4
+
+		if flags&argsAreWords != 0 {
+			offset += 4
+		} else {
+			offset += 2
+		}
+		switch {
+		case flags&haveScale != 0:
+			offset += 2
+		case flags&haveXYScale != 0:
+			offset += 4
+		case flags&haveTwoByTwo != 0:
+			offset += 8
+		}
+
+		if flags&moreComponents == 0 {
+			break
+		}
+	}
+	return result
+}
+
+// writeFont собирает файл TrueType из таблиц, отсортированных по тегу, и пересчитывает контрольные суммы
+func writeFont(list []table) []byte {
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].tag < list[j].tag
+	})
+
+	count := len(list)
+	power := 1
+	selector := 0
+	for power*2 <= count {
+		power *= 2
+		selector++
+	}
+
+	var buffer bytes.Buffer
+	header := make([]byte, 12)
+	binary.BigEndian.PutUint32(header, 0x00010000)
+	binary.BigEndian.PutUint16(header[4:], uint16(count))
+	binary.BigEndian.PutUint16(header[6:], uint16(16*power))
+	binary.BigEndian.PutUint16(header[8:], uint16(selector))
+	binary.BigEndian.PutUint16(header[10:], uint16(16*(count-power)))
+	buffer.Write(header)
+
+	offset := 12 + 16*count
+	headOffset := -1
+	for _, item := range list {
+		record := make([]byte, 16)
+		copy(record, item.tag)
+		binary.BigEndian.PutUint32(record[4:], checksum(item.data))
+		binary.BigEndian.PutUint32(record[8:], uint32(offset))
+		binary.BigEndian.PutUint32(record[12:], uint32(len(item.data)))
+		buffer.Write(record)
+
+		if item.tag == "head" {
+			headOffset = offset
+		}
+		offset += (len(item.data) + 3) &^ 3
+	}
+
+	for _, item := range list {
+		buffer.Write(item.data)
+		for buffer.Len()%4 != 0 {
+			buffer.WriteByte(0)
+		}
+	}
+
+	result := buffer.Bytes()
+	if headOffset >= 0 {
+		binary.BigEndian.PutUint32(result[headOffset+8:], 0xB1B0AFBA-checksum(result))
+	}
+	return result
+}
+
+func checksum(data []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(data); i += 4 {
+		var word [4]byte
+		copy(word[:], data[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return sum
+}
@@ -0,0 +1,275 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"golang.org/x/image/font/gofont/gomono"
+	"golang.org/x/image/font/gofont/gomonobold"
+)
+
+// ContentType — тип ответа с готовым отчётом
+const ContentType = "application/pdf"
+
+// Страница A4 набирается моноширинным шрифтом Go Mono, поэтому ширина строки
+// считается в символах и таблицы выравниваются пробелами. Каждый встроенный шрифт
+// занимает fontObjects объектов PDF
+const (
+	pageWidth   = 595
+	pageHeight  = 842
+	margin      = 40
+	fontSize    = 9
+	leading     = 12
+	lineLength  = 95
+	pageLines   = 61
+	columnSpace = 2
+	fontObjects = 5
+)
+
+type line struct {
+	text string
+	bold bool
+}
+
+// Document — текстовый документ из заголовков, абзацев и таблиц,
+// который записывается в PDF со встроенным шрифтом
+type Document struct {
+	title string
+	pages [][]line
+}
+
+func New(title string) *Document {
+	return &Document{title: title, pages: [][]line{{}}}
+}
+
+func (d *Document) add(text string, bold bool) {
+	last := len(d.pages) - 1
+	if len(d.pages[last]) >= pageLines {
+		d.pages = append(d.pages, []line{})
+		last++
+	}
+	d.pages[last] = append(d.pages[last], line{text: text, bold: bold})
+}
+
+// pageLeft возвращает число свободных строк на текущей странице
+func (d *Document) pageLeft() int {
+	return pageLines - len(d.pages[len(d.pages)-1])
+}
+
+// Heading начинает раздел; заголовок не остаётся последней строкой страницы
+func (d *Document) Heading(text string) {
+	if len(d.pages[len(d.pages)-1]) != 0 {
+		d.add("", false)
+	}
+	if d.pageLeft() < 3 {
+		d.pages = append(d.pages, []line{})
+	}
+	for _, part := range wrap(text) {
+		d.add(part, true)
+	}
+}
+
+// Text добавляет абзац, перенося слова по ширине страницы
+func (d *Document) Text(text string) {
+	for _, part := range wrap(text) {
+		d.add(part, false)
+	}
+}
+
+// Table добавляет таблицу; шапка повторяется на каждой новой странице,
+// а слишком длинные значения обрезаются
+func (d *Document) Table(header []string, rows [][]string) {
+	widths := make([]int, len(header))
+	for i, cell := range header {
+		widths[i] = runeLen(cell)
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			if i < len(widths) && runeLen(cell) > widths[i] {
+				widths[i] = runeLen(cell)
+			}
+		}
+	}
+	shrink(widths)
+
+	separator := make([]string, len(widths))
+	for i, width := range widths {
+		separator[i] = strings.Repeat("-", width)
+	}
+
+	printHeader := func() {
+		d.add(formatRow(header, widths), true)
+		d.add(formatRow(separator, widths), false)
+	}
+
+	if d.pageLeft() < 3 {
+		d.pages = append(d.pages, []line{})
+	}
+	printHeader()
+	for _, row := range rows {
+		if d.pageLeft() == 0 {
+			d.pages = append(d.pages, []line{})
+			printHeader()
+		}
+		d.add(formatRow(row, widths), false)
+	}
+}
+
+// shrink уменьшает самые широкие колонки, пока таблица не поместится в строку
+func shrink(widths []int) {
+	total := func() int {
+		sum := columnSpace * (len(widths) - 1)
+		for _, width := range widths {
+			sum += width
+		}
+		return sum
+	}
+
+	for total() > lineLength {
+		widest := 0
+		for i, width := range widths {
+			if width > widths[widest] {
+				widest = i
+			}
+		}
+		if widths[widest] <= 1 {
+			return
+		}
+		widths[widest]--
+	}
+}
+
+func formatRow(cells []string, widths []int) string {
+	parts := make([]string, len(widths))
+	for i, width := range widths {
+		cell := ""
+		if i < len(cells) {
+			cell = cells[i]
+		}
+		runes := []rune(cell)
+		if len(runes) > width {
+			runes = append(runes[:width-1], '~')
+		}
+		parts[i] = string(runes) + strings.Repeat(" ", width-len(runes))
+	}
+	return strings.TrimRight(strings.Join(parts, strings.Repeat(" ", columnSpace)), " ")
+}
+
+func wrap(text string) []string {
+	lines := make([]string, 0, 1)
+	current := make([]rune, 0, lineLength)
+	for _, word := range strings.Fields(text) {
+		runes := []rune(word)
+		for len(runes) > lineLength {
+			if len(current) != 0 {
+				lines = append(lines, string(current))
+				current = current[:0]
+			}
+			lines = append(lines, string(runes[:lineLength]))
+			runes = runes[lineLength:]
+		}
+		if len(current) != 0 && len(current)+1+len(runes) > lineLength {
+			lines = append(lines, string(current))
+			current = current[:0]
+		}
+		if len(current) != 0 {
+			current = append(current, ' ')
+		}
+		current = append(current, runes...)
+	}
+	return append(lines, string(current))
+}
+
+func runeLen(text string) int {
+	return len([]rune(text))
+}
+
+// Write записывает документ в формате PDF 1.4 с нумерацией страниц. Go Mono и Go Mono Bold
+// встраиваются подмножествами, поэтому кириллица не зависит от шрифтов просмотрщика
+func (d *Document) Write(w io.Writer) error {
+	regular, err := newFont("GoMono", gomono.TTF)
+	if err != nil {
+		return err
+	}
+	bold, err := newFont("GoMono-Bold", gomonobold.TTF)
+	if err != nil {
+		return err
+	}
+	fonts := []*font{regular, bold}
+
+	// страницы набираются до записи шрифтов: в подмножество попадают только использованные глифы
+	contents := make([]string, len(d.pages))
+	for i, page := range d.pages {
+		var content bytes.Buffer
+		fmt.Fprintf(&content, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", fontSize, leading, margin, pageHeight-margin)
+		current := regular
+		for _, text := range page {
+			if next := fontOf(text, regular, bold); next != current {
+				current = next
+				name := "/F1"
+				if current == bold {
+					name = "/F2"
+				}
+				fmt.Fprintf(&content, "%s %d Tf\n", name, fontSize)
+			}
+			fmt.Fprintf(&content, "%s Tj T*\n", current.encode(text.text))
+		}
+		fmt.Fprintf(&content, "ET\nBT\n/F1 %d Tf\n%d %d Td\n%s Tj\nET\n", fontSize, margin, margin/2,
+			regular.encode(fmt.Sprintf("%s — %d / %d", d.title, i+1, len(d.pages))))
+		contents[i] = content.String()
+	}
+
+	var buffer bytes.Buffer
+	offsets := make([]int, 0)
+	object := func(body string) {
+		offsets = append(offsets, buffer.Len())
+		fmt.Fprintf(&buffer, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buffer.WriteString("%PDF-1.4\n%\xE2\xE3\xCF\xD3\n")
+
+	const firstFont = 3
+	firstPage := firstFont + fontObjects*len(fonts)
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = strconv.Itoa(firstPage+2*i) + " 0 R"
+	}
+
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	for i, item := range fonts {
+		list, err := item.objects(firstFont + fontObjects*i)
+		if err != nil {
+			return err
+		}
+		for _, body := range list {
+			object(body)
+		}
+	}
+
+	for i, content := range contents {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 %d 0 R /F2 %d 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, firstFont, firstFont+fontObjects, firstPage+2*i+1))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", len(content), content))
+	}
+
+	xref := buffer.Len()
+	fmt.Fprintf(&buffer, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buffer, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buffer, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	_, err = w.Write(buffer.Bytes())
+	return err
+}
+
+func fontOf(text line, regular, bold *font) *font {
+	if text.bold {
+		return bold
+	}
+	return regular
+}
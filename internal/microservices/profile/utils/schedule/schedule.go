@@ -0,0 +1,64 @@
+package schedule
+
+import (
+	"errors"
+	"time"
+
+	// база часовых поясов встраивается в бинарник: в образах alpine её нет
+	_ "time/tzdata"
+)
+
+var (
+	ErrWrongZone  = errors.New("wrong time zone")
+	ErrWrongClock = errors.New("wrong time of day")
+)
+
+// Day возвращает начало дня date (2006-01-02) по местному времени loc
+func Day(date string, loc *time.Location) (time.Time, error) {
+	day, err := time.ParseInLocation("2006-01-02", date, loc)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return day, nil
+}
+
+// Location возвращает часовой пояс IANA по имени, например Europe/Moscow
+func Location(zone string) (*time.Location, error) {
+	if zone == "" || zone == "Local" {
+		return nil, ErrWrongZone
+	}
+
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return nil, ErrWrongZone
+	}
+	return loc, nil
+}
+
+// Dose возвращает момент приёма в день day (0 — ближайший) по местному времени clock (15:04)
+// в поясе loc. Ближайший день — сегодня, если это время ещё не прошло, иначе завтра.
+// Дата и время собираются в поясе loc, поэтому приём остаётся в то же местное время
+// и после перехода на летнее время
+func Dose(clock string, day int64, now time.Time, loc *time.Location) (time.Time, error) {
+	at, err := time.Parse("15:04", clock)
+	if err != nil {
+		return time.Time{}, ErrWrongClock
+	}
+
+	local := now.In(loc)
+	year, month, date := local.Date()
+	if local.Format("15:04") > clock {
+		date++
+	}
+
+	return time.Date(year, month, date+int(day), at.Hour(), at.Minute(), 0, 0, loc), nil
+}
+
+// In переводит время в пояс zone; неизвестный пояс заменяется на UTC
+func In(at time.Time, zone string) time.Time {
+	loc, err := Location(zone)
+	if err != nil {
+		return at.UTC()
+	}
+	return at.In(loc)
+}
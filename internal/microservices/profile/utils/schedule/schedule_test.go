@@ -0,0 +1,79 @@
+package schedule
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDoseKeepsLocalTimeAcrossDST(t *testing.T) {
+	loc, err := Location("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		now   time.Time
+		clock string
+		day   int64
+		want  time.Time
+	}{
+		{"before spring forward", time.Date(2026, 3, 28, 9, 0, 0, 0, loc), "08:00", 0, time.Date(2026, 3, 29, 6, 0, 0, 0, time.UTC)},
+		{"after spring forward", time.Date(2026, 3, 28, 9, 0, 0, 0, loc), "08:00", 1, time.Date(2026, 3, 30, 6, 0, 0, 0, time.UTC)},
+		{"later today", time.Date(2026, 3, 28, 7, 0, 0, 0, loc), "08:00", 0, time.Date(2026, 3, 28, 7, 0, 0, 0, time.UTC)},
+		{"on fall back", time.Date(2026, 10, 24, 9, 0, 0, 0, loc), "08:00", 0, time.Date(2026, 10, 25, 7, 0, 0, 0, time.UTC)},
+		{"before fall back", time.Date(2026, 10, 24, 7, 0, 0, 0, loc), "08:00", 0, time.Date(2026, 10, 24, 6, 0, 0, 0, time.UTC)},
+		{"month boundary", time.Date(2026, 3, 31, 23, 0, 0, 0, loc), "08:00", 1, time.Date(2026, 4, 2, 6, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Dose(test.clock, test.day, test.now, loc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(test.want) {
+				t.Errorf("Dose(%q, %d) = %v, want %v", test.clock, test.day, got.UTC(), test.want)
+			}
+			if got.In(loc).Format("15:04") != test.clock {
+				t.Errorf("local time = %s, want %s", got.In(loc).Format("15:04"), test.clock)
+			}
+		})
+	}
+}
+
+func TestDoseWrongClock(t *testing.T) {
+	for _, clock := range []string{"", "8", "25:00", "08:60"} {
+		if _, err := Dose(clock, 0, time.Now(), time.UTC); !errors.Is(err, ErrWrongClock) {
+			t.Errorf("Dose(%q) error = %v, want %v", clock, err, ErrWrongClock)
+		}
+	}
+}
+
+func TestLocation(t *testing.T) {
+	for _, zone := range []string{"", "Local", "Mars/Olympus"} {
+		if _, err := Location(zone); !errors.Is(err, ErrWrongZone) {
+			t.Errorf("Location(%q) error = %v, want %v", zone, err, ErrWrongZone)
+		}
+	}
+
+	if _, err := Location("Asia/Yekaterinburg"); err != nil {
+		t.Errorf("Location(Asia/Yekaterinburg) error = %v", err)
+	}
+}
+
+func TestDayStartsAtLocalMidnight(t *testing.T) {
+	loc, err := Location("Europe/Moscow")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	day, err := Day("2026-10-19", loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2026, 10, 18, 21, 0, 0, 0, time.UTC); !day.Equal(want) {
+		t.Errorf("Day = %v, want %v", day.UTC(), want)
+	}
+}
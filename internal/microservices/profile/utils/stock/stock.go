@@ -0,0 +1,67 @@
+package stock
+
+import (
+	"errors"
+	"time"
+)
+
+// Причины изменения остатка лекарства в журнале
+const (
+	ReasonIntake     = "intake"
+	ReasonRestock    = "restock"
+	ReasonCorrection = "correction"
+	ReasonDisposal   = "disposal"
+	ReasonExpiry     = "expiry"
+	ReasonTransfer   = "transfer"
+)
+
+var ErrWrongReason = errors.New("wrong stock change reason")
+
+// reasons — причины, которые можно указать при изменении остатка вручную. Перемещение
+// записывается только вместе с парной записью в другой аптечке, поэтому вручную его указать нельзя
+var reasons = map[string]interface{}{
+	ReasonIntake:     nil,
+	ReasonRestock:    nil,
+	ReasonCorrection: nil,
+	ReasonDisposal:   nil,
+	ReasonExpiry:     nil,
+}
+
+func ValidateReason(reason string) error {
+	if _, ok := reasons[reason]; !ok {
+		return ErrWrongReason
+	}
+	return nil
+}
+
+// Forecast возвращает время приёма, к которому остатка count уже не хватит на дозу dose,
+// если принимать лекарство по расписанию doses (отсортированному по времени).
+// ok == false, если остатка хватает на всё расписание
+func Forecast(count int64, dose float64, doses []time.Time) (time.Time, bool) {
+	if dose <= 0 {
+		dose = 1
+	}
+
+	left := float64(count)
+	for _, at := range doses {
+		if left < dose {
+			return at, true
+		}
+		left -= dose
+	}
+
+	return time.Time{}, false
+}
+
+// DaysOfSupply возвращает число полных дней, на которое хватит остатка, или -1, если остатка хватает на всё расписание
+func DaysOfSupply(runOut time.Time, ok bool, now time.Time) int64 {
+	if !ok {
+		return -1
+	}
+
+	days := int64(runOut.Sub(now).Hours() / 24)
+	if days < 0 {
+		days = 0
+	}
+	return days
+}
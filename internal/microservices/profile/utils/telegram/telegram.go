@@ -0,0 +1,218 @@
+package telegram
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultBaseURL — адрес Bot API; в тестах вместо него указывается локальный сервер
+const DefaultBaseURL = "https://api.telegram.org"
+
+// SecretHeader — заголовок, в котором Telegram присылает секрет, заданный при setWebhook
+const SecretHeader = "X-Telegram-Bot-Api-Secret-Token"
+
+// Timeout — сколько ждать ответа Bot API, чтобы зависший запрос не держал обработчик
+const Timeout = 10 * time.Second
+
+// StartCommand — команда, с которой бот получает код привязки по ссылке t.me/<бот>?start=<код>
+const StartCommand = "/start"
+
+// Действия кнопок под напоминанием
+const (
+	ActionTaken  = "taken"
+	ActionSkip   = "skip"
+	ActionSnooze = "snooze"
+)
+
+const codeLength = 8
+
+// codeAlphabet не содержит похожих символов, чтобы код было удобно ввести вручную
+const codeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+var (
+	ErrWrongCallback = errors.New("wrong telegram callback")
+	ErrRequest       = errors.New("telegram request failed")
+)
+
+type Chat struct {
+	ID int64 `json:"id"`
+}
+
+type Message struct {
+	MessageID int64  `json:"message_id"`
+	Chat      Chat   `json:"chat"`
+	Text      string `json:"text"`
+}
+
+type CallbackQuery struct {
+	ID      string   `json:"id"`
+	Message *Message `json:"message"`
+	Data    string   `json:"data"`
+}
+
+// Update — входящее событие бота: сообщение в чате или нажатие кнопки
+type Update struct {
+	UpdateID      int64          `json:"update_id"`
+	Message       *Message       `json:"message"`
+	CallbackQuery *CallbackQuery `json:"callback_query"`
+}
+
+type InlineKeyboardButton struct {
+	Text         string `json:"text"`
+	CallbackData string `json:"callback_data"`
+}
+
+type InlineKeyboardMarkup struct {
+	InlineKeyboard [][]InlineKeyboardButton `json:"inline_keyboard"`
+}
+
+type sendMessage struct {
+	ChatID      int64                 `json:"chat_id"`
+	Text        string                `json:"text"`
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+type editMessageText struct {
+	ChatID    int64  `json:"chat_id"`
+	MessageID int64  `json:"message_id"`
+	Text      string `json:"text"`
+}
+
+type answerCallbackQuery struct {
+	CallbackQueryID string `json:"callback_query_id"`
+	Text            string `json:"text,omitempty"`
+}
+
+type response struct {
+	OK          bool   `json:"ok"`
+	Description string `json:"description"`
+}
+
+// Client — клиент Bot API с настраиваемым базовым адресом
+type Client struct {
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+func NewClient(baseURL, token string, client *http.Client) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &Client{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		token:   token,
+		client:  client,
+	}
+}
+
+func (c *Client) SendMessage(chatID int64, text string, markup *InlineKeyboardMarkup) error {
+	return c.call("sendMessage", sendMessage{ChatID: chatID, Text: text, ReplyMarkup: markup})
+}
+
+// EditMessageText заменяет текст сообщения и убирает его кнопки
+func (c *Client) EditMessageText(chatID, messageID int64, text string) error {
+	return c.call("editMessageText", editMessageText{ChatID: chatID, MessageID: messageID, Text: text})
+}
+
+func (c *Client) AnswerCallbackQuery(callbackID, text string) error {
+	return c.call("answerCallbackQuery", answerCallbackQuery{CallbackQueryID: callbackID, Text: text})
+}
+
+func (c *Client) call(method string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	httpResponse, err := c.client.Post(c.baseURL+"/bot"+c.token+"/"+method, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	var result response
+	if err = json.NewDecoder(httpResponse.Body).Decode(&result); err != nil {
+		return fmt.Errorf("%w: %s returned %d", ErrRequest, method, httpResponse.StatusCode)
+	}
+	if !result.OK {
+		return fmt.Errorf("%w: %s: %s", ErrRequest, method, result.Description)
+	}
+	return nil
+}
+
+// DoseKeyboard — кнопки «Принял», «Пропустить» и «Отложить» под напоминанием о приёме
+func DoseKeyboard(idNotification int64) *InlineKeyboardMarkup {
+	return &InlineKeyboardMarkup{InlineKeyboard: [][]InlineKeyboardButton{{
+		{Text: "Принял", CallbackData: Callback(ActionTaken, idNotification)},
+		{Text: "Пропустить", CallbackData: Callback(ActionSkip, idNotification)},
+		{Text: "Отложить", CallbackData: Callback(ActionSnooze, idNotification)},
+	}}}
+}
+
+// Callback и ParseCallback переводят действие с напоминанием в данные кнопки вида taken:42 и обратно
+// TakenCount возвращает, сколько списать по кнопке «Принял»: первую из средних доз, которая
+// после округления больше нуля. Без истории приёмов списывается одна единица учёта, чтобы
+// отмеченный приём не оставлял остаток без изменений
+func TakenCount(averages ...float64) int64 {
+	for _, average := range averages {
+		if count := int64(math.Round(average)); count > 0 {
+			return count
+		}
+	}
+	return 1
+}
+
+func Callback(action string, idNotification int64) string {
+	return action + ":" + strconv.FormatInt(idNotification, 10)
+}
+
+func ParseCallback(data string) (string, int64, error) {
+	parts := strings.SplitN(data, ":", 2)
+	if len(parts) != 2 {
+		return "", 0, ErrWrongCallback
+	}
+
+	switch parts[0] {
+	case ActionTaken, ActionSkip, ActionSnooze:
+	default:
+		return "", 0, ErrWrongCallback
+	}
+
+	idNotification, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", 0, ErrWrongCallback
+	}
+	return parts[0], idNotification, nil
+}
+
+// StartCode возвращает код привязки из команды "/start <код>"
+func StartCode(text string) (string, bool) {
+	fields := strings.Fields(text)
+	if len(fields) != 2 || fields[0] != StartCommand {
+		return "", false
+	}
+	return strings.ToUpper(fields[1]), true
+}
+
+// NewCode создаёт одноразовый код привязки чата к аккаунту
+func NewCode() (string, error) {
+	random := make([]byte, codeLength)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+
+	code := make([]byte, codeLength)
+	for i, value := range random {
+		code[i] = codeAlphabet[int(value)%len(codeAlphabet)]
+	}
+	return string(code), nil
+}
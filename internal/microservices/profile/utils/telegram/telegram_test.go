@@ -0,0 +1,26 @@
+package telegram
+
+import "testing"
+
+func TestTakenCount(t *testing.T) {
+	tests := []struct {
+		name     string
+		averages []float64
+		want     int64
+	}{
+		{"no history", []float64{0, 0}, 1},
+		{"no averages", nil, 1},
+		{"person dose", []float64{2, 1}, 2},
+		{"medicine dose without person history", []float64{0, 3}, 3},
+		{"rounded person dose", []float64{1.6, 1}, 2},
+		{"person dose rounds to zero", []float64{0.3, 2}, 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := TakenCount(test.averages...); got != test.want {
+				t.Errorf("TakenCount(%v) = %d, want %d", test.averages, got, test.want)
+			}
+		})
+	}
+}
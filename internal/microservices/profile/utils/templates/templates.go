@@ -0,0 +1,121 @@
+package templates
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Состояние позиции шаблона в отчёте о комплектности аптечки
+const (
+	StatusOK      = "ok"
+	StatusMissing = "missing"
+	StatusShort   = "short"
+	StatusExpired = "expired"
+)
+
+var ErrEmptyTemplate = errors.New("empty template")
+
+type Item struct {
+	Name    string
+	Keyword string
+	Count   int64
+	Unit    string
+}
+
+type Template struct {
+	Name  string
+	Items []Item
+}
+
+func Load(path string) ([]Template, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return Parse(file)
+}
+
+// Parse читает шаблоны из csv со строками вида: template,item,keyword,count,unit;
+// шаблоны идут в порядке первого упоминания
+func Parse(r io.Reader) ([]Template, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 5
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) < 2 {
+		return nil, ErrEmptyTemplate
+	}
+
+	result := make([]Template, 0)
+	index := make(map[string]int)
+	// первая строка — заголовок
+	for _, record := range records[1:] {
+		count, err := strconv.ParseInt(record[3], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		name := strings.TrimSpace(record[0])
+		i, ok := index[name]
+		if !ok {
+			i = len(result)
+			index[name] = i
+			result = append(result, Template{Name: name})
+		}
+
+		result[i].Items = append(result[i].Items, Item{
+			Name:    strings.TrimSpace(record[1]),
+			Keyword: strings.ToLower(strings.TrimSpace(record[2])),
+			Count:   count,
+			Unit:    strings.TrimSpace(record[4]),
+		})
+	}
+
+	return result, nil
+}
+
+// Match сообщает, подходит ли лекарство под позицию шаблона: ключевое слово
+// входит в название лекарства или совпадает с одним из его действующих веществ
+func Match(keyword, medicine string, ingredients []string) bool {
+	keyword = strings.ToLower(strings.TrimSpace(keyword))
+	if len(keyword) == 0 {
+		return false
+	}
+
+	if strings.Contains(strings.ToLower(medicine), keyword) {
+		return true
+	}
+
+	for _, ingredient := range ingredients {
+		if strings.ToLower(ingredient) == keyword {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Status оценивает позицию по требуемому количеству, количеству годного и просроченного в аптечке
+func Status(required, available, expired int64) string {
+	switch {
+	case available >= required:
+		return StatusOK
+	case available == 0 && expired != 0:
+		return StatusExpired
+	case available == 0:
+		return StatusMissing
+	default:
+		return StatusShort
+	}
+}
@@ -0,0 +1,296 @@
+package webpush
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"main/internal/microservices/profile/utils/netguard"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// Размеры по RFC 8291: открытый ключ P-256 без сжатия, секрет auth подписки и соль записи
+const (
+	keyLength  = 65
+	authLength = 16
+	saltLength = 16
+	recordSize = 4096
+	tagLength  = 16
+)
+
+// MaxPayload — наибольший размер сообщения, которое помещается в одну запись aes128gcm
+const MaxPayload = recordSize - tagLength - 1
+
+// TTL — сколько секунд push-сервис хранит сообщение для браузера, который сейчас не в сети
+const TTL = 12 * 60 * 60
+
+const tokenLifetime = 12 * time.Hour
+
+var (
+	ErrWrongSubscription = errors.New("wrong push subscription")
+	ErrWrongKey          = errors.New("wrong vapid key")
+	ErrPayloadTooLarge   = errors.New("push payload is too large")
+	ErrGone              = errors.New("push subscription is gone")
+	ErrDelivery          = errors.New("push delivery failed")
+)
+
+var encoding = base64.RawURLEncoding
+
+// Subscription — подписка браузера из PushSubscription.toJSON(): адрес push-сервиса
+// и ключи p256dh и auth для шифрования сообщений
+type Subscription struct {
+	Endpoint string
+	P256dh   string
+	Auth     string
+}
+
+// Validate проверяет адрес и ключи подписки до того, как она будет сохранена
+func Validate(subscription Subscription) error {
+	parsed, err := url.Parse(subscription.Endpoint)
+	if err != nil || parsed.Scheme != "https" || parsed.Hostname() == "" {
+		return ErrWrongSubscription
+	}
+	if err = netguard.CheckHost(parsed.Hostname()); err != nil {
+		return ErrWrongSubscription
+	}
+	if _, _, err = subscriptionKeys(subscription); err != nil {
+		return err
+	}
+	return nil
+}
+
+func subscriptionKeys(subscription Subscription) (*ecdsa.PublicKey, []byte, error) {
+	public, err := decode(subscription.P256dh)
+	if err != nil || len(public) != keyLength {
+		return nil, nil, ErrWrongSubscription
+	}
+	x, y := elliptic.Unmarshal(elliptic.P256(), public)
+	if x == nil {
+		return nil, nil, ErrWrongSubscription
+	}
+
+	auth, err := decode(subscription.Auth)
+	if err != nil || len(auth) != authLength {
+		return nil, nil, ErrWrongSubscription
+	}
+	return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, auth, nil
+}
+
+// decode принимает base64url как без дополнения, так и с ним: браузеры отдают ключи по-разному
+func decode(value string) ([]byte, error) {
+	return encoding.DecodeString(string(bytes.TrimRight([]byte(value), "=")))
+}
+
+// GenerateKeys создаёт пару ключей VAPID в base64url: открытый ключ передаётся браузеру
+// при подписке, закрытый хранится только у отправителя
+func GenerateKeys() (string, string, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+	public := elliptic.Marshal(elliptic.P256(), key.X, key.Y)
+	return encoding.EncodeToString(public), encoding.EncodeToString(key.D.FillBytes(make([]byte, 32))), nil
+}
+
+// Encrypt шифрует сообщение для подписки по RFC 8291 в формате aes128gcm (RFC 8188)
+func Encrypt(subscription Subscription, payload []byte) ([]byte, error) {
+	if len(payload) > MaxPayload {
+		return nil, ErrPayloadTooLarge
+	}
+
+	userKey, auth, err := subscriptionKeys(subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	serverKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, saltLength)
+	if _, err = rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	return encrypt(userKey, auth, serverKey, salt, payload)
+}
+
+func encrypt(userKey *ecdsa.PublicKey, auth []byte, serverKey *ecdsa.PrivateKey, salt, payload []byte) ([]byte, error) {
+	userPublic := elliptic.Marshal(elliptic.P256(), userKey.X, userKey.Y)
+	serverPublic := elliptic.Marshal(elliptic.P256(), serverKey.X, serverKey.Y)
+
+	sharedX, _ := elliptic.P256().ScalarMult(userKey.X, userKey.Y, serverKey.D.Bytes())
+	shared := sharedX.FillBytes(make([]byte, 32))
+
+	keyInfo := append(append([]byte("WebPush: info\x00"), userPublic...), serverPublic...)
+	ikm, err := expand(hkdf.Extract(sha256.New, shared, auth), keyInfo, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	prk := hkdf.Extract(sha256.New, ikm, salt)
+	contentKey, err := expand(prk, []byte("Content-Encoding: aes128gcm\x00"), 16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := expand(prk, []byte("Content-Encoding: nonce\x00"), 12)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(contentKey)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// единственная запись заканчивается разделителем 0x02 без дополнения
+	record := append(append([]byte{}, payload...), 2)
+
+	var body bytes.Buffer
+	body.Write(salt)
+	binary.Write(&body, binary.BigEndian, uint32(recordSize))
+	body.WriteByte(byte(len(serverPublic)))
+	body.Write(serverPublic)
+	body.Write(gcm.Seal(nil, nonce, record, nil))
+	return body.Bytes(), nil
+}
+
+func expand(prk, info []byte, length int) ([]byte, error) {
+	out := make([]byte, length)
+	if _, err := io.ReadFull(hkdf.Expand(sha256.New, prk, info), out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Client отправляет сообщения в push-сервисы браузеров, подписывая запросы ключом VAPID (RFC 8292)
+type Client struct {
+	client    *http.Client
+	subject   string
+	publicKey string
+	key       *ecdsa.PrivateKey
+}
+
+// NewClient принимает контакт отправителя (mailto: или https:) и пару ключей из GenerateKeys
+func NewClient(client *http.Client, subject, publicKey, privateKey string) (*Client, error) {
+	public, err := decode(publicKey)
+	if err != nil || len(public) != keyLength {
+		return nil, ErrWrongKey
+	}
+	x, y := elliptic.Unmarshal(elliptic.P256(), public)
+	if x == nil {
+		return nil, ErrWrongKey
+	}
+
+	private, err := decode(privateKey)
+	if err != nil || len(private) != 32 {
+		return nil, ErrWrongKey
+	}
+
+	key := &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y},
+		D:         new(big.Int).SetBytes(private),
+	}
+	if checkX, checkY := elliptic.P256().ScalarBaseMult(private); checkX.Cmp(x) != 0 || checkY.Cmp(y) != 0 {
+		return nil, ErrWrongKey
+	}
+
+	return &Client{
+		client:    client,
+		subject:   subject,
+		publicKey: encoding.EncodeToString(public),
+		key:       key,
+	}, nil
+}
+
+// Send шифрует и отправляет сообщение. ErrGone означает, что браузер отписался
+// и подписку нужно удалить
+func (c *Client) Send(subscription Subscription, payload []byte) error {
+	body, err := Encrypt(subscription, payload)
+	if err != nil {
+		return err
+	}
+
+	token, err := c.token(subscription.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest(http.MethodPost, subscription.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "vapid t="+token+", k="+c.publicKey)
+	request.Header.Set("Content-Encoding", "aes128gcm")
+	request.Header.Set("Content-Type", "application/octet-stream")
+	request.Header.Set("TTL", strconv.Itoa(TTL))
+	request.Header.Set("Urgency", "high")
+
+	response, err := c.client.Do(request)
+	if err != nil {
+		return err
+	}
+	response.Body.Close()
+
+	switch {
+	case response.StatusCode >= 200 && response.StatusCode < 300:
+		return nil
+	case response.StatusCode == http.StatusNotFound || response.StatusCode == http.StatusGone:
+		return ErrGone
+	default:
+		return fmt.Errorf("%w: %s returned %d", ErrDelivery, subscription.Endpoint, response.StatusCode)
+	}
+}
+
+// token возвращает JWT VAPID для push-сервиса, которому принадлежит endpoint
+func (c *Client) token(endpoint string) (string, error) {
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return "", ErrWrongSubscription
+	}
+
+	header, err := json.Marshal(map[string]string{"typ": "JWT", "alg": "ES256"})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]interface{}{
+		"aud": parsed.Scheme + "://" + parsed.Host,
+		"exp": time.Now().Add(tokenLifetime).Unix(),
+		"sub": c.subject,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := encoding.EncodeToString(header) + "." + encoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	r, s, err := ecdsa.Sign(rand.Reader, c.key, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+	return unsigned + "." + encoding.EncodeToString(signature), nil
+}
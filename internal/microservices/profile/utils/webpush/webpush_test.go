@@ -0,0 +1,85 @@
+package webpush
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+// Пример из приложения A RFC 8291
+const (
+	rfcPlaintext  = "When I grow up, I want to be a watermelon"
+	rfcServerKey  = "yfWPiYE-n46HLnH0KqZOF1fJJU3MYrct3AELtAQ-oRw"
+	rfcUserPublic = "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4"
+	rfcAuth       = "BTBZMqHH6r4Tts7J_aSIgg"
+	rfcSalt       = "DGv6ra1nlYgDCS1FRnbzlw"
+	rfcBody       = "DGv6ra1nlYgDCS1FRnbzlwAAEABBBP4z9KsN6nGRTbVYI_c7VJSPQTBtkgcy27mlmlMoZIIgDll6e3vCYLocInmYWAmS6TlzAC8wEqKK6PBru3jl7A_yl95bQpu6cVPTpK4Mqgkf1CXztLVBSt2Ks3oZwbuwXPXLWyouBWLVWGNWQexSgSxsj_Qulcy4a-fN"
+)
+
+func TestEncryptKnownAnswer(t *testing.T) {
+	userKey, auth, err := subscriptionKeys(Subscription{P256dh: rfcUserPublic, Auth: rfcAuth})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	private, err := decode(rfcServerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverKey := &ecdsa.PrivateKey{PublicKey: ecdsa.PublicKey{Curve: elliptic.P256()}, D: new(big.Int).SetBytes(private)}
+	serverKey.X, serverKey.Y = elliptic.P256().ScalarBaseMult(private)
+
+	salt, err := decode(rfcSalt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := encrypt(userKey, auth, serverKey, salt, []byte(rfcPlaintext))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := encoding.EncodeToString(body); got != rfcBody {
+		t.Errorf("encrypt() = %s, want %s", got, rfcBody)
+	}
+}
+
+func TestEncryptRejects(t *testing.T) {
+	valid := Subscription{Endpoint: "https://push.example.com/send/1", P256dh: rfcUserPublic, Auth: rfcAuth}
+
+	tests := []struct {
+		name         string
+		subscription Subscription
+		payload      []byte
+		want         error
+	}{
+		{"payload too large", valid, make([]byte, MaxPayload+1), ErrPayloadTooLarge},
+		{"short auth", Subscription{P256dh: rfcUserPublic, Auth: "BTBZMqHH6r4"}, nil, ErrWrongSubscription},
+		{"key not on the curve", Subscription{P256dh: "BAAA" + rfcUserPublic[4:], Auth: rfcAuth}, nil, ErrWrongSubscription},
+		{"not base64", Subscription{P256dh: "!!!", Auth: rfcAuth}, nil, ErrWrongSubscription},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := Encrypt(test.subscription, test.payload); !errors.Is(err, test.want) {
+				t.Errorf("Encrypt error = %v, want %v", err, test.want)
+			}
+		})
+	}
+}
+
+func TestDecodeAcceptsPadding(t *testing.T) {
+	plain, err := decode(rfcAuth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	padded, err := decode(rfcAuth + "==")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(plain) != string(padded) || len(plain) != authLength {
+		t.Errorf("decode with padding = %x, without = %x", padded, plain)
+	}
+}
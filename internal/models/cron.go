@@ -1,6 +1,9 @@
 package models
 
+import "time"
+
 type NotificationsFrom struct {
+	ID           int64
 	IDFrom       int64
 	ToIsUser     bool
 	IDTo         int64
@@ -9,3 +12,43 @@ type NotificationsFrom struct {
 	Email        string
 	IDFamily     int64
 }
+
+type LowStock struct {
+	IDMedicine   int64
+	NameMedicine string
+	Count        int64
+	MinCount     int64
+	Unit         string
+	IDUser       int64
+	IDFamily     int64
+}
+
+// Recipient — пользователь, которому cron отправляет письмо, с его настройками уведомлений
+type Recipient struct {
+	ID         int64
+	Email      string
+	TimeZone   string
+	IDFamily   int64
+	Channels   string
+	QuietFrom  string
+	QuietTo    string
+	Dependents string
+}
+
+type DigestDose struct {
+	NamePerson   string
+	NameMedicine string
+	Time         time.Time
+	Taken        bool
+}
+
+// OutboxItem — сообщение из очереди отправки вместе с адресом получателя в канале
+type OutboxItem struct {
+	ID       int64
+	Channel  string
+	Message  []byte
+	Attempts int
+	Address  string
+	Secret   string
+	Key      string
+}
@@ -1,14 +1,15 @@
 package models
 
 type ProfileUserDTO struct {
-	ID      int64  `json:"id" form:"id"`
-	Name    string `json:"name" form:"name"`
-	Surname string `json:"surname" form:"surname"`
-	Email   string `json:"email" form:"email"`
-	Avatar  string `json:"avatar" form:"avatar"`
-	Date    string `json:"date" form:"date"`
-	Main    bool   `json:"main" form:"main"`
-	Adult   bool   `json:"adult" form:"adult"`
+	ID       int64  `json:"id" form:"id"`
+	Name     string `json:"name" form:"name"`
+	Surname  string `json:"surname" form:"surname"`
+	Email    string `json:"email" form:"email"`
+	Avatar   string `json:"avatar" form:"avatar"`
+	Date     string `json:"date" form:"date"`
+	Main     bool   `json:"main" form:"main"`
+	Adult    bool   `json:"adult" form:"adult"`
+	TimeZone string `json:"time_zone" form:"time_zone"`
 }
 
 type EditProfileDTO struct {
@@ -16,6 +17,7 @@ type EditProfileDTO struct {
 	Surname  string `json:"surname" form:"surname"`
 	Password string `json:"password" form:"password"`
 	Date     string `json:"date" form:"date"`
+	TimeZone string `json:"time_zone" form:"time_zone"`
 }
 
 type EmailUserDTO struct {
@@ -34,6 +36,12 @@ type MedecineIDDTO struct {
 	ID int64 `json:"id" form:"id"`
 }
 
+type DisposeMedicineDTO struct {
+	ID     int64  `json:"id" form:"id"`
+	Reason string `json:"reason" form:"reason"`
+	Date   string `json:"date" form:"date"`
+}
+
 type Member struct {
 	ID     int64  `json:"id" form:"id"`
 	Name   string `json:"name" form:"name"`
@@ -43,14 +51,33 @@ type Member struct {
 }
 
 type InviteUserDTO struct {
+	Email  string `json:"email" form:"email"`
+	Adult  bool   `json:"adult" form:"adult"`
+	Member int64  `json:"member" form:"member"`
+}
+
+type PromoteMemberDTO struct {
+	ID    int64  `json:"id" form:"id"`
 	Email string `json:"email" form:"email"`
-	Adult bool   `json:"adult" form:"adult"`
 }
 
 type AddMedicineDTO struct {
-	Name      string `json:"name" form:"name"`
-	IsTablets bool   `json:"is_tablets" form:"is_tablets"`
-	Count     int64  `json:"count" form:"count"`
+	Name        string   `json:"name" form:"name"`
+	Lot         string   `json:"lot" form:"lot"`
+	IsTablets   bool     `json:"is_tablets" form:"is_tablets"`
+	Count       int64    `json:"count" form:"count"`
+	Form        string   `json:"form" form:"form"`
+	Strength    string   `json:"strength" form:"strength"`
+	Unit        string   `json:"unit" form:"unit"`
+	Ingredients []string `json:"ingredients" form:"ingredients"`
+	MinCount    int64    `json:"min_count" form:"min_count"`
+	ExpiryDate  string   `json:"expiry_date" form:"expiry_date"`
+	IDKit       int64    `json:"id_kit" form:"id_kit"`
+}
+
+type MinCountDTO struct {
+	ID       int64 `json:"id" form:"id"`
+	MinCount int64 `json:"min_count" form:"min_count"`
 }
 
 type AddNotificationDTO struct {
@@ -60,8 +87,8 @@ type AddNotificationDTO struct {
 	IDToUser     int64  `json:"id_to_user" form:"id_to_user"`
 	NameTo       string `json:"name_to" form:"name_to"`
 	Time         string `json:"time" form:"time"`
-	TimeZone     int64  `json:"time_zone" form:"time_zone"`
 	CountDays    int64  `json:"count_days" form:"count_days"`
+	Override     bool   `json:"override" form:"override"`
 }
 
 type NotificationIDDTO struct {
@@ -69,11 +96,25 @@ type NotificationIDDTO struct {
 }
 
 type Medicine struct {
-	ID        int64  `json:"id" form:"id"`
-	Name      string `json:"name" form:"name"`
-	Image     string `json:"image" form:"image"`
-	IsTablets bool   `json:"is_tablets" form:"is_tablets"`
-	Count     int64  `json:"count" form:"count"`
+	ID            int64    `json:"id" form:"id"`
+	Name          string   `json:"name" form:"name"`
+	Image         string   `json:"image" form:"image"`
+	IsTablets     bool     `json:"is_tablets" form:"is_tablets"`
+	Count         int64    `json:"count" form:"count"`
+	Form          string   `json:"form" form:"form"`
+	Strength      string   `json:"strength" form:"strength"`
+	Unit          string   `json:"unit" form:"unit"`
+	Ingredients   []string `json:"ingredients" form:"ingredients"`
+	MinCount      int64    `json:"min_count" form:"min_count"`
+	DaysOfSupply  int64    `json:"days_of_supply" form:"days_of_supply"`
+	RunOutDate    string   `json:"run_out_date" form:"run_out_date"`
+	ExpiryDate    string   `json:"expiry_date" form:"expiry_date"`
+	IDKit         int64    `json:"id_kit" form:"id_kit"`
+	KitName       string   `json:"kit_name" form:"kit_name"`
+	DisposeReason string   `json:"dispose_reason" form:"dispose_reason"`
+	DisposedDate  string   `json:"disposed_date" form:"disposed_date"`
+	Lot           string   `json:"lot" form:"lot"`
+	KitLocation   string   `json:"kit_location" form:"kit_location"`
 }
 
 type Notification struct {
@@ -90,3 +131,200 @@ type Accept struct {
 	IDNotification int64 `json:"id" form:"id"`
 	Count          int64 `json:"count" form:"count"`
 }
+
+type RescheduleDTO struct {
+	IDNotification int64  `json:"id" form:"id"`
+	Minutes        int64  `json:"minutes" form:"minutes"`
+	Time           string `json:"time" form:"time"`
+	Clock          string `json:"clock" form:"clock"`
+	Day            int64  `json:"day" form:"day"`
+}
+
+type Health struct {
+	IsUser            bool     `json:"is_user" form:"is_user"`
+	ID                int64    `json:"id" form:"id"`
+	BirthDate         string   `json:"birth_date" form:"birth_date"`
+	Weight            float64  `json:"weight" form:"weight"`
+	Allergies         []string `json:"allergies" form:"allergies"`
+	ChronicConditions []string `json:"chronic_conditions" form:"chronic_conditions"`
+	Notes             string   `json:"notes" form:"notes"`
+}
+
+type Warning struct {
+	Type       string `json:"type" form:"type"`
+	Ingredient string `json:"ingredient" form:"ingredient"`
+	Message    string `json:"message" form:"message"`
+	Severity   string `json:"severity" form:"severity"`
+}
+
+type Interaction struct {
+	IDMedicineA   int64  `json:"id_medicine_a" form:"id_medicine_a"`
+	NameMedicineA string `json:"name_medicine_a" form:"name_medicine_a"`
+	IDMedicineB   int64  `json:"id_medicine_b" form:"id_medicine_b"`
+	NameMedicineB string `json:"name_medicine_b" form:"name_medicine_b"`
+	IngredientA   string `json:"ingredient_a" form:"ingredient_a"`
+	IngredientB   string `json:"ingredient_b" form:"ingredient_b"`
+	Severity      string `json:"severity" form:"severity"`
+	Description   string `json:"description" form:"description"`
+}
+
+type StockChangeDTO struct {
+	ID     int64  `json:"id" form:"id"`
+	Delta  int64  `json:"delta" form:"delta"`
+	Reason string `json:"reason" form:"reason"`
+}
+
+type StockEntry struct {
+	ID       int64  `json:"id" form:"id"`
+	IDUser   int64  `json:"id_user" form:"id_user"`
+	NameUser string `json:"name_user" form:"name_user"`
+	Delta    int64  `json:"delta" form:"delta"`
+	Reason   string `json:"reason" form:"reason"`
+	Time     string `json:"time" form:"time"`
+}
+
+type ShoppingItemDTO struct {
+	IDMedicine int64  `json:"id_medicine" form:"id_medicine"`
+	Name       string `json:"name" form:"name"`
+	Count      int64  `json:"count" form:"count"`
+	Unit       string `json:"unit" form:"unit"`
+}
+
+type ShoppingItemIDDTO struct {
+	ID int64 `json:"id" form:"id"`
+}
+
+type ShoppingItem struct {
+	ID         int64  `json:"id" form:"id"`
+	IDUser     int64  `json:"id_user" form:"id_user"`
+	IDMedicine int64  `json:"id_medicine" form:"id_medicine"`
+	Name       string `json:"name" form:"name"`
+	Count      int64  `json:"count" form:"count"`
+	Unit       string `json:"unit" form:"unit"`
+	Reason     string `json:"reason" form:"reason"`
+	IsBought   bool   `json:"is_bought" form:"is_bought"`
+	IDBoughtBy int64  `json:"id_bought_by" form:"id_bought_by"`
+	Created    string `json:"created" form:"created"`
+}
+
+type Kit struct {
+	ID       int64  `json:"id" form:"id"`
+	IDUser   int64  `json:"id_user" form:"id_user"`
+	Name     string `json:"name" form:"name"`
+	Location string `json:"location" form:"location"`
+}
+
+type KitIDDTO struct {
+	ID int64 `json:"id" form:"id"`
+}
+
+type MoveMedicineDTO struct {
+	ID    int64 `json:"id" form:"id"`
+	IDKit int64 `json:"id_kit" form:"id_kit"`
+	Count int64 `json:"count" form:"count"`
+}
+
+type TemplateItem struct {
+	Name    string `json:"name" form:"name"`
+	Keyword string `json:"keyword" form:"keyword"`
+	Count   int64  `json:"count" form:"count"`
+	Unit    string `json:"unit" form:"unit"`
+}
+
+type Template struct {
+	ID     int64          `json:"id" form:"id"`
+	IDUser int64          `json:"id_user" form:"id_user"`
+	Name   string         `json:"name" form:"name"`
+	Items  []TemplateItem `json:"items" form:"items"`
+}
+
+type TemplateIDDTO struct {
+	ID int64 `json:"id" form:"id"`
+}
+
+type KitCheckDTO struct {
+	IDKit      int64 `json:"id_kit" form:"id_kit"`
+	IDTemplate int64 `json:"id_template" form:"id_template"`
+	Shopping   bool  `json:"shopping" form:"shopping"`
+}
+
+type KitReportItem struct {
+	Name      string `json:"name" form:"name"`
+	Required  int64  `json:"required" form:"required"`
+	Available int64  `json:"available" form:"available"`
+	Expired   int64  `json:"expired" form:"expired"`
+	Unit      string `json:"unit" form:"unit"`
+	Status    string `json:"status" form:"status"`
+}
+
+type ImportResult struct {
+	Row        int64  `json:"row" form:"row"`
+	IDMedicine int64  `json:"id_medicine" form:"id_medicine"`
+	Created    bool   `json:"created" form:"created"`
+	Error      string `json:"error" form:"error"`
+}
+
+type CalendarDTO struct {
+	IsUser bool  `json:"is_user" form:"is_user"`
+	ID     int64 `json:"id" form:"id"`
+}
+
+type NotificationSettings struct {
+	Channels   []string `json:"channels" form:"channels"`
+	QuietFrom  string   `json:"quiet_from" form:"quiet_from"`
+	QuietTo    string   `json:"quiet_to" form:"quiet_to"`
+	Dependents string   `json:"dependents" form:"dependents"`
+}
+
+type WebhookDTO struct {
+	URL string `json:"url" form:"url"`
+}
+
+type WebhookIDDTO struct {
+	ID int64 `json:"id" form:"id"`
+}
+
+type Webhook struct {
+	ID      int64  `json:"id"`
+	URL     string `json:"url"`
+	Secret  string `json:"secret,omitempty"`
+	Created string `json:"created"`
+}
+
+type PushKeysDTO struct {
+	P256dh string `json:"p256dh" form:"p256dh"`
+	Auth   string `json:"auth" form:"auth"`
+}
+
+// PushSubscriptionDTO совпадает с результатом PushSubscription.toJSON() в браузере
+type PushSubscriptionDTO struct {
+	Endpoint string      `json:"endpoint" form:"endpoint"`
+	Keys     PushKeysDTO `json:"keys" form:"keys"`
+}
+
+// PRNRuleDTO задаёт правила приёма по необходимости: интервал в минутах и максимум за сутки
+type PRNRuleDTO struct {
+	IsUser      bool  `json:"is_user" form:"is_user"`
+	ID          int64 `json:"id" form:"id"`
+	IDMedicine  int64 `json:"id_medicine" form:"id_medicine"`
+	MinInterval int64 `json:"min_interval" form:"min_interval"`
+	MaxDaily    int64 `json:"max_daily" form:"max_daily"`
+}
+
+type PRNIntakeDTO struct {
+	IsUser     bool  `json:"is_user" form:"is_user"`
+	ID         int64 `json:"id" form:"id"`
+	IDMedicine int64 `json:"id_medicine" form:"id_medicine"`
+	Count      int64 `json:"count" form:"count"`
+	Override   bool  `json:"override" form:"override"`
+}
+
+type PRNRule struct {
+	IDMedicine   int64  `json:"id_medicine" form:"id_medicine"`
+	NameMedicine string `json:"name_medicine" form:"name_medicine"`
+	MinInterval  int64  `json:"min_interval" form:"min_interval"`
+	MaxDaily     int64  `json:"max_daily" form:"max_daily"`
+	LastTime     string `json:"last_time" form:"last_time"`
+	Taken        int64  `json:"taken" form:"taken"`
+	NextAllowed  string `json:"next_allowed" form:"next_allowed"`
+}
@@ -11,16 +11,123 @@ type ResponseUserProfile struct {
 }
 
 type ResponseMembers struct {
-	Status  int      `json:"status"`
-	Members []Member `json:"members"`
+	Status     int      `json:"status"`
+	Members    []Member `json:"members"`
+	NextCursor string   `json:"next_cursor"`
 }
 
 type ResponseMedicine struct {
-	Status   int        `json:"status"`
-	Medicine []Medicine `json:"medicines"`
+	Status     int        `json:"status"`
+	Medicine   []Medicine `json:"medicines"`
+	NextCursor string     `json:"next_cursor"`
 }
 
 type ResponseNotification struct {
 	Status        int            `json:"status"`
 	Notifications []Notification `json:"notifications"`
+	NextCursor    string         `json:"next_cursor"`
+}
+
+type ResponseHealth struct {
+	Status int     `json:"status"`
+	Health *Health `json:"health"`
+}
+
+type ResponseWarnings struct {
+	Status   int       `json:"status"`
+	Message  string    `json:"message"`
+	Warnings []Warning `json:"warnings"`
+}
+
+type ResponseInteractions struct {
+	Status       int           `json:"status"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+type ResponseStockHistory struct {
+	Status  int          `json:"status"`
+	Count   int64        `json:"count"`
+	Unit    string       `json:"unit"`
+	History []StockEntry `json:"history"`
+}
+
+type ResponseShoppingList struct {
+	Status int            `json:"status"`
+	Items  []ShoppingItem `json:"items"`
+}
+
+type ResponseKits struct {
+	Status int   `json:"status"`
+	Kits   []Kit `json:"kits"`
+}
+
+type ResponseTemplates struct {
+	Status    int        `json:"status"`
+	Templates []Template `json:"templates"`
+}
+
+type ResponseKitReport struct {
+	Status int             `json:"status"`
+	Items  []KitReportItem `json:"items"`
+}
+
+type ResponseMedicineSearch struct {
+	Status   int        `json:"status"`
+	Medicine []Medicine `json:"medicines"`
+	HasMore  bool       `json:"has_more"`
+}
+
+type ResponseImport struct {
+	Status  int            `json:"status"`
+	Results []ImportResult `json:"results"`
+}
+
+type ResponseCalendar struct {
+	Status int    `json:"status"`
+	Token  string `json:"token"`
+	URL    string `json:"url"`
+}
+
+type ResponseNotificationSettings struct {
+	Status   int                   `json:"status"`
+	Settings *NotificationSettings `json:"settings"`
+}
+
+type ResponseWebhook struct {
+	Status  int      `json:"status"`
+	Webhook *Webhook `json:"webhook"`
+}
+
+type ResponseWebhooks struct {
+	Status   int       `json:"status"`
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+type ResponseTelegram struct {
+	Status  int    `json:"status"`
+	Code    string `json:"code"`
+	Expires string `json:"expires"`
+	URL     string `json:"url,omitempty"`
+}
+
+type ResponsePushKey struct {
+	Status int    `json:"status"`
+	Key    string `json:"key"`
+}
+
+type ResponseReschedule struct {
+	Status int    `json:"status"`
+	Time   string `json:"time"`
+}
+
+type ResponsePRNRules struct {
+	Status int       `json:"status"`
+	Rules  []PRNRule `json:"rules"`
+}
+
+type ResponsePRNIntake struct {
+	Status      int       `json:"status"`
+	Message     string    `json:"message"`
+	Warnings    []Warning `json:"warnings"`
+	NextAllowed string    `json:"next_allowed"`
 }
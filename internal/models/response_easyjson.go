@@ -17,7 +17,7 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson6ff3ac1dDecodeMainInternalModels(in *jlexer.Lexer, out *ResponseUserProfile) {
+func easyjson6ff3ac1dDecodeMainInternalModels(in *jlexer.Lexer, out *ResponseWebhooks) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -38,15 +38,28 @@ func easyjson6ff3ac1dDecodeMainInternalModels(in *jlexer.Lexer, out *ResponseUse
 		switch key {
 		case "status":
 			out.Status = int(in.Int())
-		case "user":
+		case "webhooks":
 			if in.IsNull() {
 				in.Skip()
-				out.UserData = nil
+				out.Webhooks = nil
 			} else {
-				if out.UserData == nil {
-					out.UserData = new(ProfileUserDTO)
+				in.Delim('[')
+				if out.Webhooks == nil {
+					if !in.IsDelim(']') {
+						out.Webhooks = make([]Webhook, 0, 1)
+					} else {
+						out.Webhooks = []Webhook{}
+					}
+				} else {
+					out.Webhooks = (out.Webhooks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 Webhook
+					easyjson6ff3ac1dDecodeMainInternalModels1(in, &v1)
+					out.Webhooks = append(out.Webhooks, v1)
+					in.WantComma()
 				}
-				easyjson6ff3ac1dDecodeMainInternalModels1(in, out.UserData)
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -58,7 +71,7 @@ func easyjson6ff3ac1dDecodeMainInternalModels(in *jlexer.Lexer, out *ResponseUse
 		in.Consumed()
 	}
 }
-func easyjson6ff3ac1dEncodeMainInternalModels(out *jwriter.Writer, in ResponseUserProfile) {
+func easyjson6ff3ac1dEncodeMainInternalModels(out *jwriter.Writer, in ResponseWebhooks) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -68,41 +81,48 @@ func easyjson6ff3ac1dEncodeMainInternalModels(out *jwriter.Writer, in ResponseUs
 		out.Int(int(in.Status))
 	}
 	{
-		const prefix string = ",\"user\":"
+		const prefix string = ",\"webhooks\":"
 		out.RawString(prefix)
-		if in.UserData == nil {
+		if in.Webhooks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
-			easyjson6ff3ac1dEncodeMainInternalModels1(out, *in.UserData)
+			out.RawByte('[')
+			for v2, v3 := range in.Webhooks {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				easyjson6ff3ac1dEncodeMainInternalModels1(out, v3)
+			}
+			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ResponseUserProfile) MarshalJSON() ([]byte, error) {
+func (v ResponseWebhooks) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6ff3ac1dEncodeMainInternalModels(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ResponseUserProfile) MarshalEasyJSON(w *jwriter.Writer) {
+func (v ResponseWebhooks) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6ff3ac1dEncodeMainInternalModels(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ResponseUserProfile) UnmarshalJSON(data []byte) error {
+func (v *ResponseWebhooks) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6ff3ac1dDecodeMainInternalModels(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ResponseUserProfile) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *ResponseWebhooks) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6ff3ac1dDecodeMainInternalModels(l, v)
 }
-func easyjson6ff3ac1dDecodeMainInternalModels1(in *jlexer.Lexer, out *ProfileUserDTO) {
+func easyjson6ff3ac1dDecodeMainInternalModels1(in *jlexer.Lexer, out *Webhook) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -123,20 +143,12 @@ func easyjson6ff3ac1dDecodeMainInternalModels1(in *jlexer.Lexer, out *ProfileUse
 		switch key {
 		case "id":
 			out.ID = int64(in.Int64())
-		case "name":
-			out.Name = string(in.String())
-		case "surname":
-			out.Surname = string(in.String())
-		case "email":
-			out.Email = string(in.String())
-		case "avatar":
-			out.Avatar = string(in.String())
-		case "date":
-			out.Date = string(in.String())
-		case "main":
-			out.Main = bool(in.Bool())
-		case "adult":
-			out.Adult = bool(in.Bool())
+		case "url":
+			out.URL = string(in.String())
+		case "secret":
+			out.Secret = string(in.String())
+		case "created":
+			out.Created = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -147,7 +159,7 @@ func easyjson6ff3ac1dDecodeMainInternalModels1(in *jlexer.Lexer, out *ProfileUse
 		in.Consumed()
 	}
 }
-func easyjson6ff3ac1dEncodeMainInternalModels1(out *jwriter.Writer, in ProfileUserDTO) {
+func easyjson6ff3ac1dEncodeMainInternalModels1(out *jwriter.Writer, in Webhook) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -157,43 +169,3008 @@ func easyjson6ff3ac1dEncodeMainInternalModels1(out *jwriter.Writer, in ProfileUs
 		out.Int64(int64(in.ID))
 	}
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	if in.Secret != "" {
+		const prefix string = ",\"secret\":"
+		out.RawString(prefix)
+		out.String(string(in.Secret))
+	}
+	{
+		const prefix string = ",\"created\":"
+		out.RawString(prefix)
+		out.String(string(in.Created))
+	}
+	out.RawByte('}')
+}
+func easyjson6ff3ac1dDecodeMainInternalModels2(in *jlexer.Lexer, out *ResponseWebhook) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = int(in.Int())
+		case "webhook":
+			if in.IsNull() {
+				in.Skip()
+				out.Webhook = nil
+			} else {
+				if out.Webhook == nil {
+					out.Webhook = new(Webhook)
+				}
+				easyjson6ff3ac1dDecodeMainInternalModels1(in, out.Webhook)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels2(out *jwriter.Writer, in ResponseWebhook) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"webhook\":"
+		out.RawString(prefix)
+		if in.Webhook == nil {
+			out.RawString("null")
+		} else {
+			easyjson6ff3ac1dEncodeMainInternalModels1(out, *in.Webhook)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseWebhook) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeMainInternalModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseWebhook) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeMainInternalModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseWebhook) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeMainInternalModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseWebhook) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeMainInternalModels2(l, v)
+}
+func easyjson6ff3ac1dDecodeMainInternalModels3(in *jlexer.Lexer, out *ResponseWarnings) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = int(in.Int())
+		case "message":
+			out.Message = string(in.String())
+		case "warnings":
+			if in.IsNull() {
+				in.Skip()
+				out.Warnings = nil
+			} else {
+				in.Delim('[')
+				if out.Warnings == nil {
+					if !in.IsDelim(']') {
+						out.Warnings = make([]Warning, 0, 1)
+					} else {
+						out.Warnings = []Warning{}
+					}
+				} else {
+					out.Warnings = (out.Warnings)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 Warning
+					easyjson6ff3ac1dDecodeMainInternalModels4(in, &v4)
+					out.Warnings = append(out.Warnings, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels3(out *jwriter.Writer, in ResponseWarnings) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	{
+		const prefix string = ",\"warnings\":"
+		out.RawString(prefix)
+		if in.Warnings == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Warnings {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				easyjson6ff3ac1dEncodeMainInternalModels4(out, v6)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseWarnings) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeMainInternalModels3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseWarnings) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeMainInternalModels3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseWarnings) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeMainInternalModels3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseWarnings) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeMainInternalModels3(l, v)
+}
+func easyjson6ff3ac1dDecodeMainInternalModels4(in *jlexer.Lexer, out *Warning) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "ingredient":
+			out.Ingredient = string(in.String())
+		case "message":
+			out.Message = string(in.String())
+		case "severity":
+			out.Severity = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels4(out *jwriter.Writer, in Warning) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"ingredient\":"
+		out.RawString(prefix)
+		out.String(string(in.Ingredient))
+	}
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	{
+		const prefix string = ",\"severity\":"
 		out.RawString(prefix)
+		out.String(string(in.Severity))
+	}
+	out.RawByte('}')
+}
+func easyjson6ff3ac1dDecodeMainInternalModels5(in *jlexer.Lexer, out *ResponseUserProfile) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = int(in.Int())
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+				out.UserData = nil
+			} else {
+				if out.UserData == nil {
+					out.UserData = new(ProfileUserDTO)
+				}
+				easyjson6ff3ac1dDecodeMainInternalModels6(in, out.UserData)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels5(out *jwriter.Writer, in ResponseUserProfile) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		if in.UserData == nil {
+			out.RawString("null")
+		} else {
+			easyjson6ff3ac1dEncodeMainInternalModels6(out, *in.UserData)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseUserProfile) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeMainInternalModels5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseUserProfile) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeMainInternalModels5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseUserProfile) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeMainInternalModels5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseUserProfile) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeMainInternalModels5(l, v)
+}
+func easyjson6ff3ac1dDecodeMainInternalModels6(in *jlexer.Lexer, out *ProfileUserDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "name":
+			out.Name = string(in.String())
+		case "surname":
+			out.Surname = string(in.String())
+		case "email":
+			out.Email = string(in.String())
+		case "avatar":
+			out.Avatar = string(in.String())
+		case "date":
+			out.Date = string(in.String())
+		case "main":
+			out.Main = bool(in.Bool())
+		case "adult":
+			out.Adult = bool(in.Bool())
+		case "time_zone":
+			out.TimeZone = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels6(out *jwriter.Writer, in ProfileUserDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"surname\":"
+		out.RawString(prefix)
+		out.String(string(in.Surname))
+	}
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"avatar\":"
+		out.RawString(prefix)
+		out.String(string(in.Avatar))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.String(string(in.Date))
+	}
+	{
+		const prefix string = ",\"main\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Main))
+	}
+	{
+		const prefix string = ",\"adult\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Adult))
+	}
+	{
+		const prefix string = ",\"time_zone\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeZone))
+	}
+	out.RawByte('}')
+}
+func easyjson6ff3ac1dDecodeMainInternalModels7(in *jlexer.Lexer, out *ResponseTemplates) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = int(in.Int())
+		case "templates":
+			if in.IsNull() {
+				in.Skip()
+				out.Templates = nil
+			} else {
+				in.Delim('[')
+				if out.Templates == nil {
+					if !in.IsDelim(']') {
+						out.Templates = make([]Template, 0, 1)
+					} else {
+						out.Templates = []Template{}
+					}
+				} else {
+					out.Templates = (out.Templates)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 Template
+					easyjson6ff3ac1dDecodeMainInternalModels8(in, &v7)
+					out.Templates = append(out.Templates, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels7(out *jwriter.Writer, in ResponseTemplates) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"templates\":"
+		out.RawString(prefix)
+		if in.Templates == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Templates {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				easyjson6ff3ac1dEncodeMainInternalModels8(out, v9)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseTemplates) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeMainInternalModels7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseTemplates) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeMainInternalModels7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseTemplates) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeMainInternalModels7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseTemplates) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeMainInternalModels7(l, v)
+}
+func easyjson6ff3ac1dDecodeMainInternalModels8(in *jlexer.Lexer, out *Template) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "id_user":
+			out.IDUser = int64(in.Int64())
+		case "name":
+			out.Name = string(in.String())
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else {
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]TemplateItem, 0, 1)
+					} else {
+						out.Items = []TemplateItem{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 TemplateItem
+					easyjson6ff3ac1dDecodeMainInternalModels9(in, &v10)
+					out.Items = append(out.Items, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels8(out *jwriter.Writer, in Template) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"id_user\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.IDUser))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix)
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.Items {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				easyjson6ff3ac1dEncodeMainInternalModels9(out, v12)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjson6ff3ac1dDecodeMainInternalModels9(in *jlexer.Lexer, out *TemplateItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "keyword":
+			out.Keyword = string(in.String())
+		case "count":
+			out.Count = int64(in.Int64())
+		case "unit":
+			out.Unit = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels9(out *jwriter.Writer, in TemplateItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"keyword\":"
+		out.RawString(prefix)
+		out.String(string(in.Keyword))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Count))
+	}
+	{
+		const prefix string = ",\"unit\":"
+		out.RawString(prefix)
+		out.String(string(in.Unit))
+	}
+	out.RawByte('}')
+}
+func easyjson6ff3ac1dDecodeMainInternalModels10(in *jlexer.Lexer, out *ResponseTelegram) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = int(in.Int())
+		case "code":
+			out.Code = string(in.String())
+		case "expires":
+			out.Expires = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels10(out *jwriter.Writer, in ResponseTelegram) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix)
+		out.String(string(in.Code))
+	}
+	{
+		const prefix string = ",\"expires\":"
+		out.RawString(prefix)
+		out.String(string(in.Expires))
+	}
+	if in.URL != "" {
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseTelegram) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeMainInternalModels10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseTelegram) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeMainInternalModels10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseTelegram) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeMainInternalModels10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseTelegram) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeMainInternalModels10(l, v)
+}
+func easyjson6ff3ac1dDecodeMainInternalModels11(in *jlexer.Lexer, out *ResponseStockHistory) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = int(in.Int())
+		case "count":
+			out.Count = int64(in.Int64())
+		case "unit":
+			out.Unit = string(in.String())
+		case "history":
+			if in.IsNull() {
+				in.Skip()
+				out.History = nil
+			} else {
+				in.Delim('[')
+				if out.History == nil {
+					if !in.IsDelim(']') {
+						out.History = make([]StockEntry, 0, 0)
+					} else {
+						out.History = []StockEntry{}
+					}
+				} else {
+					out.History = (out.History)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 StockEntry
+					easyjson6ff3ac1dDecodeMainInternalModels12(in, &v13)
+					out.History = append(out.History, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels11(out *jwriter.Writer, in ResponseStockHistory) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Count))
+	}
+	{
+		const prefix string = ",\"unit\":"
+		out.RawString(prefix)
+		out.String(string(in.Unit))
+	}
+	{
+		const prefix string = ",\"history\":"
+		out.RawString(prefix)
+		if in.History == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.History {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				easyjson6ff3ac1dEncodeMainInternalModels12(out, v15)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseStockHistory) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeMainInternalModels11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseStockHistory) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeMainInternalModels11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseStockHistory) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeMainInternalModels11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseStockHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeMainInternalModels11(l, v)
+}
+func easyjson6ff3ac1dDecodeMainInternalModels12(in *jlexer.Lexer, out *StockEntry) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "id_user":
+			out.IDUser = int64(in.Int64())
+		case "name_user":
+			out.NameUser = string(in.String())
+		case "delta":
+			out.Delta = int64(in.Int64())
+		case "reason":
+			out.Reason = string(in.String())
+		case "time":
+			out.Time = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels12(out *jwriter.Writer, in StockEntry) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"id_user\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.IDUser))
+	}
+	{
+		const prefix string = ",\"name_user\":"
+		out.RawString(prefix)
+		out.String(string(in.NameUser))
+	}
+	{
+		const prefix string = ",\"delta\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Delta))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.String(string(in.Time))
+	}
+	out.RawByte('}')
+}
+func easyjson6ff3ac1dDecodeMainInternalModels13(in *jlexer.Lexer, out *ResponseShoppingList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = int(in.Int())
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else {
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]ShoppingItem, 0, 0)
+					} else {
+						out.Items = []ShoppingItem{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v16 ShoppingItem
+					easyjson6ff3ac1dDecodeMainInternalModels14(in, &v16)
+					out.Items = append(out.Items, v16)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels13(out *jwriter.Writer, in ResponseShoppingList) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix)
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v17, v18 := range in.Items {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				easyjson6ff3ac1dEncodeMainInternalModels14(out, v18)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseShoppingList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeMainInternalModels13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseShoppingList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeMainInternalModels13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseShoppingList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeMainInternalModels13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseShoppingList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeMainInternalModels13(l, v)
+}
+func easyjson6ff3ac1dDecodeMainInternalModels14(in *jlexer.Lexer, out *ShoppingItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "id_user":
+			out.IDUser = int64(in.Int64())
+		case "id_medicine":
+			out.IDMedicine = int64(in.Int64())
+		case "name":
+			out.Name = string(in.String())
+		case "count":
+			out.Count = int64(in.Int64())
+		case "unit":
+			out.Unit = string(in.String())
+		case "reason":
+			out.Reason = string(in.String())
+		case "is_bought":
+			out.IsBought = bool(in.Bool())
+		case "id_bought_by":
+			out.IDBoughtBy = int64(in.Int64())
+		case "created":
+			out.Created = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels14(out *jwriter.Writer, in ShoppingItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"id_user\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.IDUser))
+	}
+	{
+		const prefix string = ",\"id_medicine\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.IDMedicine))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Count))
+	}
+	{
+		const prefix string = ",\"unit\":"
+		out.RawString(prefix)
+		out.String(string(in.Unit))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	{
+		const prefix string = ",\"is_bought\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsBought))
+	}
+	{
+		const prefix string = ",\"id_bought_by\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.IDBoughtBy))
+	}
+	{
+		const prefix string = ",\"created\":"
+		out.RawString(prefix)
+		out.String(string(in.Created))
+	}
+	out.RawByte('}')
+}
+func easyjson6ff3ac1dDecodeMainInternalModels15(in *jlexer.Lexer, out *ResponseReschedule) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = int(in.Int())
+		case "time":
+			out.Time = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels15(out *jwriter.Writer, in ResponseReschedule) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.String(string(in.Time))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseReschedule) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeMainInternalModels15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseReschedule) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeMainInternalModels15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseReschedule) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeMainInternalModels15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseReschedule) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeMainInternalModels15(l, v)
+}
+func easyjson6ff3ac1dDecodeMainInternalModels16(in *jlexer.Lexer, out *ResponsePushKey) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = int(in.Int())
+		case "key":
+			out.Key = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels16(out *jwriter.Writer, in ResponsePushKey) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"key\":"
+		out.RawString(prefix)
+		out.String(string(in.Key))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponsePushKey) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeMainInternalModels16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponsePushKey) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeMainInternalModels16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponsePushKey) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeMainInternalModels16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponsePushKey) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeMainInternalModels16(l, v)
+}
+func easyjson6ff3ac1dDecodeMainInternalModels17(in *jlexer.Lexer, out *ResponsePRNRules) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = int(in.Int())
+		case "rules":
+			if in.IsNull() {
+				in.Skip()
+				out.Rules = nil
+			} else {
+				in.Delim('[')
+				if out.Rules == nil {
+					if !in.IsDelim(']') {
+						out.Rules = make([]PRNRule, 0, 0)
+					} else {
+						out.Rules = []PRNRule{}
+					}
+				} else {
+					out.Rules = (out.Rules)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 PRNRule
+					easyjson6ff3ac1dDecodeMainInternalModels18(in, &v19)
+					out.Rules = append(out.Rules, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels17(out *jwriter.Writer, in ResponsePRNRules) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"rules\":"
+		out.RawString(prefix)
+		if in.Rules == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v20, v21 := range in.Rules {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				easyjson6ff3ac1dEncodeMainInternalModels18(out, v21)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponsePRNRules) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeMainInternalModels17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponsePRNRules) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeMainInternalModels17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponsePRNRules) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeMainInternalModels17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponsePRNRules) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeMainInternalModels17(l, v)
+}
+func easyjson6ff3ac1dDecodeMainInternalModels18(in *jlexer.Lexer, out *PRNRule) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id_medicine":
+			out.IDMedicine = int64(in.Int64())
+		case "name_medicine":
+			out.NameMedicine = string(in.String())
+		case "min_interval":
+			out.MinInterval = int64(in.Int64())
+		case "max_daily":
+			out.MaxDaily = int64(in.Int64())
+		case "last_time":
+			out.LastTime = string(in.String())
+		case "taken":
+			out.Taken = int64(in.Int64())
+		case "next_allowed":
+			out.NextAllowed = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels18(out *jwriter.Writer, in PRNRule) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id_medicine\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.IDMedicine))
+	}
+	{
+		const prefix string = ",\"name_medicine\":"
+		out.RawString(prefix)
+		out.String(string(in.NameMedicine))
+	}
+	{
+		const prefix string = ",\"min_interval\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MinInterval))
+	}
+	{
+		const prefix string = ",\"max_daily\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MaxDaily))
+	}
+	{
+		const prefix string = ",\"last_time\":"
+		out.RawString(prefix)
+		out.String(string(in.LastTime))
+	}
+	{
+		const prefix string = ",\"taken\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Taken))
+	}
+	{
+		const prefix string = ",\"next_allowed\":"
+		out.RawString(prefix)
+		out.String(string(in.NextAllowed))
+	}
+	out.RawByte('}')
+}
+func easyjson6ff3ac1dDecodeMainInternalModels19(in *jlexer.Lexer, out *ResponsePRNIntake) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = int(in.Int())
+		case "message":
+			out.Message = string(in.String())
+		case "warnings":
+			if in.IsNull() {
+				in.Skip()
+				out.Warnings = nil
+			} else {
+				in.Delim('[')
+				if out.Warnings == nil {
+					if !in.IsDelim(']') {
+						out.Warnings = make([]Warning, 0, 1)
+					} else {
+						out.Warnings = []Warning{}
+					}
+				} else {
+					out.Warnings = (out.Warnings)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v22 Warning
+					easyjson6ff3ac1dDecodeMainInternalModels4(in, &v22)
+					out.Warnings = append(out.Warnings, v22)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "next_allowed":
+			out.NextAllowed = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels19(out *jwriter.Writer, in ResponsePRNIntake) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	{
+		const prefix string = ",\"warnings\":"
+		out.RawString(prefix)
+		if in.Warnings == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v23, v24 := range in.Warnings {
+				if v23 > 0 {
+					out.RawByte(',')
+				}
+				easyjson6ff3ac1dEncodeMainInternalModels4(out, v24)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"next_allowed\":"
+		out.RawString(prefix)
+		out.String(string(in.NextAllowed))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponsePRNIntake) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeMainInternalModels19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponsePRNIntake) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeMainInternalModels19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponsePRNIntake) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeMainInternalModels19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponsePRNIntake) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeMainInternalModels19(l, v)
+}
+func easyjson6ff3ac1dDecodeMainInternalModels20(in *jlexer.Lexer, out *ResponseNotificationSettings) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = int(in.Int())
+		case "settings":
+			if in.IsNull() {
+				in.Skip()
+				out.Settings = nil
+			} else {
+				if out.Settings == nil {
+					out.Settings = new(NotificationSettings)
+				}
+				easyjson6ff3ac1dDecodeMainInternalModels21(in, out.Settings)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels20(out *jwriter.Writer, in ResponseNotificationSettings) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"settings\":"
+		out.RawString(prefix)
+		if in.Settings == nil {
+			out.RawString("null")
+		} else {
+			easyjson6ff3ac1dEncodeMainInternalModels21(out, *in.Settings)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseNotificationSettings) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeMainInternalModels20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseNotificationSettings) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeMainInternalModels20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseNotificationSettings) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeMainInternalModels20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseNotificationSettings) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeMainInternalModels20(l, v)
+}
+func easyjson6ff3ac1dDecodeMainInternalModels21(in *jlexer.Lexer, out *NotificationSettings) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "channels":
+			if in.IsNull() {
+				in.Skip()
+				out.Channels = nil
+			} else {
+				in.Delim('[')
+				if out.Channels == nil {
+					if !in.IsDelim(']') {
+						out.Channels = make([]string, 0, 4)
+					} else {
+						out.Channels = []string{}
+					}
+				} else {
+					out.Channels = (out.Channels)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v25 string
+					v25 = string(in.String())
+					out.Channels = append(out.Channels, v25)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "quiet_from":
+			out.QuietFrom = string(in.String())
+		case "quiet_to":
+			out.QuietTo = string(in.String())
+		case "dependents":
+			out.Dependents = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels21(out *jwriter.Writer, in NotificationSettings) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"channels\":"
+		out.RawString(prefix[1:])
+		if in.Channels == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v26, v27 := range in.Channels {
+				if v26 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v27))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"quiet_from\":"
+		out.RawString(prefix)
+		out.String(string(in.QuietFrom))
+	}
+	{
+		const prefix string = ",\"quiet_to\":"
+		out.RawString(prefix)
+		out.String(string(in.QuietTo))
+	}
+	{
+		const prefix string = ",\"dependents\":"
+		out.RawString(prefix)
+		out.String(string(in.Dependents))
+	}
+	out.RawByte('}')
+}
+func easyjson6ff3ac1dDecodeMainInternalModels22(in *jlexer.Lexer, out *ResponseNotification) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = int(in.Int())
+		case "notifications":
+			if in.IsNull() {
+				in.Skip()
+				out.Notifications = nil
+			} else {
+				in.Delim('[')
+				if out.Notifications == nil {
+					if !in.IsDelim(']') {
+						out.Notifications = make([]Notification, 0, 0)
+					} else {
+						out.Notifications = []Notification{}
+					}
+				} else {
+					out.Notifications = (out.Notifications)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v28 Notification
+					easyjson6ff3ac1dDecodeMainInternalModels23(in, &v28)
+					out.Notifications = append(out.Notifications, v28)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "next_cursor":
+			out.NextCursor = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels22(out *jwriter.Writer, in ResponseNotification) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"notifications\":"
+		out.RawString(prefix)
+		if in.Notifications == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v29, v30 := range in.Notifications {
+				if v29 > 0 {
+					out.RawByte(',')
+				}
+				easyjson6ff3ac1dEncodeMainInternalModels23(out, v30)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"next_cursor\":"
+		out.RawString(prefix)
+		out.String(string(in.NextCursor))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseNotification) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeMainInternalModels22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseNotification) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeMainInternalModels22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseNotification) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeMainInternalModels22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseNotification) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeMainInternalModels22(l, v)
+}
+func easyjson6ff3ac1dDecodeMainInternalModels23(in *jlexer.Lexer, out *Notification) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "id_to_user":
+			out.IDToUser = int64(in.Int64())
+		case "name_to":
+			out.NameTo = string(in.String())
+		case "name_medicine":
+			out.NameMedicine = string(in.String())
+		case "is_tablets":
+			out.IsTablets = bool(in.Bool())
+		case "time":
+			out.Time = string(in.String())
+		case "is_accepted":
+			out.IsAccepted = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels23(out *jwriter.Writer, in Notification) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"id_to_user\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.IDToUser))
+	}
+	{
+		const prefix string = ",\"name_to\":"
+		out.RawString(prefix)
+		out.String(string(in.NameTo))
+	}
+	{
+		const prefix string = ",\"name_medicine\":"
+		out.RawString(prefix)
+		out.String(string(in.NameMedicine))
+	}
+	{
+		const prefix string = ",\"is_tablets\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsTablets))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.String(string(in.Time))
+	}
+	{
+		const prefix string = ",\"is_accepted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsAccepted))
+	}
+	out.RawByte('}')
+}
+func easyjson6ff3ac1dDecodeMainInternalModels24(in *jlexer.Lexer, out *ResponseMembers) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = int(in.Int())
+		case "members":
+			if in.IsNull() {
+				in.Skip()
+				out.Members = nil
+			} else {
+				in.Delim('[')
+				if out.Members == nil {
+					if !in.IsDelim(']') {
+						out.Members = make([]Member, 0, 1)
+					} else {
+						out.Members = []Member{}
+					}
+				} else {
+					out.Members = (out.Members)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v31 Member
+					easyjson6ff3ac1dDecodeMainInternalModels25(in, &v31)
+					out.Members = append(out.Members, v31)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "next_cursor":
+			out.NextCursor = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels24(out *jwriter.Writer, in ResponseMembers) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"members\":"
+		out.RawString(prefix)
+		if in.Members == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v32, v33 := range in.Members {
+				if v32 > 0 {
+					out.RawByte(',')
+				}
+				easyjson6ff3ac1dEncodeMainInternalModels25(out, v33)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"next_cursor\":"
+		out.RawString(prefix)
+		out.String(string(in.NextCursor))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseMembers) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeMainInternalModels24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseMembers) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeMainInternalModels24(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseMembers) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeMainInternalModels24(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseMembers) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeMainInternalModels24(l, v)
+}
+func easyjson6ff3ac1dDecodeMainInternalModels25(in *jlexer.Lexer, out *Member) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "name":
+			out.Name = string(in.String())
+		case "avatar":
+			out.Avatar = string(in.String())
+		case "adult":
+			out.Adult = bool(in.Bool())
+		case "user":
+			out.User = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels25(out *jwriter.Writer, in Member) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"avatar\":"
+		out.RawString(prefix)
+		out.String(string(in.Avatar))
+	}
+	{
+		const prefix string = ",\"adult\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Adult))
+	}
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.User))
+	}
+	out.RawByte('}')
+}
+func easyjson6ff3ac1dDecodeMainInternalModels26(in *jlexer.Lexer, out *ResponseMedicineSearch) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = int(in.Int())
+		case "medicines":
+			if in.IsNull() {
+				in.Skip()
+				out.Medicine = nil
+			} else {
+				in.Delim('[')
+				if out.Medicine == nil {
+					if !in.IsDelim(']') {
+						out.Medicine = make([]Medicine, 0, 0)
+					} else {
+						out.Medicine = []Medicine{}
+					}
+				} else {
+					out.Medicine = (out.Medicine)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v34 Medicine
+					easyjson6ff3ac1dDecodeMainInternalModels27(in, &v34)
+					out.Medicine = append(out.Medicine, v34)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "has_more":
+			out.HasMore = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels26(out *jwriter.Writer, in ResponseMedicineSearch) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"medicines\":"
+		out.RawString(prefix)
+		if in.Medicine == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v35, v36 := range in.Medicine {
+				if v35 > 0 {
+					out.RawByte(',')
+				}
+				easyjson6ff3ac1dEncodeMainInternalModels27(out, v36)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"has_more\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasMore))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseMedicineSearch) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeMainInternalModels26(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseMedicineSearch) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeMainInternalModels26(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseMedicineSearch) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeMainInternalModels26(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseMedicineSearch) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeMainInternalModels26(l, v)
+}
+func easyjson6ff3ac1dDecodeMainInternalModels27(in *jlexer.Lexer, out *Medicine) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "name":
+			out.Name = string(in.String())
+		case "image":
+			out.Image = string(in.String())
+		case "is_tablets":
+			out.IsTablets = bool(in.Bool())
+		case "count":
+			out.Count = int64(in.Int64())
+		case "form":
+			out.Form = string(in.String())
+		case "strength":
+			out.Strength = string(in.String())
+		case "unit":
+			out.Unit = string(in.String())
+		case "ingredients":
+			if in.IsNull() {
+				in.Skip()
+				out.Ingredients = nil
+			} else {
+				in.Delim('[')
+				if out.Ingredients == nil {
+					if !in.IsDelim(']') {
+						out.Ingredients = make([]string, 0, 4)
+					} else {
+						out.Ingredients = []string{}
+					}
+				} else {
+					out.Ingredients = (out.Ingredients)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v37 string
+					v37 = string(in.String())
+					out.Ingredients = append(out.Ingredients, v37)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "min_count":
+			out.MinCount = int64(in.Int64())
+		case "days_of_supply":
+			out.DaysOfSupply = int64(in.Int64())
+		case "run_out_date":
+			out.RunOutDate = string(in.String())
+		case "expiry_date":
+			out.ExpiryDate = string(in.String())
+		case "id_kit":
+			out.IDKit = int64(in.Int64())
+		case "kit_name":
+			out.KitName = string(in.String())
+		case "dispose_reason":
+			out.DisposeReason = string(in.String())
+		case "disposed_date":
+			out.DisposedDate = string(in.String())
+		case "lot":
+			out.Lot = string(in.String())
+		case "kit_location":
+			out.KitLocation = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels27(out *jwriter.Writer, in Medicine) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"image\":"
+		out.RawString(prefix)
+		out.String(string(in.Image))
+	}
+	{
+		const prefix string = ",\"is_tablets\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsTablets))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Count))
+	}
+	{
+		const prefix string = ",\"form\":"
+		out.RawString(prefix)
+		out.String(string(in.Form))
+	}
+	{
+		const prefix string = ",\"strength\":"
+		out.RawString(prefix)
+		out.String(string(in.Strength))
+	}
+	{
+		const prefix string = ",\"unit\":"
+		out.RawString(prefix)
+		out.String(string(in.Unit))
+	}
+	{
+		const prefix string = ",\"ingredients\":"
+		out.RawString(prefix)
+		if in.Ingredients == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v38, v39 := range in.Ingredients {
+				if v38 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v39))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"min_count\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MinCount))
+	}
+	{
+		const prefix string = ",\"days_of_supply\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.DaysOfSupply))
+	}
+	{
+		const prefix string = ",\"run_out_date\":"
+		out.RawString(prefix)
+		out.String(string(in.RunOutDate))
+	}
+	{
+		const prefix string = ",\"expiry_date\":"
+		out.RawString(prefix)
+		out.String(string(in.ExpiryDate))
+	}
+	{
+		const prefix string = ",\"id_kit\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.IDKit))
+	}
+	{
+		const prefix string = ",\"kit_name\":"
+		out.RawString(prefix)
+		out.String(string(in.KitName))
+	}
+	{
+		const prefix string = ",\"dispose_reason\":"
+		out.RawString(prefix)
+		out.String(string(in.DisposeReason))
+	}
+	{
+		const prefix string = ",\"disposed_date\":"
+		out.RawString(prefix)
+		out.String(string(in.DisposedDate))
+	}
+	{
+		const prefix string = ",\"lot\":"
+		out.RawString(prefix)
+		out.String(string(in.Lot))
+	}
+	{
+		const prefix string = ",\"kit_location\":"
+		out.RawString(prefix)
+		out.String(string(in.KitLocation))
+	}
+	out.RawByte('}')
+}
+func easyjson6ff3ac1dDecodeMainInternalModels28(in *jlexer.Lexer, out *ResponseMedicine) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = int(in.Int())
+		case "medicines":
+			if in.IsNull() {
+				in.Skip()
+				out.Medicine = nil
+			} else {
+				in.Delim('[')
+				if out.Medicine == nil {
+					if !in.IsDelim(']') {
+						out.Medicine = make([]Medicine, 0, 0)
+					} else {
+						out.Medicine = []Medicine{}
+					}
+				} else {
+					out.Medicine = (out.Medicine)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v40 Medicine
+					easyjson6ff3ac1dDecodeMainInternalModels27(in, &v40)
+					out.Medicine = append(out.Medicine, v40)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "next_cursor":
+			out.NextCursor = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels28(out *jwriter.Writer, in ResponseMedicine) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"medicines\":"
+		out.RawString(prefix)
+		if in.Medicine == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v41, v42 := range in.Medicine {
+				if v41 > 0 {
+					out.RawByte(',')
+				}
+				easyjson6ff3ac1dEncodeMainInternalModels27(out, v42)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"next_cursor\":"
+		out.RawString(prefix)
+		out.String(string(in.NextCursor))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseMedicine) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeMainInternalModels28(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseMedicine) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeMainInternalModels28(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseMedicine) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeMainInternalModels28(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseMedicine) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeMainInternalModels28(l, v)
+}
+func easyjson6ff3ac1dDecodeMainInternalModels29(in *jlexer.Lexer, out *ResponseKits) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = int(in.Int())
+		case "kits":
+			if in.IsNull() {
+				in.Skip()
+				out.Kits = nil
+			} else {
+				in.Delim('[')
+				if out.Kits == nil {
+					if !in.IsDelim(']') {
+						out.Kits = make([]Kit, 0, 1)
+					} else {
+						out.Kits = []Kit{}
+					}
+				} else {
+					out.Kits = (out.Kits)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v43 Kit
+					easyjson6ff3ac1dDecodeMainInternalModels30(in, &v43)
+					out.Kits = append(out.Kits, v43)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels29(out *jwriter.Writer, in ResponseKits) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"kits\":"
+		out.RawString(prefix)
+		if in.Kits == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v44, v45 := range in.Kits {
+				if v44 > 0 {
+					out.RawByte(',')
+				}
+				easyjson6ff3ac1dEncodeMainInternalModels30(out, v45)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseKits) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeMainInternalModels29(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseKits) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeMainInternalModels29(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseKits) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeMainInternalModels29(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseKits) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeMainInternalModels29(l, v)
+}
+func easyjson6ff3ac1dDecodeMainInternalModels30(in *jlexer.Lexer, out *Kit) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "id_user":
+			out.IDUser = int64(in.Int64())
+		case "name":
+			out.Name = string(in.String())
+		case "location":
+			out.Location = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels30(out *jwriter.Writer, in Kit) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"id_user\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.IDUser))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"location\":"
+		out.RawString(prefix)
+		out.String(string(in.Location))
+	}
+	out.RawByte('}')
+}
+func easyjson6ff3ac1dDecodeMainInternalModels31(in *jlexer.Lexer, out *ResponseKitReport) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = int(in.Int())
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else {
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]KitReportItem, 0, 0)
+					} else {
+						out.Items = []KitReportItem{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v46 KitReportItem
+					easyjson6ff3ac1dDecodeMainInternalModels32(in, &v46)
+					out.Items = append(out.Items, v46)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels31(out *jwriter.Writer, in ResponseKitReport) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix)
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v47, v48 := range in.Items {
+				if v47 > 0 {
+					out.RawByte(',')
+				}
+				easyjson6ff3ac1dEncodeMainInternalModels32(out, v48)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseKitReport) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeMainInternalModels31(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseKitReport) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeMainInternalModels31(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseKitReport) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeMainInternalModels31(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseKitReport) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeMainInternalModels31(l, v)
+}
+func easyjson6ff3ac1dDecodeMainInternalModels32(in *jlexer.Lexer, out *KitReportItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "required":
+			out.Required = int64(in.Int64())
+		case "available":
+			out.Available = int64(in.Int64())
+		case "expired":
+			out.Expired = int64(in.Int64())
+		case "unit":
+			out.Unit = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels32(out *jwriter.Writer, in KitReportItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
 		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"surname\":"
-		out.RawString(prefix)
-		out.String(string(in.Surname))
-	}
-	{
-		const prefix string = ",\"email\":"
+		const prefix string = ",\"required\":"
 		out.RawString(prefix)
-		out.String(string(in.Email))
+		out.Int64(int64(in.Required))
 	}
 	{
-		const prefix string = ",\"avatar\":"
+		const prefix string = ",\"available\":"
 		out.RawString(prefix)
-		out.String(string(in.Avatar))
+		out.Int64(int64(in.Available))
 	}
 	{
-		const prefix string = ",\"date\":"
+		const prefix string = ",\"expired\":"
 		out.RawString(prefix)
-		out.String(string(in.Date))
+		out.Int64(int64(in.Expired))
 	}
 	{
-		const prefix string = ",\"main\":"
+		const prefix string = ",\"unit\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Main))
+		out.String(string(in.Unit))
 	}
 	{
-		const prefix string = ",\"adult\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Adult))
+		out.String(string(in.Status))
 	}
 	out.RawByte('}')
 }
-func easyjson6ff3ac1dDecodeMainInternalModels2(in *jlexer.Lexer, out *ResponseNotification) {
+func easyjson6ff3ac1dDecodeMainInternalModels33(in *jlexer.Lexer, out *ResponseInteractions) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -214,25 +3191,25 @@ func easyjson6ff3ac1dDecodeMainInternalModels2(in *jlexer.Lexer, out *ResponseNo
 		switch key {
 		case "status":
 			out.Status = int(in.Int())
-		case "notifications":
+		case "interactions":
 			if in.IsNull() {
 				in.Skip()
-				out.Notifications = nil
+				out.Interactions = nil
 			} else {
 				in.Delim('[')
-				if out.Notifications == nil {
+				if out.Interactions == nil {
 					if !in.IsDelim(']') {
-						out.Notifications = make([]Notification, 0, 0)
+						out.Interactions = make([]Interaction, 0, 0)
 					} else {
-						out.Notifications = []Notification{}
+						out.Interactions = []Interaction{}
 					}
 				} else {
-					out.Notifications = (out.Notifications)[:0]
+					out.Interactions = (out.Interactions)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v1 Notification
-					easyjson6ff3ac1dDecodeMainInternalModels3(in, &v1)
-					out.Notifications = append(out.Notifications, v1)
+					var v49 Interaction
+					easyjson6ff3ac1dDecodeMainInternalModels34(in, &v49)
+					out.Interactions = append(out.Interactions, v49)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -247,7 +3224,7 @@ func easyjson6ff3ac1dDecodeMainInternalModels2(in *jlexer.Lexer, out *ResponseNo
 		in.Consumed()
 	}
 }
-func easyjson6ff3ac1dEncodeMainInternalModels2(out *jwriter.Writer, in ResponseNotification) {
+func easyjson6ff3ac1dEncodeMainInternalModels33(out *jwriter.Writer, in ResponseInteractions) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -257,17 +3234,17 @@ func easyjson6ff3ac1dEncodeMainInternalModels2(out *jwriter.Writer, in ResponseN
 		out.Int(int(in.Status))
 	}
 	{
-		const prefix string = ",\"notifications\":"
+		const prefix string = ",\"interactions\":"
 		out.RawString(prefix)
-		if in.Notifications == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Interactions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v2, v3 := range in.Notifications {
-				if v2 > 0 {
+			for v50, v51 := range in.Interactions {
+				if v50 > 0 {
 					out.RawByte(',')
 				}
-				easyjson6ff3ac1dEncodeMainInternalModels3(out, v3)
+				easyjson6ff3ac1dEncodeMainInternalModels34(out, v51)
 			}
 			out.RawByte(']')
 		}
@@ -276,29 +3253,29 @@ func easyjson6ff3ac1dEncodeMainInternalModels2(out *jwriter.Writer, in ResponseN
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ResponseNotification) MarshalJSON() ([]byte, error) {
+func (v ResponseInteractions) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6ff3ac1dEncodeMainInternalModels2(&w, v)
+	easyjson6ff3ac1dEncodeMainInternalModels33(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ResponseNotification) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6ff3ac1dEncodeMainInternalModels2(w, v)
+func (v ResponseInteractions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeMainInternalModels33(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ResponseNotification) UnmarshalJSON(data []byte) error {
+func (v *ResponseInteractions) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6ff3ac1dDecodeMainInternalModels2(&r, v)
+	easyjson6ff3ac1dDecodeMainInternalModels33(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ResponseNotification) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6ff3ac1dDecodeMainInternalModels2(l, v)
+func (v *ResponseInteractions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeMainInternalModels33(l, v)
 }
-func easyjson6ff3ac1dDecodeMainInternalModels3(in *jlexer.Lexer, out *Notification) {
+func easyjson6ff3ac1dDecodeMainInternalModels34(in *jlexer.Lexer, out *Interaction) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -317,20 +3294,22 @@ func easyjson6ff3ac1dDecodeMainInternalModels3(in *jlexer.Lexer, out *Notificati
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = int64(in.Int64())
-		case "id_to_user":
-			out.IDToUser = int64(in.Int64())
-		case "name_to":
-			out.NameTo = string(in.String())
-		case "name_medicine":
-			out.NameMedicine = string(in.String())
-		case "is_tablets":
-			out.IsTablets = bool(in.Bool())
-		case "time":
-			out.Time = string(in.String())
-		case "is_accepted":
-			out.IsAccepted = bool(in.Bool())
+		case "id_medicine_a":
+			out.IDMedicineA = int64(in.Int64())
+		case "name_medicine_a":
+			out.NameMedicineA = string(in.String())
+		case "id_medicine_b":
+			out.IDMedicineB = int64(in.Int64())
+		case "name_medicine_b":
+			out.NameMedicineB = string(in.String())
+		case "ingredient_a":
+			out.IngredientA = string(in.String())
+		case "ingredient_b":
+			out.IngredientB = string(in.String())
+		case "severity":
+			out.Severity = string(in.String())
+		case "description":
+			out.Description = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -341,48 +3320,53 @@ func easyjson6ff3ac1dDecodeMainInternalModels3(in *jlexer.Lexer, out *Notificati
 		in.Consumed()
 	}
 }
-func easyjson6ff3ac1dEncodeMainInternalModels3(out *jwriter.Writer, in Notification) {
+func easyjson6ff3ac1dEncodeMainInternalModels34(out *jwriter.Writer, in Interaction) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"id_medicine_a\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.ID))
+		out.Int64(int64(in.IDMedicineA))
 	}
 	{
-		const prefix string = ",\"id_to_user\":"
+		const prefix string = ",\"name_medicine_a\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.IDToUser))
+		out.String(string(in.NameMedicineA))
 	}
 	{
-		const prefix string = ",\"name_to\":"
+		const prefix string = ",\"id_medicine_b\":"
 		out.RawString(prefix)
-		out.String(string(in.NameTo))
+		out.Int64(int64(in.IDMedicineB))
 	}
 	{
-		const prefix string = ",\"name_medicine\":"
+		const prefix string = ",\"name_medicine_b\":"
 		out.RawString(prefix)
-		out.String(string(in.NameMedicine))
+		out.String(string(in.NameMedicineB))
 	}
 	{
-		const prefix string = ",\"is_tablets\":"
+		const prefix string = ",\"ingredient_a\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.IsTablets))
+		out.String(string(in.IngredientA))
 	}
 	{
-		const prefix string = ",\"time\":"
+		const prefix string = ",\"ingredient_b\":"
 		out.RawString(prefix)
-		out.String(string(in.Time))
+		out.String(string(in.IngredientB))
 	}
 	{
-		const prefix string = ",\"is_accepted\":"
+		const prefix string = ",\"severity\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.IsAccepted))
+		out.String(string(in.Severity))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
 	}
 	out.RawByte('}')
 }
-func easyjson6ff3ac1dDecodeMainInternalModels4(in *jlexer.Lexer, out *ResponseMembers) {
+func easyjson6ff3ac1dDecodeMainInternalModels35(in *jlexer.Lexer, out *ResponseImport) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -403,25 +3387,25 @@ func easyjson6ff3ac1dDecodeMainInternalModels4(in *jlexer.Lexer, out *ResponseMe
 		switch key {
 		case "status":
 			out.Status = int(in.Int())
-		case "members":
+		case "results":
 			if in.IsNull() {
 				in.Skip()
-				out.Members = nil
+				out.Results = nil
 			} else {
 				in.Delim('[')
-				if out.Members == nil {
+				if out.Results == nil {
 					if !in.IsDelim(']') {
-						out.Members = make([]Member, 0, 1)
+						out.Results = make([]ImportResult, 0, 1)
 					} else {
-						out.Members = []Member{}
+						out.Results = []ImportResult{}
 					}
 				} else {
-					out.Members = (out.Members)[:0]
+					out.Results = (out.Results)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v4 Member
-					easyjson6ff3ac1dDecodeMainInternalModels5(in, &v4)
-					out.Members = append(out.Members, v4)
+					var v52 ImportResult
+					easyjson6ff3ac1dDecodeMainInternalModels36(in, &v52)
+					out.Results = append(out.Results, v52)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -436,7 +3420,7 @@ func easyjson6ff3ac1dDecodeMainInternalModels4(in *jlexer.Lexer, out *ResponseMe
 		in.Consumed()
 	}
 }
-func easyjson6ff3ac1dEncodeMainInternalModels4(out *jwriter.Writer, in ResponseMembers) {
+func easyjson6ff3ac1dEncodeMainInternalModels35(out *jwriter.Writer, in ResponseImport) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -446,17 +3430,17 @@ func easyjson6ff3ac1dEncodeMainInternalModels4(out *jwriter.Writer, in ResponseM
 		out.Int(int(in.Status))
 	}
 	{
-		const prefix string = ",\"members\":"
+		const prefix string = ",\"results\":"
 		out.RawString(prefix)
-		if in.Members == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v5, v6 := range in.Members {
-				if v5 > 0 {
+			for v53, v54 := range in.Results {
+				if v53 > 0 {
 					out.RawByte(',')
 				}
-				easyjson6ff3ac1dEncodeMainInternalModels5(out, v6)
+				easyjson6ff3ac1dEncodeMainInternalModels36(out, v54)
 			}
 			out.RawByte(']')
 		}
@@ -465,29 +3449,29 @@ func easyjson6ff3ac1dEncodeMainInternalModels4(out *jwriter.Writer, in ResponseM
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ResponseMembers) MarshalJSON() ([]byte, error) {
+func (v ResponseImport) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6ff3ac1dEncodeMainInternalModels4(&w, v)
+	easyjson6ff3ac1dEncodeMainInternalModels35(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ResponseMembers) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6ff3ac1dEncodeMainInternalModels4(w, v)
+func (v ResponseImport) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeMainInternalModels35(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ResponseMembers) UnmarshalJSON(data []byte) error {
+func (v *ResponseImport) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6ff3ac1dDecodeMainInternalModels4(&r, v)
+	easyjson6ff3ac1dDecodeMainInternalModels35(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ResponseMembers) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6ff3ac1dDecodeMainInternalModels4(l, v)
+func (v *ResponseImport) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeMainInternalModels35(l, v)
 }
-func easyjson6ff3ac1dDecodeMainInternalModels5(in *jlexer.Lexer, out *Member) {
+func easyjson6ff3ac1dDecodeMainInternalModels36(in *jlexer.Lexer, out *ImportResult) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -506,16 +3490,14 @@ func easyjson6ff3ac1dDecodeMainInternalModels5(in *jlexer.Lexer, out *Member) {
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = int64(in.Int64())
-		case "name":
-			out.Name = string(in.String())
-		case "avatar":
-			out.Avatar = string(in.String())
-		case "adult":
-			out.Adult = bool(in.Bool())
-		case "user":
-			out.User = bool(in.Bool())
+		case "row":
+			out.Row = int64(in.Int64())
+		case "id_medicine":
+			out.IDMedicine = int64(in.Int64())
+		case "created":
+			out.Created = bool(in.Bool())
+		case "error":
+			out.Error = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -526,38 +3508,33 @@ func easyjson6ff3ac1dDecodeMainInternalModels5(in *jlexer.Lexer, out *Member) {
 		in.Consumed()
 	}
 }
-func easyjson6ff3ac1dEncodeMainInternalModels5(out *jwriter.Writer, in Member) {
+func easyjson6ff3ac1dEncodeMainInternalModels36(out *jwriter.Writer, in ImportResult) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"row\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.ID))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
+		out.Int64(int64(in.Row))
 	}
 	{
-		const prefix string = ",\"avatar\":"
+		const prefix string = ",\"id_medicine\":"
 		out.RawString(prefix)
-		out.String(string(in.Avatar))
+		out.Int64(int64(in.IDMedicine))
 	}
 	{
-		const prefix string = ",\"adult\":"
+		const prefix string = ",\"created\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Adult))
+		out.Bool(bool(in.Created))
 	}
 	{
-		const prefix string = ",\"user\":"
+		const prefix string = ",\"error\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.User))
+		out.String(string(in.Error))
 	}
 	out.RawByte('}')
 }
-func easyjson6ff3ac1dDecodeMainInternalModels6(in *jlexer.Lexer, out *ResponseMedicine) {
+func easyjson6ff3ac1dDecodeMainInternalModels37(in *jlexer.Lexer, out *ResponseHealth) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -578,28 +3555,15 @@ func easyjson6ff3ac1dDecodeMainInternalModels6(in *jlexer.Lexer, out *ResponseMe
 		switch key {
 		case "status":
 			out.Status = int(in.Int())
-		case "medicines":
+		case "health":
 			if in.IsNull() {
 				in.Skip()
-				out.Medicine = nil
+				out.Health = nil
 			} else {
-				in.Delim('[')
-				if out.Medicine == nil {
-					if !in.IsDelim(']') {
-						out.Medicine = make([]Medicine, 0, 1)
-					} else {
-						out.Medicine = []Medicine{}
-					}
-				} else {
-					out.Medicine = (out.Medicine)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v7 Medicine
-					easyjson6ff3ac1dDecodeMainInternalModels7(in, &v7)
-					out.Medicine = append(out.Medicine, v7)
-					in.WantComma()
+				if out.Health == nil {
+					out.Health = new(Health)
 				}
-				in.Delim(']')
+				easyjson6ff3ac1dDecodeMainInternalModels38(in, out.Health)
 			}
 		default:
 			in.SkipRecursive()
@@ -611,7 +3575,7 @@ func easyjson6ff3ac1dDecodeMainInternalModels6(in *jlexer.Lexer, out *ResponseMe
 		in.Consumed()
 	}
 }
-func easyjson6ff3ac1dEncodeMainInternalModels6(out *jwriter.Writer, in ResponseMedicine) {
+func easyjson6ff3ac1dEncodeMainInternalModels37(out *jwriter.Writer, in ResponseHealth) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -621,48 +3585,41 @@ func easyjson6ff3ac1dEncodeMainInternalModels6(out *jwriter.Writer, in ResponseM
 		out.Int(int(in.Status))
 	}
 	{
-		const prefix string = ",\"medicines\":"
+		const prefix string = ",\"health\":"
 		out.RawString(prefix)
-		if in.Medicine == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Health == nil {
 			out.RawString("null")
 		} else {
-			out.RawByte('[')
-			for v8, v9 := range in.Medicine {
-				if v8 > 0 {
-					out.RawByte(',')
-				}
-				easyjson6ff3ac1dEncodeMainInternalModels7(out, v9)
-			}
-			out.RawByte(']')
+			easyjson6ff3ac1dEncodeMainInternalModels38(out, *in.Health)
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ResponseMedicine) MarshalJSON() ([]byte, error) {
+func (v ResponseHealth) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6ff3ac1dEncodeMainInternalModels6(&w, v)
+	easyjson6ff3ac1dEncodeMainInternalModels37(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ResponseMedicine) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6ff3ac1dEncodeMainInternalModels6(w, v)
+func (v ResponseHealth) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeMainInternalModels37(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ResponseMedicine) UnmarshalJSON(data []byte) error {
+func (v *ResponseHealth) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6ff3ac1dDecodeMainInternalModels6(&r, v)
+	easyjson6ff3ac1dDecodeMainInternalModels37(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ResponseMedicine) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6ff3ac1dDecodeMainInternalModels6(l, v)
+func (v *ResponseHealth) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeMainInternalModels37(l, v)
 }
-func easyjson6ff3ac1dDecodeMainInternalModels7(in *jlexer.Lexer, out *Medicine) {
+func easyjson6ff3ac1dDecodeMainInternalModels38(in *jlexer.Lexer, out *Health) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -681,16 +3638,62 @@ func easyjson6ff3ac1dDecodeMainInternalModels7(in *jlexer.Lexer, out *Medicine)
 			continue
 		}
 		switch key {
+		case "is_user":
+			out.IsUser = bool(in.Bool())
 		case "id":
 			out.ID = int64(in.Int64())
-		case "name":
-			out.Name = string(in.String())
-		case "image":
-			out.Image = string(in.String())
-		case "is_tablets":
-			out.IsTablets = bool(in.Bool())
-		case "count":
-			out.Count = int64(in.Int64())
+		case "birth_date":
+			out.BirthDate = string(in.String())
+		case "weight":
+			out.Weight = float64(in.Float64())
+		case "allergies":
+			if in.IsNull() {
+				in.Skip()
+				out.Allergies = nil
+			} else {
+				in.Delim('[')
+				if out.Allergies == nil {
+					if !in.IsDelim(']') {
+						out.Allergies = make([]string, 0, 4)
+					} else {
+						out.Allergies = []string{}
+					}
+				} else {
+					out.Allergies = (out.Allergies)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v55 string
+					v55 = string(in.String())
+					out.Allergies = append(out.Allergies, v55)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "chronic_conditions":
+			if in.IsNull() {
+				in.Skip()
+				out.ChronicConditions = nil
+			} else {
+				in.Delim('[')
+				if out.ChronicConditions == nil {
+					if !in.IsDelim(']') {
+						out.ChronicConditions = make([]string, 0, 4)
+					} else {
+						out.ChronicConditions = []string{}
+					}
+				} else {
+					out.ChronicConditions = (out.ChronicConditions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v56 string
+					v56 = string(in.String())
+					out.ChronicConditions = append(out.ChronicConditions, v56)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "notes":
+			out.Notes = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -701,38 +3704,150 @@ func easyjson6ff3ac1dDecodeMainInternalModels7(in *jlexer.Lexer, out *Medicine)
 		in.Consumed()
 	}
 }
-func easyjson6ff3ac1dEncodeMainInternalModels7(out *jwriter.Writer, in Medicine) {
+func easyjson6ff3ac1dEncodeMainInternalModels38(out *jwriter.Writer, in Health) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"is_user\":"
 		out.RawString(prefix[1:])
+		out.Bool(bool(in.IsUser))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
 		out.Int64(int64(in.ID))
 	}
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"birth_date\":"
 		out.RawString(prefix)
-		out.String(string(in.Name))
+		out.String(string(in.BirthDate))
 	}
 	{
-		const prefix string = ",\"image\":"
+		const prefix string = ",\"weight\":"
 		out.RawString(prefix)
-		out.String(string(in.Image))
+		out.Float64(float64(in.Weight))
 	}
 	{
-		const prefix string = ",\"is_tablets\":"
+		const prefix string = ",\"allergies\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.IsTablets))
+		if in.Allergies == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v57, v58 := range in.Allergies {
+				if v57 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v58))
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"count\":"
+		const prefix string = ",\"chronic_conditions\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Count))
+		if in.ChronicConditions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v59, v60 := range in.ChronicConditions {
+				if v59 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v60))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"notes\":"
+		out.RawString(prefix)
+		out.String(string(in.Notes))
+	}
+	out.RawByte('}')
+}
+func easyjson6ff3ac1dDecodeMainInternalModels39(in *jlexer.Lexer, out *ResponseCalendar) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = int(in.Int())
+		case "token":
+			out.Token = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeMainInternalModels39(out *jwriter.Writer, in ResponseCalendar) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix)
+		out.String(string(in.Token))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
 	}
 	out.RawByte('}')
 }
-func easyjson6ff3ac1dDecodeMainInternalModels8(in *jlexer.Lexer, out *Response) {
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseCalendar) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeMainInternalModels39(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseCalendar) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeMainInternalModels39(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseCalendar) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeMainInternalModels39(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseCalendar) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeMainInternalModels39(l, v)
+}
+func easyjson6ff3ac1dDecodeMainInternalModels40(in *jlexer.Lexer, out *Response) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -765,7 +3880,7 @@ func easyjson6ff3ac1dDecodeMainInternalModels8(in *jlexer.Lexer, out *Response)
 		in.Consumed()
 	}
 }
-func easyjson6ff3ac1dEncodeMainInternalModels8(out *jwriter.Writer, in Response) {
+func easyjson6ff3ac1dEncodeMainInternalModels40(out *jwriter.Writer, in Response) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -785,23 +3900,23 @@ func easyjson6ff3ac1dEncodeMainInternalModels8(out *jwriter.Writer, in Response)
 // MarshalJSON supports json.Marshaler interface
 func (v Response) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6ff3ac1dEncodeMainInternalModels8(&w, v)
+	easyjson6ff3ac1dEncodeMainInternalModels40(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Response) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6ff3ac1dEncodeMainInternalModels8(w, v)
+	easyjson6ff3ac1dEncodeMainInternalModels40(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Response) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6ff3ac1dDecodeMainInternalModels8(&r, v)
+	easyjson6ff3ac1dDecodeMainInternalModels40(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Response) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6ff3ac1dDecodeMainInternalModels8(l, v)
+	easyjson6ff3ac1dDecodeMainInternalModels40(l, v)
 }
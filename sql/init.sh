@@ -8,6 +8,8 @@ psql -v ON_ERROR_STOP=1 --username "$POSTGRES_USER" --dbname "$POSTGRES_DB" <<-E
   ALTER DATABASE $APP_DB_NAME OWNER TO $APP_DB_USER;
   \connect $APP_DB_NAME $APP_DB_USER
 
+  CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
   BEGIN;
       create table if not exists users
       (
@@ -21,13 +23,97 @@ psql -v ON_ERROR_STOP=1 --username "$POSTGRES_USER" --dbname "$POSTGRES_DB" <<-E
           birthday timestamp,
           is_adult bool,
           email_confirmed bool,
-          id_family int
+          id_family int,
+          time_zone varchar(64)  not null default 'UTC'
       );
 
       create unique index users_email_uindex
             on users (email);
   COMMIT;
 
+  BEGIN;
+      create table if not exists notification_settings
+      (
+          id_user int constraint notification_settings_pk primary key REFERENCES users ON DELETE CASCADE,
+          channels varchar(100)  not null default 'email',
+          quiet_from varchar(5),
+          quiet_to varchar(5),
+          dependents varchar(10)  not null default 'copies'
+      );
+  COMMIT;
+
+  BEGIN;
+      create table if not exists webhooks
+      (
+          id serial constraint webhooks_pk primary key,
+          id_user int REFERENCES users ON DELETE CASCADE,
+          url varchar(500)  not null,
+          secret varchar(64)  not null,
+          created timestamptz not null default now()
+      );
+
+      create index webhooks_user_index
+            on webhooks (id_user);
+  COMMIT;
+
+  BEGIN;
+      create table if not exists telegram_chats
+      (
+          id_user int constraint telegram_chats_pk primary key REFERENCES users ON DELETE CASCADE,
+          chat_id bigint  not null
+      );
+
+      create unique index telegram_chats_chat_uindex
+            on telegram_chats (chat_id);
+
+      create table if not exists telegram_codes
+      (
+          id_user int constraint telegram_codes_pk primary key REFERENCES users ON DELETE CASCADE,
+          code varchar(16)  not null,
+          expires timestamptz not null
+      );
+
+      create unique index telegram_codes_code_uindex
+            on telegram_codes (code);
+  COMMIT;
+
+  BEGIN;
+      create table if not exists push_subscriptions
+      (
+          id serial constraint push_subscriptions_pk primary key,
+          id_user int REFERENCES users ON DELETE CASCADE,
+          endpoint varchar(1000)  not null,
+          p256dh varchar(100)  not null,
+          auth varchar(50)  not null,
+          session varchar(64)  not null,
+          expires timestamptz not null,
+          created timestamptz not null default now()
+      );
+
+      create unique index push_subscriptions_endpoint_uindex
+            on push_subscriptions (endpoint);
+
+      create index push_subscriptions_session_index
+            on push_subscriptions (session);
+  COMMIT;
+
+  BEGIN;
+      create table if not exists notification_outbox
+      (
+          id serial constraint notification_outbox_pk primary key,
+          id_user int REFERENCES users ON DELETE CASCADE,
+          channel varchar(20)  not null,
+          id_webhook int REFERENCES webhooks ON DELETE CASCADE,
+          id_subscription int REFERENCES push_subscriptions ON DELETE CASCADE,
+          message jsonb not null,
+          attempts int not null default 0,
+          next_attempt timestamptz not null default now()
+      );
+
+      create index notification_outbox_next_index
+            on notification_outbox (next_attempt);
+  COMMIT;
+
   BEGIN;
       create table if not exists family
       (
@@ -43,7 +129,86 @@ psql -v ON_ERROR_STOP=1 --username "$POSTGRES_USER" --dbname "$POSTGRES_DB" <<-E
           id_main_user int REFERENCES users,
           id_family int REFERENCES family ON DELETE CASCADE,
           name varchar(50)  not null,
-          avatar varchar(100)
+          avatar varchar(100),
+          birthday timestamp
+      );
+  COMMIT;
+
+  BEGIN;
+      create table if not exists member_invites
+      (
+          token varchar(64) constraint member_invites_pk primary key,
+          id_family int REFERENCES family ON DELETE CASCADE,
+          id_member int REFERENCES members ON DELETE CASCADE,
+          email varchar(50)  not null,
+          is_adult bool not null,
+          expires timestamptz not null
+      );
+  COMMIT;
+
+  BEGIN;
+      create table if not exists health
+      (
+          id serial constraint health_pk primary key,
+          is_user bool not null,
+          id_person int not null,
+          weight real,
+          notes text,
+          constraint health_person_uindex unique (is_user, id_person)
+      );
+  COMMIT;
+
+  BEGIN;
+      create table if not exists allergies
+      (
+          id serial constraint allergies_pk primary key,
+          is_user bool not null,
+          id_person int not null,
+          name varchar(100)  not null
+      );
+  COMMIT;
+
+  BEGIN;
+      create table if not exists chronic_conditions
+      (
+          id serial constraint chronic_conditions_pk primary key,
+          is_user bool not null,
+          id_person int not null,
+          name varchar(100)  not null
+      );
+  COMMIT;
+
+  BEGIN;
+      create table if not exists kits
+      (
+          id serial constraint kits_pk primary key,
+          id_user int REFERENCES users ON DELETE CASCADE,
+          name varchar(50)  not null,
+          location varchar(100)
+      );
+  COMMIT;
+
+  BEGIN;
+      create table if not exists kit_templates
+      (
+          id serial constraint kit_templates_pk primary key,
+          id_user int REFERENCES users ON DELETE CASCADE,
+          name varchar(100)  not null
+      );
+
+      create unique index kit_templates_builtin_uindex
+            on kit_templates (name) where id_user is null;
+  COMMIT;
+
+  BEGIN;
+      create table if not exists kit_template_items
+      (
+          id serial constraint kit_template_items_pk primary key,
+          id_template int REFERENCES kit_templates ON DELETE CASCADE,
+          name varchar(200)  not null,
+          keyword varchar(100)  not null,
+          count int not null,
+          unit varchar(10)
       );
   COMMIT;
 
@@ -52,11 +217,77 @@ psql -v ON_ERROR_STOP=1 --username "$POSTGRES_USER" --dbname "$POSTGRES_DB" <<-E
       (
           id serial constraint medicine_pk primary key,
           id_user int REFERENCES users,
+          id_kit int REFERENCES kits ON DELETE SET NULL,
           name varchar(100)  not null,
+          lot varchar(50),
           count int,
           image varchar(100),
-          is_tablets bool
+          is_tablets bool,
+          form varchar(20),
+          strength varchar(50),
+          unit varchar(10),
+          min_count int default 0,
+          stock_alerted bool default false,
+          expiry_listed bool not null default false,
+          expires date,
+          disposed date,
+          dispose_reason varchar(20)
+      );
+
+      create index medicine_name_search_index
+            on medicine using gin (to_tsvector('russian', name));
+
+      create index medicine_name_trgm_index
+            on medicine using gin (name gin_trgm_ops);
+  COMMIT;
+
+  BEGIN;
+      create table if not exists shopping_list
+      (
+          id serial constraint shopping_list_pk primary key,
+          id_user int REFERENCES users ON DELETE CASCADE,
+          id_medicine int REFERENCES medicine ON DELETE SET NULL,
+          name varchar(100)  not null,
+          count int not null default 1,
+          unit varchar(10),
+          reason varchar(20)  not null,
+          is_bought bool not null default false,
+          id_bought_by int REFERENCES users ON DELETE SET NULL,
+          created timestamptz not null default now()
+      );
+  COMMIT;
+
+  BEGIN;
+      create table if not exists medicine_ingredients
+      (
+          id serial constraint medicine_ingredients_pk primary key,
+          id_medicine int REFERENCES medicine ON DELETE CASCADE,
+          name varchar(100)  not null
       );
+
+      create index medicine_ingredients_id_medicine_index
+            on medicine_ingredients (id_medicine);
+
+      create index medicine_ingredients_name_search_index
+            on medicine_ingredients using gin (to_tsvector('russian', name));
+
+      create index medicine_ingredients_name_trgm_index
+            on medicine_ingredients using gin (name gin_trgm_ops);
+  COMMIT;
+
+  BEGIN;
+      create table if not exists medicine_ledger
+      (
+          id serial constraint medicine_ledger_pk primary key,
+          id_medicine int REFERENCES medicine ON DELETE CASCADE,
+          id_user int REFERENCES users ON DELETE SET NULL,
+          delta int not null,
+          reason varchar(20)  not null,
+          created timestamptz not null default now()
+      );
+
+      create index medicine_ledger_medicine_index
+            on medicine_ledger (id_medicine, created);
   COMMIT;
 
   BEGIN;
@@ -69,8 +300,103 @@ psql -v ON_ERROR_STOP=1 --username "$POSTGRES_USER" --dbname "$POSTGRES_DB" <<-E
           name_to varchar(100),
           id_medicine int REFERENCES medicine ON DELETE CASCADE,
           name_medicine varchar(100),
-          time varchar(100),
-          is_accepted bool
+          time timestamptz,
+          is_accepted bool,
+          count int
+      );
+  COMMIT;
+
+  BEGIN;
+      create table if not exists dose_log
+      (
+          id serial constraint dose_log_pk primary key,
+          to_is_user bool not null,
+          id_person int not null,
+          id_medicine int REFERENCES medicine ON DELETE SET NULL,
+          name_medicine varchar(100),
+          time timestamptz not null,
+          is_taken bool not null,
+          count int
+      );
+
+      create index dose_log_person_index
+            on dose_log (to_is_user, id_person, time);
+  COMMIT;
+
+  BEGIN;
+      create table if not exists dose_changes
+      (
+          id serial constraint dose_changes_pk primary key,
+          id_notification int not null,
+          to_is_user bool not null,
+          id_person int not null,
+          id_medicine int REFERENCES medicine ON DELETE SET NULL,
+          name_medicine varchar(100),
+          action varchar(20)  not null,
+          time_from timestamptz not null,
+          time_to timestamptz not null,
+          id_user int REFERENCES users ON DELETE SET NULL,
+          created timestamptz not null default now()
+      );
+
+      create index dose_changes_person_index
+            on dose_changes (to_is_user, id_person, created);
+  COMMIT;
+
+  BEGIN;
+      create table if not exists prn_rules
+      (
+          id serial constraint prn_rules_pk primary key,
+          to_is_user bool not null,
+          id_person int not null,
+          id_medicine int REFERENCES medicine ON DELETE CASCADE,
+          min_interval int not null default 0,
+          max_daily int not null default 0,
+          constraint prn_rules_medicine_uindex unique (to_is_user, id_person, id_medicine)
+      );
+
+      create table if not exists prn_intakes
+      (
+          id serial constraint prn_intakes_pk primary key,
+          to_is_user bool not null,
+          id_person int not null,
+          id_medicine int REFERENCES medicine ON DELETE SET NULL,
+          name_medicine varchar(100),
+          count int not null,
+          time timestamptz not null default now(),
+          id_user int REFERENCES users ON DELETE SET NULL,
+          overridden bool not null default false
+      );
+
+      create index prn_intakes_person_index
+            on prn_intakes (to_is_user, id_person, id_medicine, time);
+  COMMIT;
+
+  BEGIN;
+      create table if not exists calendar_tokens
+      (
+          id serial constraint calendar_tokens_pk primary key,
+          id_user int REFERENCES users ON DELETE CASCADE,
+          is_user bool not null,
+          id_person int not null,
+          token varchar(64) not null,
+          created timestamptz not null default now(),
+          constraint calendar_tokens_person_uindex unique (id_user, is_user, id_person)
+      );
+
+      create unique index calendar_tokens_token_uindex
+            on calendar_tokens (token);
+  COMMIT;
+
+  BEGIN;
+      create table if not exists interactions
+      (
+          id serial constraint interactions_pk primary key,
+          ingredient_a varchar(100)  not null,
+          ingredient_b varchar(100)  not null,
+          severity varchar(20)  not null,
+          description text,
+          constraint interactions_pair_uindex unique (ingredient_a, ingredient_b)
       );
   COMMIT;
 
@@ -0,0 +1,332 @@
+#!/bin/bash
+# Переводит базу, созданную исходной или любой более поздней версией init.sh, на текущую схему.
+# init.sh выполняется только на пустом томе, поэтому для существующей базы запустите:
+#   docker compose exec postgres bash /migrate.sh
+# Шаги идут в порядке появления изменений схемы, каждый можно выполнять повторно.
+set -e
+export PGPASSWORD=$APP_DB_PASS;
+psql -v ON_ERROR_STOP=1 --username "$APP_DB_USER" --dbname "$APP_DB_NAME" <<-EOSQL
+  CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+  BEGIN;
+      -- приглашения на место члена семьи без аккаунта
+      create table if not exists member_invites
+      (
+          token varchar(64) constraint member_invites_pk primary key,
+          id_family int REFERENCES family ON DELETE CASCADE,
+          id_member int REFERENCES members ON DELETE CASCADE,
+          email varchar(50)  not null,
+          is_adult bool not null,
+          expires timestamptz not null
+      );
+
+      -- профиль здоровья
+      ALTER TABLE members ADD COLUMN IF NOT EXISTS birthday timestamp;
+
+      create table if not exists health
+      (
+          id serial constraint health_pk primary key,
+          is_user bool not null,
+          id_person int not null,
+          weight real,
+          notes text,
+          constraint health_person_uindex unique (is_user, id_person)
+      );
+
+      create table if not exists allergies
+      (
+          id serial constraint allergies_pk primary key,
+          is_user bool not null,
+          id_person int not null,
+          name varchar(100)  not null
+      );
+
+      create table if not exists chronic_conditions
+      (
+          id serial constraint chronic_conditions_pk primary key,
+          is_user bool not null,
+          id_person int not null,
+          name varchar(100)  not null
+      );
+
+      -- таблица взаимодействий
+      create table if not exists interactions
+      (
+          id serial constraint interactions_pk primary key,
+          ingredient_a varchar(100)  not null,
+          ingredient_b varchar(100)  not null,
+          severity varchar(20)  not null,
+          description text,
+          constraint interactions_pair_uindex unique (ingredient_a, ingredient_b)
+      );
+
+      -- лекарственная форма, дозировка, единица учёта и действующие вещества
+      ALTER TABLE medicine ADD COLUMN IF NOT EXISTS form varchar(20),
+          ADD COLUMN IF NOT EXISTS strength varchar(50),
+          ADD COLUMN IF NOT EXISTS unit varchar(10);
+
+      create table if not exists medicine_ingredients
+      (
+          id serial constraint medicine_ingredients_pk primary key,
+          id_medicine int REFERENCES medicine ON DELETE CASCADE,
+          name varchar(100)  not null
+      );
+
+      -- журнал остатков
+      create table if not exists medicine_ledger
+      (
+          id serial constraint medicine_ledger_pk primary key,
+          id_medicine int REFERENCES medicine ON DELETE CASCADE,
+          id_user int REFERENCES users ON DELETE SET NULL,
+          delta int not null,
+          reason varchar(20)  not null,
+          created timestamptz not null default now()
+      );
+
+      CREATE INDEX IF NOT EXISTS medicine_ledger_medicine_index ON medicine_ledger (id_medicine, created);
+
+      -- порог остатка
+      ALTER TABLE medicine ADD COLUMN IF NOT EXISTS min_count int default 0,
+          ADD COLUMN IF NOT EXISTS stock_alerted bool default false;
+
+      -- список покупок; просроченное лекарство добавляется в него один раз
+      ALTER TABLE medicine ADD COLUMN IF NOT EXISTS expires date,
+          ADD COLUMN IF NOT EXISTS expiry_listed bool not null default false;
+
+      create table if not exists shopping_list
+      (
+          id serial constraint shopping_list_pk primary key,
+          id_user int REFERENCES users ON DELETE CASCADE,
+          id_medicine int REFERENCES medicine ON DELETE SET NULL,
+          name varchar(100)  not null,
+          count int not null default 1,
+          unit varchar(10),
+          reason varchar(20)  not null,
+          is_bought bool not null default false,
+          id_bought_by int REFERENCES users ON DELETE SET NULL,
+          created timestamptz not null default now()
+      );
+
+      UPDATE medicine SET expiry_listed = true WHERE expires < now()::date AND NOT expiry_listed
+          AND EXISTS (SELECT 1 FROM shopping_list WHERE shopping_list.id_medicine = medicine.id AND shopping_list.reason = 'expired');
+
+      -- аптечки
+      create table if not exists kits
+      (
+          id serial constraint kits_pk primary key,
+          id_user int REFERENCES users ON DELETE CASCADE,
+          name varchar(50)  not null,
+          location varchar(100)
+      );
+
+      ALTER TABLE medicine ADD COLUMN IF NOT EXISTS id_kit int REFERENCES kits ON DELETE SET NULL;
+
+      -- шаблоны аптечек; встроенные шаблоны обновляются по названию, дубли от прежних запусков удаляются
+      create table if not exists kit_templates
+      (
+          id serial constraint kit_templates_pk primary key,
+          id_user int REFERENCES users ON DELETE CASCADE,
+          name varchar(100)  not null
+      );
+
+      create table if not exists kit_template_items
+      (
+          id serial constraint kit_template_items_pk primary key,
+          id_template int REFERENCES kit_templates ON DELETE CASCADE,
+          name varchar(200)  not null,
+          keyword varchar(100)  not null,
+          count int not null,
+          unit varchar(10)
+      );
+
+      DELETE FROM kit_templates duplicate USING kit_templates original
+          WHERE duplicate.id_user IS NULL AND original.id_user IS NULL
+          AND duplicate.name = original.name AND duplicate.id > original.id;
+      CREATE UNIQUE INDEX IF NOT EXISTS kit_templates_builtin_uindex ON kit_templates (name) WHERE id_user IS NULL;
+
+      -- выбытие лекарств
+      ALTER TABLE medicine ADD COLUMN IF NOT EXISTS disposed date,
+          ADD COLUMN IF NOT EXISTS dispose_reason varchar(20);
+
+      -- поиск по названию и действующим веществам, в том числе с опечатками
+      CREATE INDEX IF NOT EXISTS medicine_name_search_index ON medicine USING gin (to_tsvector('russian', name));
+      CREATE INDEX IF NOT EXISTS medicine_name_trgm_index ON medicine USING gin (name gin_trgm_ops);
+      CREATE INDEX IF NOT EXISTS medicine_ingredients_id_medicine_index ON medicine_ingredients (id_medicine);
+      CREATE INDEX IF NOT EXISTS medicine_ingredients_name_search_index ON medicine_ingredients USING gin (to_tsvector('russian', name));
+      CREATE INDEX IF NOT EXISTS medicine_ingredients_name_trgm_index ON medicine_ingredients USING gin (name gin_trgm_ops);
+
+      -- серия лекарства для импорта
+      ALTER TABLE medicine ADD COLUMN IF NOT EXISTS lot varchar(50);
+
+      -- подписка на календарь
+      create table if not exists calendar_tokens
+      (
+          id serial constraint calendar_tokens_pk primary key,
+          id_user int REFERENCES users ON DELETE CASCADE,
+          is_user bool not null,
+          id_person int not null,
+          token varchar(64) not null,
+          created timestamptz not null default now(),
+          constraint calendar_tokens_person_uindex unique (id_user, is_user, id_person)
+      );
+
+      CREATE UNIQUE INDEX IF NOT EXISTS calendar_tokens_token_uindex ON calendar_tokens (token);
+
+      -- история приёмов сохраняется после удаления лекарства; count — сколько списано за отмеченный приём
+      create table if not exists dose_log
+      (
+          id serial constraint dose_log_pk primary key,
+          to_is_user bool not null,
+          id_person int not null,
+          id_medicine int REFERENCES medicine ON DELETE SET NULL,
+          name_medicine varchar(100),
+          time timestamptz not null,
+          is_taken bool not null,
+          count int
+      );
+
+      ALTER TABLE dose_log DROP CONSTRAINT IF EXISTS dose_log_id_medicine_fkey,
+          ADD CONSTRAINT dose_log_id_medicine_fkey FOREIGN KEY (id_medicine) REFERENCES medicine ON DELETE SET NULL;
+      ALTER TABLE dose_log ADD COLUMN IF NOT EXISTS count int;
+      ALTER TABLE notification_user ADD COLUMN IF NOT EXISTS count int;
+      CREATE INDEX IF NOT EXISTS dose_log_person_index ON dose_log (to_is_user, id_person, time);
+
+      -- часовые пояса; время напоминаний хранилось строкой "YYYY-MM-DD HH24:MI" по UTC
+      ALTER TABLE users ADD COLUMN IF NOT EXISTS time_zone varchar(64)  not null default 'UTC';
+
+      DO \$\$
+      BEGIN
+          IF EXISTS (SELECT 1 FROM information_schema.columns
+                     WHERE table_name = 'notification_user' AND column_name = 'time' AND data_type = 'character varying') THEN
+              ALTER TABLE notification_user ALTER COLUMN time TYPE timestamptz
+                  USING (NULLIF(time, '')::timestamp AT TIME ZONE 'UTC');
+          END IF;
+      END
+      \$\$;
+
+      -- настройки уведомлений
+      create table if not exists notification_settings
+      (
+          id_user int constraint notification_settings_pk primary key REFERENCES users ON DELETE CASCADE,
+          channels varchar(100)  not null default 'email',
+          quiet_from varchar(5),
+          quiet_to varchar(5),
+          dependents varchar(10)  not null default 'copies'
+      );
+
+      -- вебхуки и очередь доставки; ссылка на подписку браузера добавляется вместе с push_subscriptions
+      create table if not exists webhooks
+      (
+          id serial constraint webhooks_pk primary key,
+          id_user int REFERENCES users ON DELETE CASCADE,
+          url varchar(500)  not null,
+          secret varchar(64)  not null,
+          created timestamptz not null default now()
+      );
+
+      CREATE INDEX IF NOT EXISTS webhooks_user_index ON webhooks (id_user);
+
+      create table if not exists notification_outbox
+      (
+          id serial constraint notification_outbox_pk primary key,
+          id_user int REFERENCES users ON DELETE CASCADE,
+          channel varchar(20)  not null,
+          id_webhook int REFERENCES webhooks ON DELETE CASCADE,
+          message jsonb not null,
+          attempts int not null default 0,
+          next_attempt timestamptz not null default now()
+      );
+
+      CREATE INDEX IF NOT EXISTS notification_outbox_next_index ON notification_outbox (next_attempt);
+
+      -- Telegram
+      create table if not exists telegram_chats
+      (
+          id_user int constraint telegram_chats_pk primary key REFERENCES users ON DELETE CASCADE,
+          chat_id bigint  not null
+      );
+
+      CREATE UNIQUE INDEX IF NOT EXISTS telegram_chats_chat_uindex ON telegram_chats (chat_id);
+
+      create table if not exists telegram_codes
+      (
+          id_user int constraint telegram_codes_pk primary key REFERENCES users ON DELETE CASCADE,
+          code varchar(16)  not null,
+          expires timestamptz not null
+      );
+
+      CREATE UNIQUE INDEX IF NOT EXISTS telegram_codes_code_uindex ON telegram_codes (code);
+
+      -- Web Push; подписки привязаны к сессии, подписки без сессии браузер оформит заново после входа
+      create table if not exists push_subscriptions
+      (
+          id serial constraint push_subscriptions_pk primary key,
+          id_user int REFERENCES users ON DELETE CASCADE,
+          endpoint varchar(1000)  not null,
+          p256dh varchar(100)  not null,
+          auth varchar(50)  not null,
+          session varchar(64)  not null,
+          expires timestamptz not null,
+          created timestamptz not null default now()
+      );
+
+      ALTER TABLE push_subscriptions ADD COLUMN IF NOT EXISTS session varchar(64),
+          ADD COLUMN IF NOT EXISTS expires timestamptz;
+      DELETE FROM push_subscriptions WHERE session IS NULL OR expires IS NULL;
+      ALTER TABLE push_subscriptions ALTER COLUMN session SET NOT NULL, ALTER COLUMN expires SET NOT NULL;
+      CREATE UNIQUE INDEX IF NOT EXISTS push_subscriptions_endpoint_uindex ON push_subscriptions (endpoint);
+      CREATE INDEX IF NOT EXISTS push_subscriptions_session_index ON push_subscriptions (session);
+
+      ALTER TABLE notification_outbox ADD COLUMN IF NOT EXISTS id_subscription int REFERENCES push_subscriptions ON DELETE CASCADE;
+
+      -- перенос и пропуск приёмов
+      create table if not exists dose_changes
+      (
+          id serial constraint dose_changes_pk primary key,
+          id_notification int not null,
+          to_is_user bool not null,
+          id_person int not null,
+          id_medicine int REFERENCES medicine ON DELETE SET NULL,
+          name_medicine varchar(100),
+          action varchar(20)  not null,
+          time_from timestamptz not null,
+          time_to timestamptz not null,
+          id_user int REFERENCES users ON DELETE SET NULL,
+          created timestamptz not null default now()
+      );
+
+      ALTER TABLE dose_changes DROP CONSTRAINT IF EXISTS dose_changes_id_medicine_fkey,
+          ADD CONSTRAINT dose_changes_id_medicine_fkey FOREIGN KEY (id_medicine) REFERENCES medicine ON DELETE SET NULL;
+      CREATE INDEX IF NOT EXISTS dose_changes_person_index ON dose_changes (to_is_user, id_person, created);
+
+      -- лекарства по необходимости
+      create table if not exists prn_rules
+      (
+          id serial constraint prn_rules_pk primary key,
+          to_is_user bool not null,
+          id_person int not null,
+          id_medicine int REFERENCES medicine ON DELETE CASCADE,
+          min_interval int not null default 0,
+          max_daily int not null default 0,
+          constraint prn_rules_medicine_uindex unique (to_is_user, id_person, id_medicine)
+      );
+
+      create table if not exists prn_intakes
+      (
+          id serial constraint prn_intakes_pk primary key,
+          to_is_user bool not null,
+          id_person int not null,
+          id_medicine int REFERENCES medicine ON DELETE SET NULL,
+          name_medicine varchar(100),
+          count int not null,
+          time timestamptz not null default now(),
+          id_user int REFERENCES users ON DELETE SET NULL,
+          overridden bool not null default false
+      );
+
+      ALTER TABLE prn_intakes DROP CONSTRAINT IF EXISTS prn_intakes_id_medicine_fkey,
+          ADD CONSTRAINT prn_intakes_id_medicine_fkey FOREIGN KEY (id_medicine) REFERENCES medicine ON DELETE SET NULL;
+      ALTER TABLE prn_intakes ADD COLUMN IF NOT EXISTS name_medicine varchar(100);
+      CREATE INDEX IF NOT EXISTS prn_intakes_person_index ON prn_intakes (to_is_user, id_person, id_medicine, time);
+  COMMIT;
+EOSQL